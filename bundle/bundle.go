@@ -0,0 +1,346 @@
+// Package bundle loads specs that are split across files. References to definitions in other
+// files, e.g. common.yml#/definitions/Error, are imported into the definitions of the spec, so
+// the code for them is generated along with the rest of the spec. All other references to other
+// files, e.g. paths/books.yml, are replaced with the object they refer to.
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/go-openapi/loads/fmts"
+	"github.com/go-openapi/swag"
+
+	"github.com/Clever/wag/v9/swagger"
+)
+
+// Load reads the spec at specPath, which can be YAML or JSON, and resolves its references to
+// other files. It returns the spec as a single JSON document.
+func Load(specPath string) (json.RawMessage, error) {
+	if u, err := url.Parse(specPath); err != nil || u.Scheme == "" {
+		specPath = filepath.Clean(specPath)
+	}
+	raw, err := readFile(specPath)
+	if err != nil {
+		return nil, err
+	}
+	var root map[string]interface{}
+	if err := json.Unmarshal(raw, &root); err != nil {
+		return nil, fmt.Errorf("%s: %s", specPath, err)
+	}
+
+	b := bundler{
+		root:     specPath,
+		files:    map[string]interface{}{specPath: root},
+		imported: map[string]string{},
+		sources:  map[string]string{},
+	}
+	// OpenAPI 3 specs keep their schemas in components
+	if _, ok := root["openapi"]; ok {
+		components, ok := root["components"].(map[string]interface{})
+		if !ok {
+			components = map[string]interface{}{}
+			root["components"] = components
+		}
+		b.definitions, b.definitionsPointer = definitionsMap(components, "schemas"), "#/components/schemas/"
+	} else {
+		b.definitions, b.definitionsPointer = definitionsMap(root, "definitions"), "#/definitions/"
+	}
+	for name := range b.definitions {
+		b.sources[name] = specPath + b.definitionsPointer + swagger.EscapePointer(name)
+	}
+
+	// definitions that only refer to the definition with the same name in another file, e.g.
+	// Error: {$ref: common.yml#/definitions/Error}, are replaced with that definition
+	for _, name := range swagger.SortedKeys(b.definitions) {
+		definition, _ := b.definitions[name].(map[string]interface{})
+		ref, _ := definition["$ref"].(string)
+		i := strings.Index(ref, "#")
+		if len(definition) != 1 || i <= 0 {
+			continue
+		}
+		if refName, ok := definitionName(ref[i:]); ok && refName == name {
+			delete(b.definitions, name)
+			delete(b.sources, name)
+			if _, err := b.resolve(definition, ref, specPath, b.definitionsPointer+swagger.EscapePointer(name)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if _, err := b.walk(root, specPath, "#"); err != nil {
+		return nil, err
+	}
+	if len(b.definitions) == 0 {
+		// don't add an empty definitions object to specs that didn't have one
+		if components, ok := root["components"].(map[string]interface{}); ok {
+			delete(components, "schemas")
+			if len(components) == 0 {
+				delete(root, "components")
+			}
+		} else {
+			delete(root, "definitions")
+		}
+	}
+	return json.Marshal(root)
+}
+
+func definitionsMap(parent map[string]interface{}, key string) map[string]interface{} {
+	definitions, ok := parent[key].(map[string]interface{})
+	if !ok {
+		definitions = map[string]interface{}{}
+		parent[key] = definitions
+	}
+	return definitions
+}
+
+type bundler struct {
+	// root is the path of the spec being loaded.
+	root string
+	// definitions are the definitions of the root spec, which imported definitions are added to.
+	definitions map[string]interface{}
+	// definitionsPointer is the location of the definitions, e.g. "#/definitions/".
+	definitionsPointer string
+	// files are the parsed files, by path.
+	files map[string]interface{}
+	// imported maps the location of each imported definition, e.g. "common.yml#/definitions/X",
+	// to its name in the root spec.
+	imported map[string]string
+	// sources maps the name of each definition in the root spec to the location it came from.
+	sources map[string]string
+	// inlining are the locations being inlined, used to detect cycles.
+	inlining []string
+}
+
+// walk resolves the references in value, which is at pointer in file. Maps and slices are
+// updated in place, but references are replaced, so the caller must use the returned value.
+func (b *bundler) walk(value interface{}, file, pointer string) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok {
+			return b.resolve(v, ref, file, pointer)
+		}
+		for _, key := range swagger.SortedKeys(v) {
+			resolved, err := b.walk(v[key], file, pointer+"/"+swagger.EscapePointer(key))
+			if err != nil {
+				return nil, err
+			}
+			v[key] = resolved
+		}
+	case []interface{}:
+		for i := range v {
+			resolved, err := b.walk(v[i], file, fmt.Sprintf("%s/%d", pointer, i))
+			if err != nil {
+				return nil, err
+			}
+			v[i] = resolved
+		}
+	}
+	return value, nil
+}
+
+// resolve returns the replacement for the object with the $ref ref at pointer in file. The other
+// fields of the object are kept.
+func (b *bundler) resolve(object map[string]interface{}, ref, file, pointer string) (interface{}, error) {
+	refFile, fragment := ref, "#"
+	if i := strings.Index(ref, "#"); i >= 0 {
+		refFile, fragment = ref[:i], ref[i:]
+	}
+	if refFile == "" {
+		if file == b.root {
+			// references within the root spec don't need to change
+			return object, nil
+		}
+		refFile = file
+	} else {
+		refFile = relativePath(file, refFile)
+	}
+	location := refFile + fragment
+
+	target, err := b.lookup(refFile, fragment)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve $ref %s at %s%s: %s", ref, file, pointer, err)
+	}
+
+	if name, ok := definitionName(fragment); ok {
+		if err := b.importDefinition(location, name, target, refFile, fragment); err != nil {
+			return nil, err
+		}
+		replaced := siblings(object)
+		replaced["$ref"] = b.definitionsPointer + swagger.EscapePointer(b.imported[location])
+		return replaced, nil
+	}
+
+	for _, l := range b.inlining {
+		if l == location {
+			return nil, fmt.Errorf("$ref %s at %s%s refers to itself. Only definitions can be recursive",
+				ref, file, pointer)
+		}
+	}
+	b.inlining = append(b.inlining, location)
+	defer func() { b.inlining = b.inlining[:len(b.inlining)-1] }()
+	inlined, err := b.walk(deepCopy(target), refFile, fragment)
+	if err != nil {
+		return nil, err
+	}
+	if m, ok := inlined.(map[string]interface{}); ok {
+		for key, value := range siblings(object) {
+			m[key] = value
+		}
+	}
+	return inlined, nil
+}
+
+// siblings returns the fields of an object with a $ref other than the $ref.
+func siblings(object map[string]interface{}) map[string]interface{} {
+	fields := map[string]interface{}{}
+	for key, value := range object {
+		if key != "$ref" {
+			fields[key] = value
+		}
+	}
+	return fields
+}
+
+// importDefinition adds the definition at location to the definitions of the root spec. A
+// definition with the same name must be identical.
+func (b *bundler) importDefinition(location, name string, target interface{}, file, fragment string) error {
+	if _, ok := b.imported[location]; ok {
+		return nil
+	}
+	if location == b.sources[name] {
+		b.imported[location] = name
+		return nil
+	}
+	if source, ok := b.sources[name]; ok {
+		b.imported[location] = name
+		definition, err := b.walk(deepCopy(target), file, fragment)
+		if err != nil {
+			return err
+		}
+		existing := b.definitions[name]
+		if strings.HasPrefix(source, b.root+"#") {
+			// the root spec's definitions may not have been walked yet
+			if existing, err = b.walk(existing, b.root, strings.TrimPrefix(source, b.root)); err != nil {
+				return err
+			}
+			b.definitions[name] = existing
+		}
+		if !reflect.DeepEqual(definition, existing) {
+			return fmt.Errorf("definition %s from %s conflicts with the definition %s from %s. "+
+				"Definitions with the same name must be identical", name, location, name, source)
+		}
+		return nil
+	}
+
+	// add the definition before resolving its references, so recursive definitions work
+	b.imported[location] = name
+	b.sources[name] = location
+	b.definitions[name] = nil
+	definition, err := b.walk(deepCopy(target), file, fragment)
+	if err != nil {
+		return err
+	}
+	b.definitions[name] = definition
+	return nil
+}
+
+// lookup returns the value at the JSON pointer fragment in file.
+func (b *bundler) lookup(file, fragment string) (interface{}, error) {
+	doc, ok := b.files[file]
+	if !ok {
+		raw, err := readFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			return nil, fmt.Errorf("%s: %s", file, err)
+		}
+		b.files[file] = doc
+	}
+
+	current := doc
+	pointer := strings.TrimPrefix(fragment, "#")
+	if pointer == "" {
+		return current, nil
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = swagger.UnescapePointer(token)
+		switch c := current.(type) {
+		case map[string]interface{}:
+			next, ok := c[token]
+			if !ok {
+				return nil, fmt.Errorf("%s doesn't have %s", file, fragment)
+			}
+			current = next
+		case []interface{}:
+			var i int
+			if _, err := fmt.Sscanf(token, "%d", &i); err != nil || i < 0 || i >= len(c) {
+				return nil, fmt.Errorf("%s doesn't have %s", file, fragment)
+			}
+			current = c[i]
+		default:
+			return nil, fmt.Errorf("%s doesn't have %s", file, fragment)
+		}
+	}
+	return current, nil
+}
+
+// definitionName returns the name of the definition a fragment refers to, if it refers to one.
+func definitionName(fragment string) (string, bool) {
+	for _, prefix := range []string{"#/definitions/", "#/components/schemas/"} {
+		if strings.HasPrefix(fragment, prefix) {
+			name := strings.TrimPrefix(fragment, prefix)
+			if name != "" && !strings.Contains(name, "/") {
+				return swagger.UnescapePointer(name), true
+			}
+		}
+	}
+	return "", false
+}
+
+// relativePath returns the path of ref, which is relative to the file that contains it.
+func relativePath(file, ref string) string {
+	if u, err := url.Parse(ref); err == nil && u.Scheme != "" {
+		return ref
+	}
+	if u, err := url.Parse(file); err == nil && u.Scheme != "" {
+		u.Path = path.Join(path.Dir(u.Path), ref)
+		return u.String()
+	}
+	if filepath.IsAbs(ref) {
+		return ref
+	}
+	return filepath.Join(filepath.Dir(file), ref)
+}
+
+// readFile reads a YAML or JSON file, returning it as JSON.
+func readFile(file string) (json.RawMessage, error) {
+	if fmts.YAMLMatcher(file) {
+		return fmts.YAMLDoc(file)
+	}
+	return swag.LoadFromFileOrHTTP(file)
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := map[string]interface{}{}
+		for key, value := range v {
+			copied[key] = deepCopy(value)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, value := range v {
+			copied[i] = deepCopy(value)
+		}
+		return copied
+	}
+	return value
+}
@@ -0,0 +1,62 @@
+package bundle
+
+import (
+	"testing"
+
+	"github.com/go-openapi/loads/fmts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	expected, err := fmts.YAMLDoc("testyml/service/bundled.yml")
+	require.NoError(t, err)
+	bundled, err := Load("./testyml/service/swagger.yml")
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(bundled))
+}
+
+func TestLoadWithoutExternalRefs(t *testing.T) {
+	expected, err := fmts.YAMLDoc("testyml/service/bundled.yml")
+	require.NoError(t, err)
+	bundled, err := Load("testyml/service/bundled.yml")
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(bundled))
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		file string
+		err  string
+	}{
+		{
+			file: "testyml/invalid/conflict.yml",
+			err: "definition PageInfo from testyml/shared/common.yml#/definitions/PageInfo conflicts with " +
+				"the definition PageInfo from testyml/invalid/conflict.yml#/definitions/PageInfo. " +
+				"Definitions with the same name must be identical",
+		},
+		{
+			file: "testyml/invalid/missing-definition.yml",
+			err: "could not resolve $ref ../shared/common.yml#/definitions/PageInformation at " +
+				"testyml/invalid/missing-definition.yml#/definitions/Page/properties/info: " +
+				"testyml/shared/common.yml doesn't have #/definitions/PageInformation",
+		},
+		{
+			file: "testyml/invalid/missing-file.yml",
+			err: "could not resolve $ref paths/books.yml at testyml/invalid/missing-file.yml#/paths/~1books: " +
+				"open testyml/invalid/paths/books.yml: no such file or directory",
+		},
+		{
+			file: "testyml/invalid/cycle.yml",
+			err: "$ref #/books at testyml/invalid/cycle-paths.yml#/books refers to itself. Only definitions " +
+				"can be recursive",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			_, err := Load(test.file)
+			require.Error(t, err)
+			assert.Equal(t, test.err, err.Error())
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package goclient
+
+import (
+	"strings"
+
+	"github.com/go-openapi/spec"
+
+	"github.com/Clever/wag/v9/swagger"
+	"github.com/Clever/wag/v9/templates"
+	"github.com/Clever/wag/v9/utils"
+)
+
+type fakeTemplate struct {
+	ServiceName      string
+	ImportStatements string
+	Operations       []fakeOperation
+}
+
+type fakeOperation struct {
+	// OpID is the operation ID with a lowercase first letter, for the unexported names of the
+	// operation.
+	OpID    string
+	CapOpID string
+	// Input is the input argument of the operation, e.g. "i *models.GetBookInput". InputName
+	// and InputType are its parts.
+	Input     string
+	InputName string
+	InputType string
+	// ResultType is the success type of the operation. It's empty for operations that only
+	// return an error.
+	ResultType string
+
+	HasPaging            bool
+	ResourceType         string
+	ResponseAccessString string
+	PointerArray         bool
+}
+
+// generateFake generates the clientfake package, which has an in-memory implementation of the
+// Client interface for tests.
+func generateFake(packageName, basePath, outputPath string, s *spec.Swagger) error {
+	outputPath = strings.TrimPrefix(outputPath, ".")
+	moduleName, versionSuffix := utils.ExtractModuleNameAndVersionSuffix(packageName, outputPath)
+	tmpl := fakeTemplate{
+		ServiceName: s.Info.InfoProps.Title,
+	}
+	imports := []string{"context", "errors", "fmt", "sync"}
+	if hasPaging(s) {
+		imports = append(imports, "iter", "strconv")
+	}
+	tmpl.ImportStatements = swagger.ImportStatements(append(imports,
+		moduleName+outputPath+"/client"+versionSuffix,
+		moduleName+outputPath+"/models"+versionSuffix,
+	))
+
+	for _, pathKey := range swagger.SortedPathItemKeys(s.Paths.Paths) {
+		pathItemOps := swagger.PathItemOperations(s.Paths.Paths[pathKey])
+		for _, method := range swagger.SortedOperationsKeys(pathItemOps) {
+			op := pathItemOps[method]
+			if op.Deprecated {
+				continue
+			}
+			fakeOp := fakeOperation{
+				OpID:    strings.ToLower(op.ID[:1]) + op.ID[1:],
+				CapOpID: swagger.Capitalize(op.ID),
+				Input:   swagger.OperationInput(s, op),
+			}
+			if parts := strings.SplitN(fakeOp.Input, " ", 2); len(parts) == 2 {
+				fakeOp.InputName, fakeOp.InputType = parts[0], parts[1]
+			}
+			if successType := swagger.SuccessType(s, op); successType != nil {
+				fakeOp.ResultType = *successType
+			}
+			if _, hasPaging := swagger.PagingParam(op); hasPaging {
+				resourceType, needsPointer, err := swagger.PagingResourceType(s, op)
+				if err != nil {
+					return err
+				}
+				fakeOp.HasPaging = true
+				fakeOp.ResourceType = resourceType
+				fakeOp.PointerArray = needsPointer
+				for _, pathComponent := range swagger.PagingResourcePath(op) {
+					fakeOp.ResponseAccessString += "." + utils.CamelCase(pathComponent, true)
+				}
+			}
+			tmpl.Operations = append(tmpl.Operations, fakeOp)
+		}
+	}
+
+	fakeCode, err := templates.WriteTemplate(fakeTemplateStr, tmpl)
+	if err != nil {
+		return err
+	}
+	g := swagger.Generator{BasePath: basePath}
+	g.Print(fakeCode)
+	return g.WriteFile("client/clientfake/clientfake.go")
+}
+
+var fakeTemplateStr = `
+// Package clientfake has an in-memory implementation of the {{.ServiceName}} client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+{{.ImportStatements}}
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+	{{- range .Operations}}
+
+	{{.OpID}}Stub  func(ctx context.Context, {{.Input}}) {{if .ResultType}}({{.ResultType}}, error){{else}}error{{end}}
+	{{- if .HasPaging}}
+	{{.OpID}}PageStub func(ctx context.Context, {{.Input}}, page int) ({{.ResultType}}, bool, error)
+	{{- end}}
+	{{.OpID}}Queue []{{.OpID}}Result
+	{{.OpID}}Calls []{{.CapOpID}}Call
+	{{- end}}
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+{{- range .Operations}}
+
+// {{.CapOpID}}Call records a call to {{.CapOpID}}.
+type {{.CapOpID}}Call struct {
+	Ctx   context.Context
+	{{- if .InputType}}
+	Input {{.InputType}}
+	{{- end}}
+}
+
+type {{.OpID}}Result struct {
+	{{- if .ResultType}}
+	resp {{.ResultType}}
+	{{- end}}
+	err  error
+}
+
+// Stub{{.CapOpID}} sets the function that answers calls to {{.CapOpID}} once its queued responses
+// are used up.
+func (f *Fake) Stub{{.CapOpID}}(stub func(ctx context.Context, {{.Input}}) {{if .ResultType}}({{.ResultType}}, error){{else}}error{{end}}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.{{.OpID}}Stub = stub
+}
+
+// Queue{{.CapOpID}} adds a response for a call to {{.CapOpID}}.
+func (f *Fake) Queue{{.CapOpID}}({{if .ResultType}}resp {{.ResultType}}, {{end}}err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.{{.OpID}}Queue = append(f.{{.OpID}}Queue, {{.OpID}}Result{ {{- if .ResultType}}resp: resp, {{end}}err: err})
+}
+
+// {{.CapOpID}}Calls returns the calls made to {{.CapOpID}}.
+func (f *Fake) {{.CapOpID}}Calls() []{{.CapOpID}}Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]{{.CapOpID}}Call{}, f.{{.OpID}}Calls...)
+}
+
+// {{.CapOpID}} returns the next queued response or calls the stub.
+func (f *Fake) {{.CapOpID}}(ctx context.Context, {{.Input}}) {{if .ResultType}}({{.ResultType}}, error){{else}}error{{end}} {
+	f.mu.Lock()
+	f.{{.OpID}}Calls = append(f.{{.OpID}}Calls, {{.CapOpID}}Call{Ctx: ctx{{if .InputType}}, Input: {{.InputName}}{{end}}})
+	if len(f.{{.OpID}}Queue) > 0 {
+		result := f.{{.OpID}}Queue[0]
+		f.{{.OpID}}Queue = f.{{.OpID}}Queue[1:]
+		f.mu.Unlock()
+		return {{if .ResultType}}result.resp, {{end}}result.err
+	}
+	stub := f.{{.OpID}}Stub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx{{if .InputType}}, {{.InputName}}{{end}})
+	}
+	{{- if .ResultType}}
+	var resp {{.ResultType}}
+	return resp, notStubbed("{{.CapOpID}}")
+	{{- else}}
+	return notStubbed("{{.CapOpID}}")
+	{{- end}}
+}
+{{- if .HasPaging}}
+
+// Stub{{.CapOpID}}Page sets the function that answers the calls iterators make for the pages of
+// {{.CapOpID}} once its queued responses are used up. It's passed the number of the page, starting
+// at 1, and returns whether there are pages after it. It takes precedence over the stub set with
+// Stub{{.CapOpID}} for iterators.
+func (f *Fake) Stub{{.CapOpID}}Page(stub func(ctx context.Context, {{.Input}}, page int) ({{.ResultType}}, bool, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.{{.OpID}}PageStub = stub
+}
+
+// has{{.CapOpID}}Response returns true if a call to {{.CapOpID}} has a queued response or a stub.
+func (f *Fake) has{{.CapOpID}}Response() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.{{.OpID}}Queue) > 0 || f.{{.OpID}}Stub != nil || f.{{.OpID}}PageStub != nil
+}
+
+// {{.OpID}}Page returns a page of {{.CapOpID}} for an iterator and whether there may be pages after
+// it. Queued responses and the stub set with Stub{{.CapOpID}} don't know about pages, so pages
+// are assumed to follow them.
+func (f *Fake) {{.OpID}}Page(ctx context.Context, {{.Input}}, page int) ({{.ResultType}}, bool, error) {
+	f.mu.Lock()
+	stub := f.{{.OpID}}PageStub
+	if len(f.{{.OpID}}Queue) > 0 || stub == nil {
+		f.mu.Unlock()
+		resp, err := f.{{.CapOpID}}(ctx{{if .InputType}}, {{.InputName}}{{end}})
+		return resp, true, err
+	}
+	f.{{.OpID}}Calls = append(f.{{.OpID}}Calls, {{.CapOpID}}Call{Ctx: ctx{{if .InputType}}, Input: {{.InputName}}{{end}}})
+	f.mu.Unlock()
+	return stub(ctx{{if .InputType}}, {{.InputName}}{{end}}, page)
+}
+
+// New{{.CapOpID}}Iter returns an iterator that calls {{.CapOpID}} for each page, so pages are queued
+// or stubbed like responses of {{.CapOpID}}, or with Stub{{.CapOpID}}Page. The iterator stops after
+// an empty page, a page the page stub says is the last, or the last queued page if there's no stub.
+func (f *Fake) New{{.CapOpID}}Iter(ctx context.Context, {{.Input}}) (client.{{.CapOpID}}Iter, error) {
+	return &{{.OpID}}Iter{f: f, ctx: ctx{{if .InputType}}, input: {{.InputName}}{{end}}}, nil
+}
+
+// New{{.CapOpID}}IterFromCursor returns an iterator like New{{.CapOpID}}Iter that starts at the page
+// number in the cursor, or has no pages if the cursor is empty. The page number is passed to the
+// stub set with Stub{{.CapOpID}}Page; queued responses are returned in order whatever the cursor.
+func (f *Fake) New{{.CapOpID}}IterFromCursor(ctx context.Context, {{.Input}}, cursor string) (client.{{.CapOpID}}Iter, error) {
+	if cursor == "" {
+		return &{{.OpID}}Iter{f: f, ctx: ctx{{if .InputType}}, input: {{.InputName}}{{end}}, done: true}, nil
+	}
+	page, err := strconv.Atoi(cursor)
+	if err != nil || page < 1 {
+		return nil, fmt.Errorf("clientfake: invalid cursor %q for {{.CapOpID}}", cursor)
+	}
+	return &{{.OpID}}Iter{f: f, ctx: ctx{{if .InputType}}, input: {{.InputName}}{{end}}, pages: page - 1}, nil
+}
+
+type {{.OpID}}Iter struct {
+	f     *Fake
+	ctx   context.Context
+	{{- if .InputType}}
+	input {{.InputType}}
+	{{- end}}
+	page  []{{if .PointerArray}}*{{end}}{{.ResourceType}}
+	index int
+	// pages is the number of the last page fetched.
+	pages   int
+	fetched bool
+	done    bool
+	err     error
+}
+
+// nextPage fetches the next page. Returns false if there are no more pages or there was an error.
+func (i *{{.OpID}}Iter) nextPage() bool {
+	if i.done || i.err != nil || (i.fetched && !i.f.has{{.CapOpID}}Response()) {
+		return false
+	}
+	resp, more, err := i.f.{{.OpID}}Page(i.ctx{{if .InputType}}, i.input{{end}}, i.pages+1)
+	if err != nil {
+		i.err = err
+		return false
+	}
+	i.page = resp{{.ResponseAccessString}}
+	i.index = 0
+	i.pages++
+	i.fetched = true
+	i.done = len(i.page) == 0 || !more
+	return true
+}
+
+// Next assigns the next resource to v, fetching a new page if necessary. Returns true if there
+// was a resource.
+func (i *{{.OpID}}Iter) Next(v *{{.ResourceType}}) bool {
+	for i.index >= len(i.page) {
+		if !i.nextPage() {
+			return false
+		}
+	}
+	*v = {{if .PointerArray}}*{{end}}i.page[i.index]
+	i.index++
+	return true
+}
+
+// Err returns the error returned for a page, if any.
+func (i *{{.OpID}}Iter) Err() error {
+	return i.err
+}
+
+// Cursor returns "" if the iterator has no more pages, or else the number of the next page.
+func (i *{{.OpID}}Iter) Cursor() string {
+	if i.done || (i.fetched && !i.f.has{{.CapOpID}}Response()) {
+		return ""
+	}
+	return fmt.Sprint(i.pages + 1)
+}
+
+// Close does nothing, since the fake doesn't fetch pages ahead of the caller.
+func (i *{{.OpID}}Iter) Close() {}
+
+// {{.CapOpID}}Seq returns a sequence of the resources of the pages of {{.CapOpID}}, which are
+// fetched like the pages of New{{.CapOpID}}Iter.
+func (f *Fake) {{.CapOpID}}Seq(ctx context.Context, {{.Input}}) iter.Seq2[*{{.ResourceType}}, error] {
+	return func(yield func(*{{.ResourceType}}, error) bool) {
+		for page, err := range f.{{.CapOpID}}Pages(ctx{{if .InputType}}, {{.InputName}}{{end}}) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for j := range page {
+				if !yield({{if not .PointerArray}}&{{end}}page[j], nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// {{.CapOpID}}Pages returns a sequence of the pages of {{.CapOpID}}, which are fetched like the
+// pages of New{{.CapOpID}}Iter.
+func (f *Fake) {{.CapOpID}}Pages(ctx context.Context, {{.Input}}) iter.Seq2[[]{{if .PointerArray}}*{{end}}{{.ResourceType}}, error] {
+	return func(yield func([]{{if .PointerArray}}*{{end}}{{.ResourceType}}, error) bool) {
+		it := &{{.OpID}}Iter{f: f, ctx: ctx{{if .InputType}}, input: {{.InputName}}{{end}}}
+		for it.nextPage() {
+			if !yield(it.page, nil) {
+				return
+			}
+		}
+		if it.err != nil {
+			yield(nil, it.err)
+		}
+	}
+}
+{{- end}}
+{{- end}}
+`
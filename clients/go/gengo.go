@@ -19,7 +19,15 @@ func Generate(packageName, basePath, outputPath string, s spec.Swagger) error {
 	if err := generateClient(packageName, basePath, outputPath, s); err != nil {
 		return err
 	}
-	return generateInterface(packageName, basePath, outputPath, &s, s.Info.InfoProps.Title, s.Paths)
+	if swagger.HasSecurity(&s) {
+		if err := generateAuth(basePath, &s); err != nil {
+			return err
+		}
+	}
+	if err := generateInterface(packageName, basePath, outputPath, &s, s.Info.InfoProps.Title, s.Paths); err != nil {
+		return err
+	}
+	return generateFake(packageName, basePath, outputPath, &s)
 }
 
 type clientCodeTemplate struct {
@@ -31,6 +39,11 @@ type clientCodeTemplate struct {
 	Operations           []string
 	Version              string
 	VersionSuffix        string
+	HasSecurity          bool
+	HasFormData          bool
+	HasPolymorphism      bool
+	ProblemDetails       bool
+	HasPaging            bool
 }
 
 var clientCodeTemplateStr = `
@@ -48,10 +61,22 @@ import (
 		"fmt"
 		"io/ioutil"
 		"crypto/md5"
+		{{- if .HasPaging}}
+		"iter"
+		{{- end}}
+		{{- if .HasFormData}}
+		"io"
+		"mime/multipart"
+		"net/url"
+		"path/filepath"
+		{{- end}}
 
 		"{{.ModuleName}}{{.OutputPath}}/models{{.VersionSuffix}}"
 
 		discovery "github.com/Clever/discovery-go"
+		{{- if .HasPolymorphism}}
+		"github.com/go-openapi/runtime"
+		{{- end}}
 		wcl "github.com/Clever/wag/logging/wagclientlogger"
 		
 )
@@ -60,6 +85,14 @@ var _ = json.Marshal
 var _ = strings.Replace
 var _ = strconv.FormatInt
 var _ = bytes.Compare
+{{- if .HasFormData}}
+var _ = io.Copy
+var _ = multipart.NewWriter
+var _ = url.Values{}
+{{- end}}
+{{- if .HasPolymorphism}}
+var _ = runtime.JSONConsumer
+{{- end}}
 
 // Version of the client.
 const Version = "{{ .Version }}"
@@ -70,12 +103,21 @@ const VersionHeader = "X-Client-Version"
 // WagClient is used to make requests to the {{.ServiceName}} service.
 type WagClient struct {
 	basePath    string
-	requestDoer doer
+	requestDoer Doer
 	client   	*http.Client
 	// Keep the retry doer around so that we can set the number of retries
 	retryDoer *retryDoer
+	middleware []Middleware
+	// Keep the circuit breaker doer around so that we can set its options
+	circuitBreaker *circuitBreakerDoer
 	defaultTimeout time.Duration
+	{{- if .HasPaging}}
+	pagePrefetch int
+	{{- end}}
 	logger      wcl.WagClientLogger
+	{{- if .HasSecurity}}
+	credentials CredentialsProvider
+	{{- end}}
 }
 
 var _ Client = (*WagClient)(nil)
@@ -93,9 +135,11 @@ func New(basePath string, logger wcl.WagClientLogger, transport *http.RoundTripp
 
 	basePath = strings.TrimSuffix(basePath, "/")
 	base := baseDoer{}
-	
+	// The circuit breaker is off until its options are set
+	circuit := &circuitBreakerDoer{d: base, service: "{{.ServiceName}}", logger: logger}
+
 	// Don't use the default retry policy since its 5 retries can 5X the traffic
-	retry := retryDoer{d: base, retryPolicy: SingleRetryPolicy{}}
+	retry := retryDoer{d: circuit, retryPolicy: SingleRetryPolicy{}}
 
 	client := &WagClient{
 		basePath: basePath,
@@ -104,6 +148,7 @@ func New(basePath string, logger wcl.WagClientLogger, transport *http.RoundTripp
 			Transport: t,
 		},
 		retryDoer: &retry,
+		circuitBreaker: circuit,
 		defaultTimeout: 5 * time.Second,
 		logger: logger,
 	}
@@ -131,11 +176,51 @@ func (c *WagClient) SetRetryPolicy(retryPolicy RetryPolicy) {
 	c.retryDoer.retryPolicy = retryPolicy
 }
 
+// SetCircuitBreaker turns on a circuit breaker with the given options for all the operations that
+// don't have their own. Each attempt at a request counts, so retries of failed requests stop when
+// the circuit opens. Requests fail with ErrCircuitOpen while it's open. Setting the options resets
+// the circuit.
+func (c *WagClient) SetCircuitBreaker(options CircuitBreakerOptions) {
+	c.circuitBreaker.setOptions("", options)
+}
+
+// SetOperationCircuitBreaker gives an operation, e.g. "getBookByID", its own circuit breaker with
+// the given options. Use CircuitBreakerOptions{ForceClosed: true} to turn the circuit breaker off
+// for the operation.
+func (c *WagClient) SetOperationCircuitBreaker(operation string, options CircuitBreakerOptions) {
+	c.circuitBreaker.setOptions(operation, options)
+}
+
+// AddMiddleware adds middleware that wraps the requests of all operations. Middleware is called in
+// the order it's added, before the client retries requests, so it's called once per call to an
+// operation, or per page for operations with paging.
+func (c *WagClient) AddMiddleware(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+	var d Doer = c.retryDoer
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		d = c.middleware[i](d)
+	}
+	c.requestDoer = d
+}
+
 // SetLogger allows for setting a custom logger
 func (c *WagClient) SetLogger(l wcl.WagClientLogger) {
 	c.logger = l
+	c.circuitBreaker.setLogger(l)
 }
 
+{{- if .HasPaging}}
+
+// SetPagePrefetch sets the number of pages that the iterators and sequences of paged operations
+// fetch ahead of the page being iterated, concurrently. The default, 0, fetches each page when it's
+// needed. It applies to iterators constructed after it's set. Iterators that prefetch keep fetching
+// pages until the last one, until their context is done or until they're closed, so Close an
+// iterator that isn't iterated to the end.
+func (c *WagClient) SetPagePrefetch(depth int) {
+	c.pagePrefetch = depth
+}
+{{- end}}
+
 // SetTimeout sets a timeout on all operations for the client. To make a single request with a shorter timeout
 // than the default on the client, use context.WithTimeout as described here: https://godoc.org/golang.org/x/net/context#WithTimeout.
 func (c *WagClient) SetTimeout(timeout time.Duration){
@@ -149,6 +234,69 @@ func (c *WagClient) SetTimeout(timeout time.Duration){
 func shortHash(s string) string {
 	return fmt.Sprintf("%x", md5.Sum([]byte(s)))[0:6]
 }
+{{- if .HasPaging}}
+
+// All returns all the resources in a sequence of the resources of a paged operation, e.g. from
+// GetThingsSeq, or the resources before the first error and the error.
+func All[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	return Collect(seq, 0)
+}
+
+// Collect returns the first limit resources in a sequence of the resources of a paged operation,
+// e.g. from GetThingsSeq, or all of them if limit isn't positive. It stops iterating the sequence,
+// and so fetching pages, once it has limit resources. If the sequence yields an error, it returns
+// the resources before it and the error.
+func Collect[T any](seq iter.Seq2[T, error], limit int) ([]T, error) {
+	var resources []T
+	for resource, err := range seq {
+		if err != nil {
+			return resources, err
+		}
+		resources = append(resources, resource)
+		if limit > 0 && len(resources) >= limit {
+			break
+		}
+	}
+	return resources, nil
+}
+{{- end}}
+{{- if .HasFormData}}
+
+// writeFormFile copies a file parameter into a multipart form and closes it. The part's filename
+// is the base of the file's name if it has one, e.g. for an *os.File, and the field name otherwise.
+func writeFormFile(form *multipart.Writer, field string, file io.ReadCloser) error {
+	defer file.Close()
+	filename := field
+	if named, ok := file.(interface{ Name() string }); ok {
+		filename = filepath.Base(named.Name())
+	}
+	part, err := form.CreateFormFile(field, filename)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, file)
+	return err
+}
+{{- end}}
+{{- if .ProblemDetails}}
+
+// decodeProblem decodes a problem details error response, with the error model of its status code
+// decoded from the same body as its Err.
+func decodeProblem(resp *http.Response, output error) (*models.ProblemDetails, error) {
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, err
+	}
+	problem := models.ProblemDetails{Err: output}
+	if err := json.Unmarshal(data, &problem); err != nil {
+		return nil, err
+	}
+	return &problem, nil
+}
+{{- end}}
 `
 
 func generateClient(packageName, basePath, outputPath string, s spec.Swagger) error {
@@ -162,6 +310,9 @@ func generateClient(packageName, basePath, outputPath string, s spec.Swagger) er
 		FormattedServiceName: strings.ToUpper(strings.Replace(s.Info.InfoProps.Title, "-", "_", -1)),
 		Version:              s.Info.InfoProps.Version,
 		VersionSuffix:        versionSuffix,
+		HasSecurity:          swagger.HasSecurity(&s),
+		HasPolymorphism:      swagger.HasPolymorphicDefinitions(&s),
+		ProblemDetails:       swagger.ProblemDetails(&s),
 	}
 
 	for _, path := range swagger.SortedPathItemKeys(s.Paths.Paths) {
@@ -176,6 +327,12 @@ func generateClient(packageName, basePath, outputPath string, s spec.Swagger) er
 			if err != nil {
 				return err
 			}
+			if swagger.HasFormDataParams(op) {
+				codeTemplate.HasFormData = true
+			}
+			if _, hasPaging := swagger.PagingParam(op); hasPaging {
+				codeTemplate.HasPaging = true
+			}
 			codeTemplate.Operations = append(codeTemplate.Operations, code)
 		}
 	}
@@ -204,6 +361,10 @@ func CreateModFile(path string, basePath string, codeTemplate clientCodeTemplate
 	}
 
 	defer f.Close()
+	runtimeRequirement := ""
+	if codeTemplate.HasPolymorphism {
+		runtimeRequirement = "\n\tgithub.com/go-openapi/runtime v0.24.1"
+	}
 	modFileString := `
 module ` + codeTemplate.ModuleName + codeTemplate.OutputPath + `/client` + codeTemplate.VersionSuffix + `
 
@@ -212,7 +373,7 @@ go 1.24
 require (
 	github.com/Clever/discovery-go v1.8.1
 	github.com/Clever/wag/logging/wagclientlogger v0.0.0-20221024182247-2bf828ef51be
-	github.com/donovanhide/eventsource v0.0.0-20171031113327-3ed64d21fb0b
+	github.com/donovanhide/eventsource v0.0.0-20171031113327-3ed64d21fb0b` + runtimeRequirement + `
 )
 //Replace directives will work locally but mess up imports.
 replace ` + codeTemplate.ModuleName + codeTemplate.OutputPath + `/models` + codeTemplate.VersionSuffix + ` => ../models `
@@ -246,7 +407,11 @@ func generateInterface(packageName, basePath, outputPath string, s *spec.Swagger
 	g := swagger.Generator{BasePath: basePath}
 	g.Print("package client\n\n")
 	moduleName, versionSuffix := utils.ExtractModuleNameAndVersionSuffix(packageName, outputPath)
-	g.Print(swagger.ImportStatements([]string{"context", moduleName + outputPath + "/models" + versionSuffix}))
+	imports := []string{"context", moduleName + outputPath + "/models" + versionSuffix}
+	if hasPaging(s) {
+		imports = append(imports, "iter")
+	}
+	g.Print(swagger.ImportStatements(imports))
 	g.Print("//go:generate mockgen -source=$GOFILE -destination=mock_client.go -package client --build_flags=--mod=mod -imports=models=" + moduleName + outputPath + "/models" + versionSuffix + "\n\n")
 
 	if err := generateClientInterface(s, &g, serviceName, paths); err != nil {
@@ -281,6 +446,18 @@ func generateClientInterface(s *spec.Swagger, g *swagger.Generator, serviceName
 			_, hasPaging := swagger.PagingParam(pathItemOps[method])
 			if hasPaging {
 				g.Printf("\t%s\n\n", swagger.ClientIterInterface(s, pathItemOps[method]))
+				g.Printf("\t// New%sIterFromCursor resumes iterating the pages of %s from the Cursor of an iterator.\n",
+					swagger.Capitalize(op.ID), op.ID)
+				g.Printf("\tNew%sIterFromCursor(ctx context.Context, %s, cursor string) (%sIter, error)\n\n",
+					swagger.Capitalize(op.ID), swagger.OperationInput(s, op), swagger.Capitalize(op.ID))
+				seq, pages, err := clientSeqInterfaces(s, op)
+				if err != nil {
+					return err
+				}
+				g.Printf("\t// %sSeq returns a sequence of the resources of all the pages of %s.\n", swagger.Capitalize(op.ID), op.ID)
+				g.Printf("\t%s\n\n", seq)
+				g.Printf("\t// %sPages returns a sequence of the pages of %s.\n", swagger.Capitalize(op.ID), op.ID)
+				g.Printf("\t%s\n\n", pages)
 			}
 		}
 	}
@@ -288,6 +465,36 @@ func generateClientInterface(s *spec.Swagger, g *swagger.Generator, serviceName
 	return nil
 }
 
+// hasPaging returns true if any operation of the spec has paging.
+func hasPaging(s *spec.Swagger) bool {
+	for _, pathItem := range s.Paths.Paths {
+		for _, op := range swagger.PathItemOperations(pathItem) {
+			if _, paging := swagger.PagingParam(op); paging && !op.Deprecated {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// clientSeqInterfaces returns the client-facing interfaces of the <Op>Seq and <Op>Pages methods of
+// an operation with paging.
+func clientSeqInterfaces(s *spec.Swagger, op *spec.Operation) (string, string, error) {
+	capOpID := swagger.Capitalize(op.ID)
+	resourceType, needsPointer, err := swagger.PagingResourceType(s, op)
+	if err != nil {
+		return "", "", err
+	}
+	pageType := "[]" + resourceType
+	if needsPointer {
+		pageType = "[]*" + resourceType
+	}
+	input := swagger.OperationInput(s, op)
+	seq := fmt.Sprintf("%sSeq(ctx context.Context, %s) iter.Seq2[*%s, error]", capOpID, input, resourceType)
+	pages := fmt.Sprintf("%sPages(ctx context.Context, %s) iter.Seq2[%s, error]", capOpID, input, pageType)
+	return seq, pages, nil
+}
+
 func generateIteratorTypes(s *spec.Swagger, g *swagger.Generator, paths *spec.Paths) error {
 	for _, pathKey := range swagger.SortedPathItemKeys(paths.Paths) {
 		path := paths.Paths[pathKey]
@@ -309,6 +516,8 @@ func generateIteratorTypes(s *spec.Swagger, g *swagger.Generator, paths *spec.Pa
 				g.Printf("type %sIter interface {\n", capOpID)
 				g.Printf("\tNext(*%s) bool\n", resourceType)
 				g.Print("\tErr() error\n")
+				g.Print("\tCursor() string\n")
+				g.Print("\tClose()\n")
 				g.Print("}\n\n")
 			}
 		}
@@ -348,7 +557,7 @@ func methodCode(s *spec.Swagger, op *spec.Operation, basePath, method, methodPat
 	buf.WriteString(fmt.Sprintf("func (c *WagClient) %s {\n", swagger.ClientInterface(s, op)))
 
 	buf.WriteString("\theaders := make(map[string]string)\n\n")
-	if !binaryBody {
+	if !binaryBody && !swagger.HasFormDataParams(op) {
 		buf.WriteString("\tvar body []byte\n")
 	}
 
@@ -358,44 +567,59 @@ func methodCode(s *spec.Swagger, op *spec.Operation, basePath, method, methodPat
 
 	if _, hasPaging := swagger.PagingParam(op); !hasPaging {
 		buf.WriteString(fmt.Sprintf(`
-	return c.do%sRequest(ctx, req, headers)
+	return c.do%sRequest(ctx, req, headers, %s)
 }
 
-`, capOpID))
+`, capOpID, operationInputName(s, op)))
 	} else {
 		buf.WriteString(fmt.Sprintf(`
-	resp, _, err := c.do%sRequest(ctx, req, headers)
+	resp, _, err := c.do%sRequest(ctx, req, headers, %s)
 	return resp, err
 }
 
-`, capOpID))
+`, capOpID, operationInputName(s, op)))
 	}
 
 	return buf.String(), nil
 }
 
+// operationInputName returns the name of the input parameter of an operation's client method, or
+// nil if it doesn't have one.
+func operationInputName(s *spec.Swagger, op *spec.Operation) string {
+	input := swagger.OperationInput(s, op)
+	if input == "" {
+		return "nil"
+	}
+	return strings.Fields(input)[0]
+}
+
 func methodDoerCode(s *spec.Swagger, op *spec.Operation) string {
 	var buf bytes.Buffer
 	capOpID := swagger.Capitalize(op.ID)
 
+	// The results are named so that the operation's OnResult functions can be called with them
 	errReturn := ""
 	returnType := ""
+	output := "nil"
 	if successType := swagger.SuccessType(s, op); successType != nil {
 		errReturn += "nil, "
-		returnType += *successType + ", "
+		returnType += "output " + *successType + ", "
+		output = "output"
 	}
 	if _, hasPaging := swagger.PagingParam(op); hasPaging {
 		errReturn += "\"\", "
-		returnType += "string, "
+		returnType += "nextPage string, "
 	}
-	returnType += "error"
+	returnType = "(" + returnType + "err error)"
 
-	if len(returnType) != len("error") {
-		returnType = "(" + returnType + ")"
+	requirements := swagger.SecurityRequirements(s, op)
+	if len(requirements) > 0 {
+		buf.WriteString(fmt.Sprintf("\n// securityFor%s are the security requirements of %s.\n", capOpID, op.ID))
+		buf.WriteString(fmt.Sprintf("var securityFor%s = %s\n", capOpID, securityRequirementsCode(requirements)))
 	}
 
 	buf.WriteString(fmt.Sprintf(`
-func (c *WagClient) do%sRequest(ctx context.Context, req *http.Request, headers map[string]string) %s {
+func (c *WagClient) do%sRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) %s {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Canonical-Resource", "%s")
 	req.Header.Set(VersionHeader, Version)
@@ -403,9 +627,24 @@ func (c *WagClient) do%sRequest(ctx context.Context, req *http.Request, headers
 	for field, value := range headers {
 		req.Header.Set(field, value)
 	}
+`, capOpID, returnType, op.ID))
+
+	if len(requirements) > 0 {
+		buf.WriteString(fmt.Sprintf(`
+	if err := c.applyCredentials(ctx, req, securityFor%s); err != nil {
+		return %serr
+	}
+`, capOpID, errReturn))
+	}
 
+	buf.WriteString(fmt.Sprintf(`
 	// Add the opname for doers like tracing
 	ctx = context.WithValue(ctx, opNameCtx{}, "%s")
+	operation := &Operation{Name: "%s", Input: input}
+	defer func() {
+		operation.finish(%s, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -443,13 +682,28 @@ func (c *WagClient) do%sRequest(ctx context.Context, req *http.Request, headers
 		return %serr
 	}
 	defer resp.Body.Close()
-`, capOpID, returnType, op.ID, op.ID, s.Info.InfoProps.Title, errReturn))
+`, op.ID, op.ID, output, s.Info.InfoProps.Title, errReturn))
 
 	buf.WriteString(parseResponseCode(s, op, capOpID))
 
 	return buf.String()
 }
 
+// securityRequirementsCode returns a Go literal of type [][]SecurityScheme for security requirements.
+func securityRequirementsCode(requirements []map[string][]string) string {
+	var buf bytes.Buffer
+	buf.WriteString("[][]SecurityScheme{\n")
+	for _, requirement := range requirements {
+		var schemes []string
+		for _, name := range swagger.SortedSecurityRequirementKeys(requirement) {
+			schemes = append(schemes, swagger.SecuritySchemeConstName(name))
+		}
+		buf.WriteString(fmt.Sprintf("\t{%s},\n", strings.Join(schemes, ", ")))
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
 func buildPathCode(s *spec.Swagger, op *spec.Operation, basePath, methodPath string) string {
 	var buf bytes.Buffer
 	capOpID := swagger.Capitalize(op.ID)
@@ -512,7 +766,13 @@ func buildRequestCode(s *spec.Swagger, op *spec.Operation, method string, binary
 	var buf bytes.Buffer
 
 	// binary bodies are io.ReadCloser and do not need to be transformed
-	if binaryBody {
+	if swagger.HasFormDataParams(op) {
+		buf.WriteString(buildFormCode(s, op))
+		buf.WriteString(fmt.Sprintf(`
+	req, err := http.NewRequestWithContext(ctx, "%s", path, body)
+	%s
+`, strings.ToUpper(method), errorMessage(s, op)))
+	} else if binaryBody {
 		buf.WriteString(fmt.Sprintf(`
 	req, err := http.NewRequestWithContext(ctx, "%s", path, *%s)
 	%s
@@ -528,6 +788,97 @@ func buildRequestCode(s *spec.Swagger, op *spec.Operation, method string, binary
 	return buf.String()
 }
 
+// buildFormCode encodes the formData parameters into the request body. Operations that only
+// consume application/x-www-form-urlencoded are sent URL encoded, others as multipart/form-data.
+// It assigns to the err declared by buildPathCode, which always calls i.Path() for these operations.
+func buildFormCode(s *spec.Swagger, op *spec.Operation) string {
+	tmpl := formTemplate{
+		URLEncoded:   isURLEncodedForm(op),
+		ErrorMessage: errorMessage(s, op),
+	}
+	for _, param := range op.Parameters {
+		if param.In == "formData" {
+			tmpl.Params = append(tmpl.Params, swagger.ParamToTemplate(&param, op))
+		}
+	}
+	str, err := templates.WriteTemplate(formStr, tmpl)
+	if err != nil {
+		panic(fmt.Errorf("unexpected error: %s", err))
+	}
+	return str
+}
+
+func isURLEncodedForm(op *spec.Operation) bool {
+	urlEncoded := false
+	for _, mimeType := range op.Consumes {
+		if mimeType == "multipart/form-data" {
+			return false
+		}
+		if mimeType == "application/x-www-form-urlencoded" {
+			urlEncoded = true
+		}
+	}
+	return urlEncoded
+}
+
+type formTemplate struct {
+	URLEncoded   bool
+	Params       []swagger.ParamTemplate
+	ErrorMessage string
+}
+
+var formStr = `
+	{{- $errorMessage := .ErrorMessage}}
+	{{- if .URLEncoded}}
+	form := url.Values{}
+	{{- range .Params}}
+	{{if .Pointer -}}
+	if {{.AccessString}} != nil {
+	{{end -}}
+	{{if eq .Type "array" -}}
+	for _, v := range {{.AccessString}} {
+		form.Add("{{.Name}}", v)
+	}
+	{{- else -}}
+	form.Add("{{.Name}}", {{.ToStringCode}})
+	{{- end}}
+	{{if .Pointer -}}
+	}
+	{{end -}}
+	{{- end}}
+	body := bytes.NewBufferString(form.Encode())
+	headers["Content-Type"] = "application/x-www-form-urlencoded"
+	{{- else}}
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+	{{- range .Params}}
+	{{if eq .Type "file" -}}
+	if {{.AccessString}} != nil {
+		err = writeFormFile(form, "{{.Name}}", {{.AccessString}})
+		{{$errorMessage}}
+	}
+	{{- else if eq .Type "array" -}}
+	for _, v := range {{.AccessString}} {
+		err = form.WriteField("{{.Name}}", v)
+		{{$errorMessage}}
+	}
+	{{- else -}}
+	{{if .Pointer -}}
+	if {{.AccessString}} != nil {
+	{{end -}}
+	err = form.WriteField("{{.Name}}", {{.ToStringCode}})
+	{{$errorMessage}}
+	{{- if .Pointer}}
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	err = form.Close()
+	{{$errorMessage}}
+	headers["Content-Type"] = form.FormDataContentType()
+	{{- end}}
+`
+
 // buildHeadersCode adds the parameters to the header
 func buildHeadersCode(s *spec.Swagger, op *spec.Operation) string {
 	var buf bytes.Buffer
@@ -660,16 +1011,47 @@ func writeStatusCodeDecoder(s *spec.Swagger, op *spec.Operation, statusCode int)
 		outputType = "&output"
 	}
 
+	if (swagger.HasMultipleSuccessResponses(op) || swagger.HasOutputType(op)) && statusCode < 400 {
+		var fields []string
+		responseType := swagger.OutputTypeName(op)
+		if swagger.HasMultipleSuccessResponses(op) {
+			responseType = swagger.SuccessResponseTypeName(op)
+			fields = append(fields, fmt.Sprintf("StatusCode: %d", statusCode))
+		}
+		if outputName != "" {
+			field := "Body"
+			for _, r := range swagger.SuccessResponses(s, op) {
+				if r.StatusCode == statusCode && swagger.HasMultipleSuccessResponses(op) {
+					field = r.Field
+				}
+			}
+			fields = append(fields, fmt.Sprintf("%s: %s", field, outputType))
+		}
+		headers, err := swagger.ResponseHeaders(op)
+		if err != nil {
+			return "", err
+		}
+		return templates.WriteTemplate(successDetectorTmplStr, successDetectorTmpl{
+			StatusCode: statusCode,
+			TypeName:   outputName,
+			Unmarshal:  swagger.UnmarshalFunc(s, swagger.OutputSchema(s, op, statusCode)),
+			Result:     fmt.Sprintf("&models.%s{%s}", responseType, strings.Join(fields, ", ")),
+			Headers:    headers,
+		})
+	}
+
 	_, hasPaging := swagger.PagingParam(op)
 	return templates.WriteTemplate(codeDetectorTmplStr,
 		codeDetectorTmpl{
-			StatusCode:    statusCode,
-			NoSuccessType: swagger.SuccessType(s, op) == nil,
-			SuccessReturn: buildSuccessReturn(s, op),
-			HasPaging:     hasPaging,
-			ErrorType:     statusCode >= 400,
-			TypeName:      outputName,
-			OutputType:    outputType,
+			StatusCode:     statusCode,
+			NoSuccessType:  swagger.SuccessType(s, op) == nil,
+			SuccessReturn:  buildSuccessReturn(s, op),
+			HasPaging:      hasPaging,
+			ErrorType:      statusCode >= 400,
+			ProblemDetails: swagger.ProblemDetails(s),
+			TypeName:       outputName,
+			OutputType:     outputType,
+			Unmarshal:      swagger.UnmarshalFunc(s, swagger.OutputSchema(s, op, statusCode)),
 		})
 }
 
@@ -681,6 +1063,10 @@ type codeDetectorTmpl struct {
 	ErrorType     bool
 	TypeName      string
 	OutputType    string
+	// ProblemDetails is true if error responses are problem details, see swagger.ProblemDetails.
+	ProblemDetails bool
+	// Unmarshal is the function that decodes polymorphic types, e.g. UnmarshalEvent.
+	Unmarshal string
 }
 
 var codeDetectorTmplStr = `
@@ -689,20 +1075,46 @@ var codeDetectorTmplStr = `
 	{{if .NoSuccessType}}
 		{{if .ErrorType}}
 		var output {{.TypeName}}
+		{{- if .ProblemDetails}}
+		problem, err := decodeProblem(resp, &output)
+		if err != nil {
+			return {{.SuccessReturn}}err
+		}
+		return {{.SuccessReturn}}problem
+		{{- else}}
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
 			return {{.SuccessReturn}}err
 		}
 		return {{.SuccessReturn}}{{.OutputType}}
+		{{- end}}
 		{{else}}
 		return {{.SuccessReturn}}nil
 		{{end}}
 	{{else}}
 		{{if .ErrorType}}
 		var output {{.TypeName}}
+		{{- if .ProblemDetails}}
+		problem, err := decodeProblem(resp, &output)
+		if err != nil {
+			return {{.SuccessReturn}}err
+		}
+		return {{.SuccessReturn}}problem
+		{{- else}}
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
 			return {{.SuccessReturn}}err
 		}
 		return {{.SuccessReturn}}{{.OutputType}}
+		{{- end}}
+		{{else if .Unmarshal}}
+		output, err := models.{{.Unmarshal}}(resp.Body, runtime.JSONConsumer())
+		if err != nil {
+			return {{.SuccessReturn}}err
+		}
+		{{if .HasPaging}}
+		return {{.OutputType}}, resp.Header.Get("X-Next-Page-Path"), nil
+		{{else}}
+		return {{.OutputType}}, nil
+		{{end}}
 		{{else}}
 		var output {{.TypeName}}
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
@@ -717,6 +1129,57 @@ var codeDetectorTmplStr = `
 	{{end}}
 `
 
+type successDetectorTmpl struct {
+	StatusCode int
+	TypeName   string
+	// Unmarshal is the function that decodes polymorphic types, e.g. UnmarshalEvent.
+	Unmarshal string
+	// Result is the code for the <OperationID>Response or <OperationID>Output the client returns.
+	Result  string
+	Headers []swagger.ResponseHeader
+}
+
+// successDetectorTmplStr decodes a success response of an operation that returns an
+// <OperationID>Response or <OperationID>Output, including its headers.
+var successDetectorTmplStr = `
+	case {{.StatusCode}}:
+	{{- if .Unmarshal}}
+		output, err := models.{{.Unmarshal}}(resp.Body, runtime.JSONConsumer())
+		if err != nil {
+			return nil, err
+		}
+	{{- else if .TypeName}}
+		var output {{.TypeName}}
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+	{{- end}}
+	{{- if .Headers}}
+		result := {{.Result}}
+		{{- range .Headers}}
+		if v := resp.Header.Get("{{.Name}}"); v != "" {
+		{{- if .ParseCode}}
+			parsed, err := {{.ParseCode}}
+			if err != nil {
+				return nil, fmt.Errorf("invalid {{.Name}} header: %s", err)
+			}
+			{{- if .ParsedValue}}
+			value := {{.ParsedValue}}
+			result.{{.Field}} = &value
+			{{- else}}
+			result.{{.Field}} = &parsed
+			{{- end}}
+		{{- else}}
+			result.{{.Field}} = v
+		{{- end}}
+		}
+		{{- end}}
+		return result, nil
+	{{- else}}
+		return {{.Result}}, nil
+	{{- end}}
+`
+
 func iterCode(s *spec.Swagger, op *spec.Operation, basePath, methodPath, method string) (string, error) {
 	capOpID := swagger.Capitalize(op.ID)
 	resourceType, needsPointer, err := swagger.PagingResourceType(s, op)
@@ -741,7 +1204,8 @@ func iterCode(s *spec.Swagger, op *spec.Operation, basePath, methodPath, method
 		iterTmpl{
 			OpID:                 op.ID,
 			CapOpID:              capOpID,
-			Input:                swagger.OperationInput(op),
+			Input:                swagger.OperationInput(s, op),
+			InputName:            operationInputName(s, op),
 			BuildPathCode:        buildPathCode(s, op, basePath, methodPath),
 			BuildHeadersCode:     buildHeadersCode(s, op),
 			BuildBodyCode:        buildBodyCode(s, op, method),
@@ -758,6 +1222,7 @@ type iterTmpl struct {
 	OpID                 string
 	CapOpID              string
 	Input                string
+	InputName            string
 	BuildPathCode        string
 	BuildHeadersCode     string
 	BuildBodyCode        string
@@ -778,11 +1243,47 @@ type {{.OpID}}IterImpl struct {
 	nextURL      string
 	headers      map[string]string
 	body         []byte
+	input        interface{}
+	// prefetch is the number of pages to fetch ahead. The pages are sent on pages, and cancel
+	// stops fetching them.
+	prefetch int
+	pages    chan {{.OpID}}Page
+	cancel   context.CancelFunc
+}
+
+// {{.OpID}}Page is a page of {{.OpID}} and the URL of the page after it.
+type {{.OpID}}Page struct {
+	resources {{.ResponseType}}
+	nextURL   string
+	err       error
 }
 
 // New{{.OpID}}Iter constructs an iterator that makes calls to {{.OpID}} for
 // each page.
 func (c *WagClient) New{{.CapOpID}}Iter(ctx context.Context, {{.Input}}) ({{.CapOpID}}Iter, error) {
+	it, err := c.new{{.CapOpID}}Iter(ctx, {{.InputName}})
+	if err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// New{{.CapOpID}}IterFromCursor constructs an iterator that resumes iterating the pages of
+// {{.OpID}} from the Cursor of an iterator constructed with the same input. The iterator has no
+// pages if the cursor is empty.
+func (c *WagClient) New{{.CapOpID}}IterFromCursor(ctx context.Context, {{.Input}}, cursor string) ({{.CapOpID}}Iter, error) {
+	it, err := c.new{{.CapOpID}}Iter(ctx, {{.InputName}})
+	if err != nil {
+		return nil, err
+	}
+	it.nextURL = ""
+	if cursor != "" {
+		it.nextURL = c.basePath + cursor
+	}
+	return it, nil
+}
+
+func (c *WagClient) new{{.CapOpID}}Iter(ctx context.Context, {{.Input}}) (*{{.OpID}}IterImpl, error) {
 	{{.BuildPathCode}}
 
 	headers := make(map[string]string)
@@ -798,33 +1299,96 @@ func (c *WagClient) New{{.CapOpID}}Iter(ctx context.Context, {{.Input}}) ({{.Cap
 		nextURL:      path,
 		headers:      headers,
 		body:         body,
+		input:        {{.InputName}},
+		prefetch:     c.pagePrefetch,
 	}, nil
 }
 
-func (i *{{.OpID}}IterImpl) refresh() error {
-	req, err := http.NewRequestWithContext(i.ctx, "{{.Method}}", i.nextURL, bytes.NewBuffer(i.body))
+// fetch fetches the page at url.
+func (i *{{.OpID}}IterImpl) fetch(ctx context.Context, url string) {{.OpID}}Page {
+	req, err := http.NewRequestWithContext(ctx, "{{.Method}}", url, bytes.NewBuffer(i.body))
 
 	if err != nil {
-		i.err = err
-		return err
+		return {{.OpID}}Page{err: err}
 	}
 
-	resp, nextPage, err := i.c.do{{.CapOpID}}Request(i.ctx, req, i.headers)
+	resp, nextPage, err := i.c.do{{.CapOpID}}Request(ctx, req, i.headers, i.input)
 	if err != nil {
-		i.err = err
-		return err
+		return {{.OpID}}Page{err: err}
 	}
 
-	i.lastResponse = resp{{.ResponseAccessString}}
-	i.index = 0
+	page := {{.OpID}}Page{resources: resp{{.ResponseAccessString}}}
 	if nextPage != "" {
-		i.nextURL = i.c.basePath + nextPage
+		page.nextURL = i.c.basePath + nextPage
+	}
+	return page
+}
+
+// startPrefetch starts fetching the pages after the current one ahead of the caller.
+func (i *{{.OpID}}IterImpl) startPrefetch() {
+	ctx, cancel := context.WithCancel(i.ctx)
+	i.cancel = cancel
+	// The page being fetched counts towards the depth
+	i.pages = make(chan {{.OpID}}Page, i.prefetch-1)
+	go func(url string) {
+		defer close(i.pages)
+		for url != "" {
+			page := i.fetch(ctx, url)
+			select {
+			case i.pages <- page:
+			case <-ctx.Done():
+				return
+			}
+			if page.err != nil {
+				return
+			}
+			url = page.nextURL
+		}
+	}(i.nextURL)
+}
+
+// stop stops fetching pages ahead of the caller.
+func (i *{{.OpID}}IterImpl) stop() {
+	if i.cancel != nil {
+		i.cancel()
+	}
+}
+
+func (i *{{.OpID}}IterImpl) refresh() error {
+	var page {{.OpID}}Page
+	if i.prefetch > 0 {
+		if i.pages == nil {
+			i.startPrefetch()
+		}
+		var ok bool
+		if page, ok = <-i.pages; !ok {
+			page.err = i.ctx.Err()
+			if page.err == nil {
+				page.err = context.Canceled
+			}
+		}
 	} else {
-		i.nextURL = ""
+		page = i.fetch(i.ctx, i.nextURL)
+	}
+	if page.err != nil {
+		i.err = page.err
+		return page.err
 	}
+
+	i.lastResponse = page.resources
+	i.index = 0
+	i.nextURL = page.nextURL
 	return nil
 }
 
+// Cursor returns the path of the next page the iterator fetches, relative to the client's base
+// path, i.e. the X-Next-Page-Path of the current page, or "" if there are no more pages. An
+// iterator from New{{.CapOpID}}IterFromCursor with the cursor resumes after the current page, so
+// save it after iterating all the resources of a page.
+func (i *{{.OpID}}IterImpl) Cursor() string {
+	return strings.TrimPrefix(i.nextURL, i.c.basePath)
+}
+
 // Next retrieves the next resource from the iterator and assigns it to the
 // provided pointer, fetching a new page if necessary. Returns true if it
 // successfully retrieves a new resource.
@@ -849,4 +1413,52 @@ func (i *{{.OpID}}IterImpl) Next(v *{{.ResourceType}}) bool {
 func (i *{{.OpID}}IterImpl) Err() error {
 	return i.err
 }
+
+// Close stops the iterator fetching pages ahead of the caller. Call it when an iterator isn't
+// iterated to the end.
+func (i *{{.OpID}}IterImpl) Close() {
+	i.stop()
+}
+
+// {{.CapOpID}}Seq returns a sequence of the resources of all the pages of {{.OpID}}, for use with
+// range. Pages are fetched as the sequence is iterated, so breaking out of the loop stops fetching
+// them. The sequence ends with the first error, which it yields with a nil resource.
+func (c *WagClient) {{.CapOpID}}Seq(ctx context.Context, {{.Input}}) iter.Seq2[*{{.ResourceType}}, error] {
+	return func(yield func(*{{.ResourceType}}, error) bool) {
+		for page, err := range c.{{.CapOpID}}Pages(ctx, {{.InputName}}) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for j := range page {
+				if !yield({{if not .PointerArray}}&{{end}}page[j], nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// {{.CapOpID}}Pages returns a sequence of the pages of {{.OpID}}, for use with range. Pages are
+// fetched as the sequence is iterated. The sequence ends with the first error, which it yields with
+// a nil page.
+func (c *WagClient) {{.CapOpID}}Pages(ctx context.Context, {{.Input}}) iter.Seq2[{{.ResponseType}}, error] {
+	return func(yield func({{.ResponseType}}, error) bool) {
+		it, err := c.new{{.CapOpID}}Iter(ctx, {{.InputName}})
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer it.stop()
+		for it.nextURL != "" {
+			if err := it.refresh(); err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(it.lastResponse, nil) {
+				return
+			}
+		}
+	}
+}
 `
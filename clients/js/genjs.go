@@ -26,11 +26,13 @@ func Generate(modulePath string, s spec.Swagger) error {
 	}
 
 	tmplInfo := clientCodeTemplate{
-		ClassName:   utils.CamelCase(s.Info.InfoProps.Title, true),
-		PackageName: pkgName,
-		ServiceName: s.Info.InfoProps.Title,
-		Version:     s.Info.InfoProps.Version,
-		Description: s.Info.InfoProps.Description,
+		ClassName:       utils.CamelCase(s.Info.InfoProps.Title, true),
+		PackageName:     pkgName,
+		ServiceName:     s.Info.InfoProps.Title,
+		Version:         s.Info.InfoProps.Version,
+		Description:     s.Info.InfoProps.Description,
+		HasSecurity:     swagger.HasSecurity(&s),
+		SecuritySchemes: swagger.SecuritySchemes(&s),
 	}
 
 	for _, path := range swagger.SortedPathItemKeys(s.Paths.Paths) {
@@ -85,12 +87,14 @@ func Generate(modulePath string, s spec.Swagger) error {
 }
 
 type clientCodeTemplate struct {
-	PackageName string
-	ClassName   string
-	ServiceName string
-	Version     string
-	Description string
-	Methods     []string
+	PackageName     string
+	ClassName       string
+	ServiceName     string
+	Version         string
+	Description     string
+	Methods         []string
+	HasSecurity     bool
+	SecuritySchemes []swagger.SecurityScheme
 }
 
 var indexJSTmplStr = `const async = require("async");
@@ -220,6 +224,56 @@ function applyCallback(promise, cb) {
     cb(err);
   });
 }
+{{- if .HasSecurity}}
+
+/**
+ * The security schemes defined in the swagger spec.
+ * @private
+ */
+const securitySchemes = {
+{{- range .SecuritySchemes}}
+  "{{.Name}}": { type: "{{.Type}}"{{if eq .Type "apiKey"}}, in: "{{.In}}", name: "{{.ParamName}}"{{end}} },
+{{- end}}
+};
+
+/**
+ * Resolves the headers and query parameters that carry credentials for an operation. The
+ * requirements are alternatives, so the first one the credentials provider can satisfy is used.
+ * Empty requirements, which allow anonymous requests, are skipped so that credentials are still
+ * sent when they're available. If none can be satisfied the request is sent without credentials.
+ * @private
+ */
+async function resolveCredentials(provider, requirements) {
+  const resolved = { headers: {}, query: {} };
+  if (!provider) {
+    return resolved;
+  }
+  for (const requirement of requirements) {
+    if (requirement.length === 0) {
+      continue;
+    }
+    const credentials = await Promise.all(requirement.map(scheme => provider(scheme)));
+    if (credentials.some(c => !c)) {
+      continue;
+    }
+    requirement.forEach((scheme, i) => {
+      const c = credentials[i];
+      const def = securitySchemes[scheme];
+      if (def.type === "basic") {
+        resolved.headers.authorization = "Basic " + Buffer.from(c.username + ":" + c.password).toString("base64");
+      } else if (def.type === "oauth2") {
+        resolved.headers.authorization = "Bearer " + c.token;
+      } else if (def.in === "query") {
+        resolved.query[def.name] = c.token;
+      } else {
+        resolved.headers[def.name] = c.token;
+      }
+    });
+    return resolved;
+  }
+  return resolved;
+}
+{{- end}}
 
 /**
  * Default circuit breaker options.
@@ -274,7 +328,11 @@ class {{.ClassName}} {
    * rate. Once the error rate exceeds this percentage, the circuit opens.
    * Default: 90.
    * @param {object} [options.asynclocalstore] a request scoped async store 
-   */
+   {{if .HasSecurity}}* @param {function} [options.credentialsProvider] - Called with the name of a security scheme
+   * before requests to operations that require it. Returns (or resolves to) an object with a
+   * token (apiKey and oauth2 schemes) or a username and password (basic schemes), or undefined
+   * if there are no credentials for the scheme.
+   {{end}}*/
   constructor(options) {
     options = options || {};
 
@@ -310,6 +368,11 @@ class {{.ClassName}} {
     if (options.asynclocalstore) {
       this.asynclocalstore = options.asynclocalstore;
     }
+    {{- if .HasSecurity}}
+    if (options.credentialsProvider) {
+      this.credentialsProvider = options.credentialsProvider;
+    }
+    {{- end}}
 
 
     const circuitOptions = Object.assign({}, defaultCircuitOptions, options.circuit);
@@ -422,13 +485,13 @@ const packageJSONTmplStr = `{
 const methodTmplStr = `
   {{.MethodDefinition}}
     {{if .IterMethod -}}
-    const it = (f, saveResults, isAsync) => new Promise((resolve, reject) => {
+    const it = (f, saveResults, isAsync) => {{if .Security}}resolveCredentials(this.credentialsProvider, {{.Security}}).then(credentials => {{end}}new Promise((resolve, reject) => {
     {{- else -}}
     if (!cb && typeof options === "function") {
       options = undefined;
     }
 
-    return new Promise((resolve, reject) => {
+    return {{if .Security}}resolveCredentials(this.credentialsProvider, {{.Security}}).then(credentials => {{end}}new Promise((resolve, reject) => {
     {{- end}}
       if (!options) {
         options = {};
@@ -452,6 +515,9 @@ const methodTmplStr = `
 
       headers["Canonical-Resource"] = "{{.Operation}}";
       headers[versionHeader] = version;
+      {{- if .Security}}
+      Object.assign(headers, credentials.headers);
+      {{- end}}
       {{- range $param := .PathParams}}
       if (!params.{{$param.JSName}}) {
         reject(new Error("{{$param.JSName}} must be non-empty because it's a path parameter"));
@@ -465,12 +531,15 @@ const methodTmplStr = `
       const query = {};
       {{- range $param := .QueryParams -}}
       {{- if $param.Required }}
-      query["{{$param.WagName}}"] = params.{{$param.JSName}};
+      query["{{$param.WagName}}"] = params.{{$param.JSName}}{{if $param.Separator}}.join({{printf "%q" $param.Separator}}){{end}};
 {{else}}
       if (typeof params.{{$param.JSName}} !== "undefined") {
-        query["{{$param.WagName}}"] = params.{{$param.JSName}};
+        query["{{$param.WagName}}"] = params.{{$param.JSName}}{{if $param.Separator}}.join({{printf "%q" $param.Separator}}){{end}};
       }
 {{end}}{{end}}
+      {{- if .Security}}
+      Object.assign(query, credentials.query);
+      {{- end}}
 
       const requestOptions = {
         method: "{{.Method}}",
@@ -488,6 +557,27 @@ const methodTmplStr = `
 {{ if ne .BodyParam ""}}
       requestOptions.body = params.{{.BodyParam}};
 {{ end }}
+{{- if .FormDataParams}}
+      const form = {};
+      {{- range $param := .FormDataParams}}
+      if (typeof params.{{$param.JSName}} !== "undefined") {
+        {{- if eq $param.Type "file"}}
+        // Buffers don't have a filename, so fall back to the field name
+        form["{{$param.WagName}}"] = Buffer.isBuffer(params.{{$param.JSName}}) ?
+          {value: params.{{$param.JSName}}, options: {filename: "{{$param.WagName}}"}} : params.{{$param.JSName}};
+        {{- else if eq $param.Type "array"}}
+        form["{{$param.WagName}}"] = params.{{$param.JSName}}.map(String);
+        {{- else}}
+        form["{{$param.WagName}}"] = String(params.{{$param.JSName}});
+        {{- end}}
+      }
+      {{- end}}
+      {{- if .URLEncodedForm}}
+      requestOptions.form = form;
+      {{- else}}
+      requestOptions.formData = form;
+      {{- end}}
+{{- end }}
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
       const backoffs = retryPolicy.backoffs();
@@ -519,6 +609,16 @@ const methodTmplStr = `
             return;
           }
 
+          {{- if .ResponseHeaders}}
+
+          const headers = {};
+          {{- range .ResponseHeaders}}
+          if (response.headers["{{.LowerName}}"] !== undefined) {
+            headers.{{.JSName}} = {{.ParseCode}};
+          }
+          {{- end}}
+          {{- end}}
+
           switch (response.statusCode) {
             {{ range $response := .Responses }}case {{ $response.StatusCode }}:{{if $response.IsError }}
               var err = new Errors.{{ $response.Name }}(body || {});
@@ -529,7 +629,13 @@ const methodTmplStr = `
               cbW(err);
               {{- end}}
               return;
-{{else}}{{if $response.IsNoData}}
+{{else}}{{if $.MultiSuccess}}
+              resolve({statusCode: {{ $response.StatusCode }}{{if not $response.IsNoData}}, body{{end}}{{if $.ResponseHeaders}}, headers{{end}}});
+              break;
+{{else if $.ResponseHeaders}}
+              resolve({ {{- if not $response.IsNoData}}body, {{end}}headers});
+              break;
+{{else if $response.IsNoData}}
               resolve();
               break;
 {{else}}
@@ -593,7 +699,7 @@ const methodTmplStr = `
         }
       );
       {{- end}}
-    });
+    }){{if .Security}}){{end}};
 
     {{- if .IterMethod}}
 
@@ -688,6 +794,11 @@ type paramMapping struct {
 	JSDocType   string
 	Default     interface{}
 	Description string
+	// Type is the swagger type of the parameter. It's only set for formData parameters, which
+	// are converted to strings before they're sent.
+	Type string
+	// Separator joins the items of array query parameters with a collectionFormat other than multi.
+	Separator string
 }
 
 type responseMapping struct {
@@ -712,9 +823,14 @@ type methodTemplate struct {
 	HeaderParams             []paramMapping
 	PathParams               []paramMapping
 	QueryParams              []paramMapping
+	FormDataParams           []paramMapping
+	URLEncodedForm           bool
+	MultiSuccess             bool
+	ResponseHeaders          []jsResponseHeader
 	BodyParam                string
 	Responses                []responseMapping
 	JSDocSuccessReturnType   string
+	Security                 string
 }
 
 // This function takes in a swagger path such as "/path/goes/to/{location}/and/to/{other_Location}"
@@ -739,6 +855,7 @@ func methodCode(s spec.Swagger, op *spec.Operation, method, path string) (string
 		Method:      method,
 		PathCode:    basePath + fillOutPath(path),
 		Path:        basePath + path,
+		Security:    securityRequirementsJS(swagger.SecurityRequirements(&s, op)),
 	}
 
 	var successResponse *spec.Response
@@ -762,6 +879,20 @@ func methodCode(s spec.Swagger, op *spec.Operation, method, path string) (string
 		tmplInfo.Responses = append(tmplInfo.Responses, response)
 	}
 	tmplInfo.JSDocSuccessReturnType = responseToJSDocReturnType(successResponse)
+	if swagger.HasMultipleSuccessResponses(op) {
+		// resolves with {statusCode, body} so callers can tell which success response came back
+		tmplInfo.MultiSuccess = true
+		tmplInfo.JSDocSuccessReturnType = "{Object}"
+	}
+	headers, err := jsResponseHeaders(op)
+	if err != nil {
+		return "", err
+	}
+	if len(headers) > 0 {
+		// resolves with {body, headers}, or {statusCode, body, headers}
+		tmplInfo.ResponseHeaders = headers
+		tmplInfo.JSDocSuccessReturnType = "{Object}"
+	}
 
 	for _, wagParam := range op.Parameters {
 		param := paramMapping{
@@ -782,9 +913,16 @@ func methodCode(s spec.Swagger, op *spec.Operation, method, path string) (string
 		case "body": // Will only ever be a single bodyParam so we can just set here
 			tmplInfo.BodyParam = param.JSName
 		case "query":
+			if wagParam.Type == "array" {
+				param.Separator = swagger.CollectionFormatSeparator(wagParam)
+			}
 			tmplInfo.QueryParams = append(tmplInfo.QueryParams, param)
+		case "formData":
+			param.Type = wagParam.Type
+			tmplInfo.FormDataParams = append(tmplInfo.FormDataParams, param)
 		}
 	}
+	tmplInfo.URLEncodedForm = isURLEncodedForm(op)
 
 	if err := fillMethodDefinition(op, &tmplInfo); err != nil {
 		return "", err
@@ -818,6 +956,77 @@ func methodCode(s spec.Swagger, op *spec.Operation, method, path string) (string
 	return res, nil
 }
 
+// jsResponseHeader is a header of an operation's success responses, which the client parses into
+// the headers object it resolves with.
+type jsResponseHeader struct {
+	JSName string
+	// LowerName is the header name as node reports it.
+	LowerName string
+	// ParseCode converts the header value to its JS type.
+	ParseCode string
+	Type      JSType
+}
+
+func jsResponseHeaders(op *spec.Operation) ([]jsResponseHeader, error) {
+	headers, err := swagger.ResponseHeaders(op)
+	if err != nil {
+		return nil, err
+	}
+	jsHeaders := []jsResponseHeader{}
+	for _, h := range headers {
+		lowerName := strings.ToLower(h.Name)
+		jsHeader := jsResponseHeader{
+			JSName:    utils.CamelCase(h.Name, false),
+			LowerName: lowerName,
+			ParseCode: fmt.Sprintf("response.headers[\"%s\"]", lowerName),
+			Type:      "string",
+		}
+		switch h.Type {
+		case "bool":
+			jsHeader.ParseCode += ` === "true"`
+			jsHeader.Type = "boolean"
+		case "string":
+		default:
+			jsHeader.ParseCode = "Number(" + jsHeader.ParseCode + ")"
+			jsHeader.Type = "number"
+		}
+		jsHeaders = append(jsHeaders, jsHeader)
+	}
+	return jsHeaders, nil
+}
+
+// isURLEncodedForm returns true if the operation's formData parameters are sent as
+// application/x-www-form-urlencoded instead of multipart/form-data.
+func isURLEncodedForm(op *spec.Operation) bool {
+	urlEncoded := false
+	for _, mimeType := range op.Consumes {
+		if mimeType == "multipart/form-data" {
+			return false
+		}
+		if mimeType == "application/x-www-form-urlencoded" {
+			urlEncoded = true
+		}
+	}
+	return urlEncoded
+}
+
+// securityRequirementsJS returns a JS array of the scheme names in each security requirement,
+// or an empty string if there are no requirements.
+func securityRequirementsJS(requirements []map[string][]string) string {
+	if len(requirements) == 0 {
+		return ""
+	}
+	var reqs []string
+	for _, requirement := range requirements {
+		var schemes []string
+		for _, name := range swagger.SortedSecurityRequirementKeys(requirement) {
+			schemes = append(schemes, fmt.Sprintf("%q", name))
+		}
+		reqs = append(reqs, "["+strings.Join(schemes, ", ")+"]")
+	}
+	return "[" + strings.Join(reqs, ", ") + "]"
+}
+
 func fillMethodDefinition(op *spec.Operation, tmplInfo *methodTemplate) error {
 	var err error
 	var methodDefinition string
@@ -843,6 +1052,10 @@ func paramToJSDocType(param spec.Parameter) string {
 	} else if param.Type == "array" && param.Items != nil &&
 		(param.Items.Type == "string" || param.Items.Type == "number" || param.Items.Type == "boolean") {
 		return fmt.Sprintf("{%s[]}", param.Items.Type)
+	} else if param.Type == "array" && param.Items != nil && param.Items.Type == "integer" {
+		return "{number[]}"
+	} else if param.Type == "file" {
+		return "{(Buffer|ReadableStream)}"
 	}
 	log.Printf("TODO: unhandled param name=%s. Documentation will be incomplete for this parameter.", param.Name)
 	return ""
@@ -857,6 +1070,8 @@ func schemaToJSDocType(schema *spec.Schema) string {
 			return "{string}"
 		} else if typ == "integer" {
 			return "{number}"
+		} else if typ == "array" {
+			return "{Object[]}"
 		}
 	}
 	log.Printf("TODO: unhandled schema type %v.", schema.Type)
@@ -893,14 +1108,20 @@ module.exports.Errors.{{ .Name }} = class extends Error {
     for (const k of Object.keys(body)) {
       this[k] = body[k];
     }
+    {{- if $.ProblemDetails}}
+    if (body["invalid-params"] !== undefined) {
+      this.invalidParams = body["invalid-params"];
+    }
+    {{- end}}
   }
 };
 
 {{ end }}`
 
 type typesTemplate struct {
-	ServiceName string
-	ErrorTypes  []errorType
+	ServiceName    string
+	ErrorTypes     []errorType
+	ProblemDetails bool
 }
 
 type errorType struct {
@@ -921,9 +1142,40 @@ func jsDocPropertyFromSchema(name string, schema *spec.Schema) jsDocProperty {
 	}
 }
 
+// withProblemDetails returns a copy of an error schema with the members of the problem details
+// the server responds with when the spec sets x-problem-details. The client also sets
+// invalidParams to the invalid-params member.
+func withProblemDetails(schema spec.Schema) spec.Schema {
+	invalidParam := spec.Schema{SchemaProps: spec.SchemaProps{
+		Type:       spec.StringOrArray{"object"},
+		Required:   []string{"name", "reason"},
+		Properties: map[string]spec.Schema{"name": *spec.StringProperty(), "reason": *spec.StringProperty()},
+	}}
+	invalidParams := *spec.ArrayProperty(&invalidParam)
+	members := map[string]spec.Schema{
+		"type":           *spec.StringProperty(),
+		"title":          *spec.StringProperty(),
+		"status":         *spec.Int64Property(),
+		"detail":         *spec.StringProperty(),
+		"instance":       *spec.StringProperty(),
+		"invalid-params": invalidParams,
+		"invalidParams":  invalidParams,
+	}
+	properties := map[string]spec.Schema{}
+	for name, property := range members {
+		properties[name] = property
+	}
+	for name, property := range schema.Properties {
+		properties[name] = property
+	}
+	schema.Properties = properties
+	return schema
+}
+
 func generateErrorsFile(s spec.Swagger) (string, error) {
 	typesTmpl := typesTemplate{
-		ServiceName: s.Info.InfoProps.Title,
+		ServiceName:    s.Info.InfoProps.Title,
+		ProblemDetails: swagger.ProblemDetails(&s),
 	}
 
 	typeNames := stringset.New()
@@ -954,6 +1206,9 @@ func generateErrorsFile(s spec.Swagger) (string, error) {
 				if schema, ok := s.Definitions[typeName]; !ok {
 					log.Printf("TODO: could not find schema for %s, JS documentation will be incomplete", typeName)
 				} else if len(schema.Properties) > 0 {
+					if typesTmpl.ProblemDetails {
+						schema = withProblemDetails(schema)
+					}
 					for _, name := range swagger.SortedSchemaProperties(schema) {
 						propertySchema := schema.Properties[name]
 						etype.JSDocProperties = append(etype.JSDocProperties, jsDocPropertyFromSchema(name, &propertySchema))
@@ -976,6 +1231,7 @@ type JSTypeMap map[string]JSType
 
 type typescriptTypes struct {
 	ServiceName   string
+	HasSecurity   bool
 	IncludedTypes []string
 	MethodDecls   []string
 	ErrorTypes    []string
@@ -997,6 +1253,7 @@ var primitiveTypes = map[string]string{
 func generateTypescriptTypes(s spec.Swagger) (string, error) {
 	tt := typescriptTypes{
 		ServiceName:   utils.CamelCase(s.Info.InfoProps.Title, true),
+		HasSecurity:   swagger.HasSecurity(&s),
 		IncludedTypes: []string{},
 		MethodDecls:   []string{},
 	}
@@ -1019,17 +1276,28 @@ func generateTypescriptTypes(s spec.Swagger) (string, error) {
 			if err != nil {
 				return "", err
 			}
+			err = addSuccessResponseType(&includedTypeMap, s, op)
+			if err != nil {
+				return "", err
+			}
 		}
 	}
 
 	for name, schema := range s.Definitions {
-		if !isDefaultIncludedType[name] {
-			theType, err := asJSType(&schema, "")
-			if err != nil {
+		if isDefaultIncludedType[name] {
+			continue
+		}
+		if schema.Discriminator != "" {
+			if err := addPolymorphicTypes(&includedTypeMap, s, name, schema); err != nil {
 				return "", err
 			}
-			includedTypeMap[name] = theType
+			continue
+		}
+		theType, err := polymorphicSubtypeJSType(s, name, schema)
+		if err != nil {
+			return "", err
 		}
+		includedTypeMap[name] = theType
 	}
 
 	var keys []string
@@ -1084,6 +1352,9 @@ func getErrorTypes(s spec.Swagger) ([]string, error) {
 				if schema, ok := s.Definitions[typeName]; !ok {
 					errorTypes = append(errorTypes, fmt.Sprintf("class %s {}", typeName))
 				} else if len(schema.Properties) > 0 {
+					if swagger.ProblemDetails(&s) {
+						schema = withProblemDetails(schema)
+					}
 					declaration, err := generateErrorDeclaration(&schema, typeName, "models.")
 					if err != nil {
 						return errorTypes, err
@@ -1157,9 +1428,6 @@ func addInputType(jsTypeMap *JSTypeMap, op *spec.Operation) error {
 	paramNames := []string{}
 	fields := JSTypeMap{}
 	for _, param := range op.Parameters {
-		if param.In == "formData" {
-			return fmt.Errorf("input parameters with 'In' formData are not supported")
-		}
 		paramType, err := paramToJSType(param)
 		if err != nil {
 			return err
@@ -1180,6 +1448,49 @@ func addInputType(jsTypeMap *JSTypeMap, op *spec.Operation) error {
 	return nil
 }
 
+// addSuccessResponseType adds a discriminated union of the success responses of operations
+// with more than one, keyed on the status code, and the {body, headers} type of other operations
+// whose success response declares headers.
+func addSuccessResponseType(jsTypeMap *JSTypeMap, s spec.Swagger, op *spec.Operation) error {
+	multipleSuccess := swagger.HasMultipleSuccessResponses(op)
+	if !multipleSuccess && !swagger.HasOutputType(op) {
+		return nil
+	}
+	headers, err := jsResponseHeaders(op)
+	if err != nil {
+		return err
+	}
+	headerFields := []string{}
+	for _, h := range headers {
+		headerFields = append(headerFields, fmt.Sprintf("%s?: %s", h.JSName, h.Type))
+	}
+
+	variants := []string{}
+	for _, r := range swagger.SuccessResponses(&s, op) {
+		bodyType, err := typeOf(s, op, r.StatusCode)
+		if err != nil {
+			return err
+		}
+		fields := []string{}
+		if multipleSuccess {
+			fields = append(fields, fmt.Sprintf("statusCode: %d", r.StatusCode))
+		}
+		if bodyType != "void" {
+			fields = append(fields, fmt.Sprintf("body: %s", bodyType))
+		}
+		if len(headers) > 0 {
+			fields = append(fields, fmt.Sprintf("headers: { %s }", strings.Join(headerFields, "; ")))
+		}
+		variants = append(variants, fmt.Sprintf("{ %s }", strings.Join(fields, "; ")))
+	}
+	typeName := swagger.SuccessResponseTypeName(op)
+	if !multipleSuccess {
+		typeName = swagger.OutputTypeName(op)
+	}
+	(*jsTypeMap)[typeName] = JSType(strings.Join(variants, " | "))
+	return nil
+}
+
 const paramDeclTmlpStr = `interface {{.TypeName}} {
   {{.Fields}}
 }`
@@ -1197,9 +1508,12 @@ func ReturnType(s spec.Swagger, op *spec.Operation) (JSType, error) {
 	if len(successCodes) == 0 {
 		return "never", nil
 	} else if len(successCodes) == 1 {
+		if swagger.HasOutputType(op) {
+			return JSType(swagger.OutputTypeName(op)), nil
+		}
 		return typeOf(s, op, successCodes[0])
 	}
-	return "", fmt.Errorf("Operation %s has more than one possible success return type", op.ID)
+	return JSType(swagger.SuccessResponseTypeName(op)), nil
 }
 
 func typeOf(s spec.Swagger, op *spec.Operation, statusCode int) (JSType, error) {
@@ -1232,18 +1546,28 @@ func paramToJSType(param spec.Parameter) (JSType, error) {
 	case "boolean":
 		typeName = "boolean"
 	case "array":
-		if param.Items.Type != "string" {
-			return JSType(""), fmt.Errorf("array parameters must have string sub-types")
+		switch param.Items.Type {
+		case "string":
+			typeName = "string[]"
+		case "integer", "number":
+			typeName = "number[]"
+		case "boolean":
+			typeName = "boolean[]"
+		default:
+			return JSType(""), fmt.Errorf("array parameters must have string, integer, number, or boolean sub-types")
 		}
-		typeName = "string[]"
+	case "file":
+		typeName = fileJSType
 	default:
-		// Note. We don't support 'array' or 'file' types even though they're in the
-		// Swagger spec.
 		return JSType(""), fmt.Errorf("unsupported param type: \"%s\"", param.Type)
 	}
 	return JSType(typeName), nil
 }
 
+// fileJSType is the TypeScript type of file parameters. Streams must be readable once and should
+// have a path, like fs.ReadStream, so the upload has a filename.
+const fileJSType = "Buffer | NodeJS.ReadableStream"
+
 func asJSTypeSimple(simpleSchema spec.SimpleSchema) (JSType, error) {
 	if jsType, ok := primitiveTypes[simpleSchema.Type]; ok {
 		return JSType(jsType), nil
@@ -1257,9 +1581,69 @@ func asJSTypeSimple(simpleSchema spec.SimpleSchema) (JSType, error) {
 		return JSType("any"), nil
 	}
 
+	if simpleSchema.Type == "file" {
+		return JSType(fileJSType), nil
+	}
+
 	return JSType(""), fmt.Errorf("Unknown type '%v'", simpleSchema.Type)
 }
 
+// addPolymorphicTypes adds the types for a definition with a discriminator. The definition
+// itself is the union of its subtypes, so TypeScript can narrow it on the discriminator, and its
+// properties are in a <Name>Base type that the subtypes extend.
+func addPolymorphicTypes(jsTypeMap *JSTypeMap, s spec.Swagger, name string, schema spec.Schema) error {
+	baseName := name + "Base"
+	if _, ok := s.Definitions[baseName]; ok {
+		return fmt.Errorf("the definition %s conflicts with the base type of the polymorphic "+
+			"definition %s", baseName, name)
+	}
+	baseType, err := asJSType(&schema, "")
+	if err != nil {
+		return err
+	}
+	(*jsTypeMap)[baseName] = baseType
+
+	subtypes := swagger.Subtypes(&s, name)
+	if len(subtypes) == 0 {
+		(*jsTypeMap)[name] = JSType(baseName)
+		return nil
+	}
+	(*jsTypeMap)[name] = JSType(strings.Join(subtypes, " | "))
+	return nil
+}
+
+// polymorphicSubtypeJSType returns the type of a definition. Definitions that extend a
+// polymorphic definition with allOf extend its base type instead, with the discriminator set to
+// their name.
+func polymorphicSubtypeJSType(s spec.Swagger, name string, schema spec.Schema) (JSType, error) {
+	if len(schema.AllOf) == 0 {
+		return asJSType(&schema, "")
+	}
+	subTypes := []string{}
+	discriminators := []string{}
+	for _, subSchema := range schema.AllOf {
+		if swagger.IsPolymorphic(&s, &subSchema) {
+			def, err := defFromRef(subSchema.Ref.String())
+			if err != nil {
+				return JSType(""), err
+			}
+			subTypes = append(subTypes, def+"Base")
+			discriminators = append(discriminators,
+				fmt.Sprintf("%s: %q;", s.Definitions[def].Discriminator, name))
+			continue
+		}
+		subType, err := asJSType(&subSchema, "")
+		if err != nil {
+			return JSType(""), err
+		}
+		subTypes = append(subTypes, string(subType))
+	}
+	if len(discriminators) > 0 {
+		subTypes = append(subTypes, fmt.Sprintf("{\n  %s\n}", strings.Join(discriminators, "\n  ")))
+	}
+	return JSType(strings.Join(subTypes, " & ")), nil
+}
+
 func asJSType(schema *spec.Schema, refPrefix string) (JSType, error) {
 	if schema == nil {
 		return JSType(""), fmt.Errorf("No schema")
@@ -1395,6 +1779,16 @@ interface CircuitOptions {
   errorPercentThreshold?: number;
 }
 
+{{if .HasSecurity -}}
+interface Credentials {
+  token?: string;
+  username?: string;
+  password?: string;
+}
+
+type CredentialsProvider = (scheme: string) => Credentials | undefined | Promise<Credentials | undefined>;
+
+{{end -}}
 interface GenericOptions {
   timeout?: number;
   baggage?: Map<string, string | number>;
@@ -1404,6 +1798,9 @@ interface GenericOptions {
   circuit?: CircuitOptions;
   serviceName?: string;
   asynclocalstore?: object;
+  {{- if .HasSecurity}}
+  credentialsProvider?: CredentialsProvider;
+  {{- end}}
 }
 
 interface DiscoveryOptions {
@@ -0,0 +1,369 @@
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/awslabs/goformation/v2/cloudformation/resources"
+	"github.com/go-openapi/spec"
+
+	"github.com/Clever/wag/v9/server/gendb"
+	"github.com/Clever/wag/v9/swagger"
+)
+
+// Kind identifies the category of a breaking change.
+type Kind string
+
+// The kinds of breaking changes Compare reports.
+const (
+	OperationRemoved     Kind = "operation-removed"
+	OperationIDChanged   Kind = "operation-id-changed"
+	ParameterRemoved     Kind = "parameter-removed"
+	ParameterRequired    Kind = "parameter-required"
+	ParameterTypeChanged Kind = "parameter-type-changed"
+	SuccessTypeChanged   Kind = "success-type-changed"
+	HeaderRemoved        Kind = "response-header-removed"
+	HeaderTypeChanged    Kind = "response-header-type-changed"
+	DefinitionRemoved    Kind = "definition-removed"
+	PropertyRemoved      Kind = "property-removed"
+	KeySchemaChanged     Kind = "key-schema-changed"
+)
+
+// BreakingChange is a single difference between two specs that would break
+// existing clients or stored data.
+type BreakingChange struct {
+	Kind Kind `json:"kind"`
+	// Location is either an operation ("GET /books") or a definition ("#/definitions/Book").
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+// String returns a human-readable description of the change.
+func (c BreakingChange) String() string {
+	return fmt.Sprintf("%s: %s", c.Location, c.Message)
+}
+
+// Compare returns the breaking changes between oldSpec and newSpec, sorted by
+// location. Operations are matched by method and path, definitions by name.
+func Compare(oldSpec, newSpec spec.Swagger) ([]BreakingChange, error) {
+	var changes []BreakingChange
+
+	opChanges, err := compareOperations(&oldSpec, &newSpec)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, opChanges...)
+
+	defChanges, err := compareDefinitions(oldSpec, newSpec)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, defChanges...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Location < changes[j].Location
+	})
+	return changes, nil
+}
+
+func compareOperations(oldSpec, newSpec *spec.Swagger) ([]BreakingChange, error) {
+	var changes []BreakingChange
+	newOps := operations(newSpec)
+	oldOps := operations(oldSpec)
+	for _, key := range swagger.SortedOperationsKeys(oldOps) {
+		oldOp := oldOps[key]
+		newOp, ok := newOps[key]
+		if !ok {
+			changes = append(changes, BreakingChange{
+				Kind:     OperationRemoved,
+				Location: key,
+				Message:  fmt.Sprintf("operation %s was removed", oldOp.ID),
+			})
+			continue
+		}
+		if oldOp.ID != newOp.ID {
+			changes = append(changes, BreakingChange{
+				Kind:     OperationIDChanged,
+				Location: key,
+				Message:  fmt.Sprintf("operationId changed from %s to %s", oldOp.ID, newOp.ID),
+			})
+		}
+		changes = append(changes, compareParameters(key, oldOp, newOp)...)
+
+		oldSuccess, err := successType(oldSpec, oldOp)
+		if err != nil {
+			return nil, fmt.Errorf("%s in old spec: %s", key, err)
+		}
+		newSuccess, err := successType(newSpec, newOp)
+		if err != nil {
+			return nil, fmt.Errorf("%s in new spec: %s", key, err)
+		}
+		if oldSuccess != newSuccess {
+			changes = append(changes, BreakingChange{
+				Kind:     SuccessTypeChanged,
+				Location: key,
+				Message:  fmt.Sprintf("success type changed from %s to %s", oldSuccess, newSuccess),
+			})
+			continue
+		}
+		headerChanges, err := compareResponseHeaders(key, oldOp, newOp)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, headerChanges...)
+	}
+	return changes, nil
+}
+
+// compareResponseHeaders reports removed and retyped success response headers, which are fields of
+// the operation's output type.
+func compareResponseHeaders(location string, oldOp, newOp *spec.Operation) ([]BreakingChange, error) {
+	oldHeaders, err := swagger.ResponseHeaders(oldOp)
+	if err != nil {
+		return nil, fmt.Errorf("%s in old spec: %s", location, err)
+	}
+	newHeaders, err := swagger.ResponseHeaders(newOp)
+	if err != nil {
+		return nil, fmt.Errorf("%s in new spec: %s", location, err)
+	}
+	newTypes := map[string]string{}
+	for _, h := range newHeaders {
+		newTypes[h.Field] = h.Type
+	}
+
+	var changes []BreakingChange
+	for _, h := range oldHeaders {
+		newType, ok := newTypes[h.Field]
+		if !ok {
+			changes = append(changes, BreakingChange{
+				Kind:     HeaderRemoved,
+				Location: location,
+				Message:  fmt.Sprintf("response header %s was removed", h.Name),
+			})
+		} else if newType != h.Type {
+			changes = append(changes, BreakingChange{
+				Kind:     HeaderTypeChanged,
+				Location: location,
+				Message:  fmt.Sprintf("response header %s changed type from %s to %s", h.Name, h.Type, newType),
+			})
+		}
+	}
+	return changes, nil
+}
+
+func compareParameters(location string, oldOp, newOp *spec.Operation) []BreakingChange {
+	var changes []BreakingChange
+	oldParams := parameters(oldOp)
+	for _, p := range newOp.Parameters {
+		name := paramName(p)
+		oldParam, ok := oldParams[name]
+		if !ok {
+			if p.Required {
+				changes = append(changes, BreakingChange{
+					Kind:     ParameterRequired,
+					Location: location,
+					Message:  fmt.Sprintf("new parameter %s is required", name),
+				})
+			}
+			continue
+		}
+		if p.Required && !oldParam.Required {
+			changes = append(changes, BreakingChange{
+				Kind:     ParameterRequired,
+				Location: location,
+				Message:  fmt.Sprintf("parameter %s is now required", name),
+			})
+		}
+		if oldType, newType := paramType(oldParam), paramType(p); oldType != newType {
+			changes = append(changes, BreakingChange{
+				Kind:     ParameterTypeChanged,
+				Location: location,
+				Message:  fmt.Sprintf("parameter %s changed type from %s to %s", name, oldType, newType),
+			})
+		}
+	}
+
+	newParams := parameters(newOp)
+	for _, p := range oldOp.Parameters {
+		name := paramName(p)
+		if _, ok := newParams[name]; !ok {
+			changes = append(changes, BreakingChange{
+				Kind:     ParameterRemoved,
+				Location: location,
+				Message:  fmt.Sprintf("parameter %s was removed", name),
+			})
+		}
+	}
+	return changes
+}
+
+func compareDefinitions(oldSpec, newSpec spec.Swagger) ([]BreakingChange, error) {
+	var changes []BreakingChange
+	for _, name := range swagger.SortedKeys(oldSpec.Definitions) {
+		location := "#/definitions/" + name
+		oldDef := oldSpec.Definitions[name]
+		newDef, ok := newSpec.Definitions[name]
+		if !ok {
+			changes = append(changes, BreakingChange{
+				Kind:     DefinitionRemoved,
+				Location: location,
+				Message:  fmt.Sprintf("definition %s was removed", name),
+			})
+			continue
+		}
+
+		for _, prop := range swagger.SortedSchemaProperties(oldDef) {
+			if _, ok := newDef.Properties[prop]; !ok {
+				changes = append(changes, BreakingChange{
+					Kind:     PropertyRemoved,
+					Location: location,
+					Message:  fmt.Sprintf("property %s was removed", prop),
+				})
+			}
+		}
+
+		keyChanges, err := compareKeySchemas(name, oldDef, newDef, oldSpec, newSpec)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, keyChanges...)
+	}
+	return changes, nil
+}
+
+// compareKeySchemas reports changes to the primary and secondary index keys of an x-db
+// table. Changing these requires migrating the table, so any difference is breaking.
+func compareKeySchemas(name string, oldDef, newDef spec.Schema, oldSpec, newSpec spec.Swagger) ([]BreakingChange, error) {
+	location := "#/definitions/" + name
+	oldConfig, err := gendb.DecodeConfig(name, oldDef, oldSpec)
+	if err != nil {
+		return nil, fmt.Errorf("old spec: %s", err)
+	}
+	if oldConfig == nil {
+		return nil, nil
+	}
+	newConfig, err := gendb.DecodeConfig(name, newDef, newSpec)
+	if err != nil {
+		return nil, fmt.Errorf("new spec: %s", err)
+	}
+	if newConfig == nil {
+		return []BreakingChange{{
+			Kind:     KeySchemaChanged,
+			Location: location,
+			Message:  "x-db configuration was removed",
+		}}, nil
+	}
+
+	var changes []BreakingChange
+	if oldKeys, newKeys := keySchemaString(oldConfig.DynamoDB.KeySchema), keySchemaString(newConfig.DynamoDB.KeySchema); oldKeys != newKeys {
+		changes = append(changes, BreakingChange{
+			Kind:     KeySchemaChanged,
+			Location: location,
+			Message:  fmt.Sprintf("x-db key schema changed from [%s] to [%s]", oldKeys, newKeys),
+		})
+	}
+
+	newIndexes := map[string]string{}
+	for _, gsi := range newConfig.DynamoDB.GlobalSecondaryIndexes {
+		newIndexes[gsi.IndexName] = keySchemaString(gsi.KeySchema)
+	}
+	for _, gsi := range oldConfig.DynamoDB.GlobalSecondaryIndexes {
+		oldKeys := keySchemaString(gsi.KeySchema)
+		newKeys, ok := newIndexes[gsi.IndexName]
+		if !ok {
+			changes = append(changes, BreakingChange{
+				Kind:     KeySchemaChanged,
+				Location: location,
+				Message:  fmt.Sprintf("x-db index %s was removed", gsi.IndexName),
+			})
+		} else if oldKeys != newKeys {
+			changes = append(changes, BreakingChange{
+				Kind:     KeySchemaChanged,
+				Location: location,
+				Message:  fmt.Sprintf("x-db index %s key schema changed from [%s] to [%s]", gsi.IndexName, oldKeys, newKeys),
+			})
+		}
+	}
+	return changes, nil
+}
+
+// operations returns the operations of a spec keyed by "METHOD path".
+func operations(s *spec.Swagger) map[string]*spec.Operation {
+	ops := map[string]*spec.Operation{}
+	if s.Paths == nil {
+		return ops
+	}
+	for path, pathItem := range s.Paths.Paths {
+		for method, op := range swagger.PathItemOperations(pathItem) {
+			ops[method+" "+s.BasePath+path] = op
+		}
+	}
+	return ops
+}
+
+// parameters returns the parameters of an operation keyed by paramName.
+func parameters(op *spec.Operation) map[string]spec.Parameter {
+	params := map[string]spec.Parameter{}
+	for _, p := range op.Parameters {
+		params[paramName(p)] = p
+	}
+	return params
+}
+
+func paramName(p spec.Parameter) string {
+	return fmt.Sprintf("%s (in %s)", p.Name, p.In)
+}
+
+// paramType returns a description of a parameter's type that changes whenever the
+// generated Go or JS type would change.
+func paramType(p spec.Parameter) string {
+	if p.In == "body" {
+		typ, err := swagger.TypeFromSchema(p.Schema, false)
+		if err != nil || typ == "" {
+			return "inline schema"
+		}
+		return typ
+	}
+	typ := p.Type
+	if p.Format != "" {
+		typ += "<" + p.Format + ">"
+	}
+	if p.Type == "array" && p.Items != nil {
+		typ += "[" + p.Items.Type + "]"
+		// Changing how the items are joined breaks clients just like changing their type. Wag sends
+		// arrays without a collectionFormat as "multi" rather than the swagger default of "csv" (see
+		// swagger.CollectionFormatSeparator), so compare them as "multi".
+		format := p.CollectionFormat
+		if format == "" {
+			format = "multi"
+		}
+		typ += "(" + format + ")"
+	}
+	return typ
+}
+
+func successType(s *spec.Swagger, op *spec.Operation) (st string, err error) {
+	// OutputType panics on specs it doesn't understand, so surface those as errors
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	if op.Responses == nil {
+		return "none", nil
+	}
+	t := swagger.SuccessType(s, op)
+	if t == nil {
+		return "none", nil
+	}
+	return strings.TrimPrefix(*t, "*"), nil
+}
+
+func keySchemaString(keys []resources.AWSDynamoDBTable_KeySchema) string {
+	var parts []string
+	for _, k := range keys {
+		parts = append(parts, k.AttributeName+" "+k.KeyType)
+	}
+	return strings.Join(parts, ", ")
+}
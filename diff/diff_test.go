@@ -0,0 +1,126 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/loads/fmts"
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareNoChanges(t *testing.T) {
+	s := loadTestFile(t, "testyml/old.yml")
+	changes, err := Compare(s, s)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestCompareAdditiveChanges(t *testing.T) {
+	changes, err := Compare(loadTestFile(t, "testyml/old.yml"), loadTestFile(t, "testyml/additive.yml"))
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestCompareBreakingChanges(t *testing.T) {
+	changes, err := Compare(loadTestFile(t, "testyml/old.yml"), loadTestFile(t, "testyml/new.yml"))
+	require.NoError(t, err)
+	assert.Equal(t, []BreakingChange{
+		{
+			Kind:     PropertyRemoved,
+			Location: "#/definitions/Book",
+			Message:  "property pages was removed",
+		},
+		{
+			Kind:     KeySchemaChanged,
+			Location: "#/definitions/Book",
+			Message:  "x-db index byAuthor key schema changed from [author HASH, name RANGE] to [author HASH]",
+		},
+		{
+			Kind:     DefinitionRemoved,
+			Location: "#/definitions/Publisher",
+			Message:  "definition Publisher was removed",
+		},
+		{
+			Kind:     OperationRemoved,
+			Location: "DELETE /v1/books/{id}",
+			Message:  "operation deleteBook was removed",
+		},
+		{
+			Kind:     ParameterTypeChanged,
+			Location: "GET /v1/books",
+			Message:  "parameter maxPages (in query) changed type from integer to string",
+		},
+		{
+			Kind:     ParameterRequired,
+			Location: "GET /v1/books",
+			Message:  "new parameter genre (in query) is required",
+		},
+		{
+			Kind:     ParameterRemoved,
+			Location: "GET /v1/books",
+			Message:  "parameter available (in query) was removed",
+		},
+		{
+			Kind:     HeaderRemoved,
+			Location: "GET /v1/books",
+			Message:  "response header X-Request-Cost was removed",
+		},
+		{
+			Kind:     HeaderTypeChanged,
+			Location: "GET /v1/books",
+			Message:  "response header X-Total-Count changed type from int64 to string",
+		},
+		{
+			Kind:     SuccessTypeChanged,
+			Location: "GET /v1/books/{id}",
+			Message:  "success type changed from models.Book to models.Author",
+		},
+		{
+			Kind:     OperationIDChanged,
+			Location: "POST /v1/books",
+			Message:  "operationId changed from createBook to addBook",
+		},
+		{
+			Kind:     ParameterRequired,
+			Location: "POST /v1/books",
+			Message:  "parameter newBook (in body) is now required",
+		},
+	}, changes)
+}
+
+func TestCompareCollectionFormat(t *testing.T) {
+	withFormat := func(format string) spec.Swagger {
+		param := spec.QueryParam("ids").CollectionOf(spec.NewItems().Typed("integer", ""), format)
+		op := spec.NewOperation("getBooks").AddParam(param)
+		op.Responses = &spec.Responses{}
+		return spec.Swagger{SwaggerProps: spec.SwaggerProps{
+			BasePath: "/v1",
+			Paths:    &spec.Paths{Paths: map[string]spec.PathItem{"/books": {PathItemProps: spec.PathItemProps{Get: op}}}},
+		}}
+	}
+
+	// Arrays without a collectionFormat are sent as multi
+	changes, err := Compare(withFormat(""), withFormat("multi"))
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	changes, err = Compare(withFormat(""), withFormat("csv"))
+	require.NoError(t, err)
+	assert.Equal(t, []BreakingChange{{
+		Kind:     ParameterTypeChanged,
+		Location: "GET /v1/books",
+		Message:  "parameter ids (in query) changed type from array[integer](multi) to array[integer](csv)",
+	}}, changes)
+}
+
+func init() {
+	loads.AddLoader(fmts.YAMLMatcher, fmts.YAMLDoc)
+}
+
+func loadTestFile(t *testing.T, filename string) spec.Swagger {
+	doc, err := loads.Spec(filename)
+	require.NoError(t, err)
+	return *doc.Spec()
+}
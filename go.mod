@@ -13,7 +13,9 @@ require (
 	github.com/go-openapi/swag v0.22.3
 	github.com/go-openapi/validate v0.19.15
 	github.com/go-swagger/go-swagger v0.23.0
+	github.com/gorilla/mux v1.8.0
 	github.com/stretchr/testify v1.11.1
+	gopkg.in/yaml.v2 v2.4.0
 )
 
 require (
@@ -51,7 +53,6 @@ require (
 	golang.org/x/text v0.3.7 // indirect
 	golang.org/x/tools v0.1.13-0.20220908144252-ce397412b6a4 // indirect
 	gopkg.in/ini.v1 v1.54.0 // indirect
-	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
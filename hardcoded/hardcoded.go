@@ -1,6 +1,6 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// ../_hardcoded/doer.go (4.105kB)
+// ../_hardcoded/doer.go (18.174kB)
 // ../_hardcoded/middleware.go (1.695kB)
 // ../_hardcoded/tracing.go (6.855kB)
 
@@ -70,7 +70,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var __hardcodedDoerGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x4d\x6f\xdb\x38\x13\x3e\x4b\xbf\x62\x6a\xe0\x45\xa5\xc4\x96\x9c\xbc\x4d\x5b\xa4\xeb\x43\x9b\xec\xa2\x7b\x68\x52\x34\xdd\xdd\x02\x41\x80\xd2\xd2\x28\x66\x43\x93\x2a\x49\xc5\x31\xd2\xfc\xf7\xc5\x90\x94\x2c\xa5\x4e\xb0\x5f\x87\xdd\x1c\x1c\x89\xe4\x3c\xf3\xcc\x07\x67\x46\x35\x2b\xae\xd8\x25\x42\x21\x38\x4a\x1b\xc7\x7c\x59\x2b\x6d\x21\x89\xa3\xd1\x7c\x6d\xd1\x8c\xe2\x68\x54\x28\x69\xf1\xc6\xd2\x23\x57\x39\x57\x8d\xe5\x82\x5e\x96\xcc\x2e\x72\xcd\x64\x49\x2f\x12\x6d\xbe\xb0\xb6\xa6\x67\xcb\x97\x38\x8a\xd3\x38\xce\x73\x28\x15\x6a\xe0\x06\x98\x04\x2e\x2d\xea\x8a\x15\x08\x95\xd2\x30\x2a\x15\x97\x97\x23\x20\x21\xd0\xf8\xb5\x41\x63\x0d\xd4\xca\x18\x3e\x17\x6b\x58\x71\xbb\x80\x95\x66\x75\xcd\xe5\x65\x6c\xd7\x35\x06\xa8\x0e\xe4\x36\x8e\x8e\x55\x52\xc0\x0e\x21\x64\x47\xce\x80\x31\xe8\xf0\xfe\xc1\x23\xa6\x90\xb4\xef\xa6\x56\xd2\xe0\x18\x50\x6b\xa5\xd3\xf8\x2e\xf6\xa8\xaa\x3e\x61\x4b\x3c\xb2\x37\x60\xac\x6e\x0a\x7b\x7b\xe7\x78\xcf\x99\xc1\x80\xf1\x96\xc9\x52\xa0\x86\x1a\x75\xa5\xf4\xd2\x80\x5d\xa0\xdb\x1f\x70\xf7\x68\xb4\x7c\x4c\x3c\x37\x60\x55\x23\x0b\x48\xca\x6e\x2b\x85\xbf\x41\x9b\xac\xd6\x68\x1b\x2d\xa1\xc8\x8e\x55\xe2\x0d\xc9\x73\xd0\x68\xf5\xba\x65\x4a\x2f\x1c\x0d\x1c\x4c\x3f\x0d\xfd\xeb\x49\xba\xb3\x3d\x96\x04\x5a\xc2\xe6\x8f\x1c\xed\xd4\xe8\xf5\x7b\x25\x78\xb1\x86\x0f\x9b\xe7\xa0\xaf\xb7\x02\x25\x56\x5c\xa2\x01\xe6\x91\xa1\x76\xcb\x99\x57\xd6\x3f\x38\x08\x5e\x9e\xc3\x1b\x56\x5c\xa9\xaa\x32\xe0\x4d\xf2\x9e\x95\xcd\x72\x8e\x1a\x98\x2c\xc1\xf2\x25\x97\x97\xa0\xaa\x00\xcc\xac\xc5\x65\x6d\x4d\x16\x47\xad\x68\x92\xc2\xf9\x05\x25\x5c\x76\xdc\x68\x66\xb9\x92\x0e\xd9\x69\x05\x8d\x05\xf2\x6b\xf4\xb8\x7d\x47\x8c\x81\x19\x58\xa1\x10\xf4\x9f\x36\x35\x9a\x46\x58\x50\x95\x93\x6e\x93\x39\x84\xe7\xa9\x81\xcf\xc7\xea\x33\x2c\xd1\x2e\x54\x99\xc5\x91\x43\x4f\x06\x11\x1b\xc3\x03\x01\x9b\x2b\x25\x82\xcf\xce\xb8\xbc\x14\xf8\x98\xe7\xec\x82\xd9\x2e\x7a\xac\x65\x0b\x4a\x16\xe8\xbd\xf9\x3d\xc4\x20\x6b\xb7\x38\x94\x59\x58\xab\x06\xcc\x42\x35\xa2\xec\xd4\x60\x87\xbd\x67\xb0\x50\xb2\x04\x56\x59\xba\x5e\x16\x2a\xc6\x85\xc9\x42\xda\x7e\xa7\x2f\x85\x87\x3d\xdf\x4b\xce\x7b\x3b\xb7\x7b\xb0\x03\x6e\xe5\xcc\x69\xbb\xeb\x67\x11\xac\xb8\x10\x81\x99\x54\x72\xf2\xfe\xf4\xec\xe3\xd8\x3f\xbd\xfe\x78\xf4\x76\x53\x1c\x9c\x31\x07\x9f\x3e\x65\x24\xfa\xf1\xf4\xf8\xf4\x10\x7e\xb6\x94\xad\x06\xa4\xb2\x50\x34\x5a\xa3\xb4\x62\xdd\x66\x8b\x5c\xfb\x20\xb4\xee\xc0\x12\xe6\xeb\x07\x82\xfb\x88\xc1\x3e\xda\x1a\xbf\xc2\xbd\x88\x6b\x34\xf5\xb6\xb0\xf7\x43\x4f\x3e\xe1\x95\x5b\x7d\x32\x03\xc9\x05\x7c\xfb\x46\x16\x65\xef\x5c\x32\xc1\x6c\x06\x23\x32\x78\xb4\x6d\x9d\xcc\xa7\x8d\x38\x8a\x48\x57\x76\x66\x99\x6d\xcc\x91\x2a\x11\x7e\x80\x83\xe9\x94\xc0\x5b\x8f\x57\x4c\x18\x8c\xa3\xbb\x2e\x04\x56\x37\x18\xdc\xfc\xe3\x4d\xad\x24\x4a\xcb\x99\xd8\x9a\x7d\x12\x70\x73\x62\x70\x89\x7d\xd6\x3d\x20\xff\x78\xea\x55\xfc\x9a\xea\x61\x58\x75\xf5\xbc\xa7\x45\x50\x35\x28\x34\x32\x43\x37\x7c\xc5\xb8\x75\xf9\x61\x5c\xed\x45\xbb\x42\x94\x5d\xe0\x0f\x61\x6f\x3a\x1d\xc3\x3e\xfd\x3c\xa3\x9f\x97\xf4\x43\x05\x62\xef\xf9\x74\x0a\x4b\x2e\x04\xf7\x59\x6c\x60\x37\x9f\x40\x53\x83\x55\x70\xf0\x3f\xf8\xc2\xad\x45\xdd\x86\x76\xbb\x15\x7f\x20\xa1\xe1\x70\x06\x4b\x76\x85\xc9\xbd\xed\x31\x1c\xa4\x71\x24\xf1\xc6\x9d\xd8\x9b\x4e\xdb\x24\x7f\xb7\x61\x14\x47\x5a\x96\xb4\x4d\x8d\x32\x3b\xc1\x55\xd2\x3e\x9c\xa9\x46\x17\x98\x38\x81\x13\xb5\x4a\xd2\xec\x17\xc9\x6f\x4e\x98\x54\x49\x9a\xa6\x71\x84\x24\x35\xcd\xa6\x07\x90\xe7\xce\xac\x03\xea\x40\x05\x4a\x1b\xec\x8a\x23\xea\xa3\x3c\x80\x5f\xba\xba\xde\x66\xc4\x39\xbf\x80\x19\x38\x6a\xbb\x30\x20\x9d\x24\x89\x96\x65\xf6\x93\x50\xcc\x3e\x7f\x96\xa4\x3b\xfb\xe9\x64\x2f\xdd\xc1\x9d\x2a\xac\x90\x10\xe9\xf7\x86\xed\xcc\x60\xbf\x9f\x55\x1a\xed\xbf\xf6\xee\x3e\x14\xe0\xff\xfe\x05\x3e\x51\xdb\xbb\x86\xbf\xa4\xd4\x20\x25\x5e\x87\xc6\xbf\xa6\x0b\xd5\xf5\x8e\xd0\x85\x87\x00\x8f\x5f\x5c\x2a\x07\xcb\xda\xae\xc1\x08\x5e\x60\xeb\xdc\x01\xc2\x5f\xec\x02\xc3\xb2\xcf\xc4\x8a\xad\x7b\xf5\x82\xec\x7f\x40\xdb\x9f\x6d\xb8\x3d\x0e\xde\xad\xed\xb4\xe7\x1c\x74\xe4\x07\xda\xa1\x1b\x7e\xe3\x76\xd1\xf7\x51\xe7\x0d\x90\xb8\x82\x30\x03\xfb\x34\x56\xd7\xa8\x35\x2f\xc3\x5c\xe1\x47\x67\x50\xf3\x2f\x58\xd8\xa7\xa6\x1b\xc6\xba\x31\xc8\x59\x74\x0f\x3e\x29\xec\x4d\x0b\x9a\x05\x3e\x63\x78\x60\xe4\x4a\xef\x9f\xec\x4f\x81\x61\x87\xf0\x7f\x65\xa2\x41\x42\x1e\x0f\xec\xbc\xbd\x1b\x20\xbb\x89\xb1\x1d\x4a\x77\xba\x59\xf0\x9f\x18\x4b\x5b\x15\x63\x50\x57\xae\x2c\xb5\x84\x93\x34\xf3\xe4\x86\xbc\xd2\x2c\xe9\x9b\xe9\x2e\xda\x13\x75\xd5\xde\x8a\xce\x17\x33\x28\xb3\xde\xbb\xbb\x24\x5d\x67\x21\x3d\x9b\xbd\x6c\x93\x98\x71\x74\xcd\xf4\xb6\x3b\xee\x37\xba\x7b\x1e\xbb\x99\xef\x8c\x5d\xe3\x60\x30\x9a\xab\x92\x7a\x14\x14\x34\xe9\xaf\x10\x16\xee\x80\xf2\xca\x32\x38\xb5\x0b\xd4\x2b\xee\xf7\x5c\x15\x74\x07\x98\xd0\xc8\x4a\x4a\x1f\x56\x3a\x5c\xf7\xb1\xd0\x54\x15\x6a\x50\xb2\xab\x6e\xe5\x40\x95\x13\xa7\xb9\x2b\x83\x33\x44\x27\x46\x84\x0f\xf3\xdc\x58\x32\xe7\x1a\x75\x25\xd4\x2a\x2b\xd4\x32\x77\x12\x5c\x49\x93\xef\xff\x7f\xfa\x62\xfa\xf2\xc5\xf3\x9c\x74\x71\x79\x39\x21\xc6\x13\x55\x4d\x48\x76\x12\xb0\x27\xd4\x78\x55\x63\x27\x4b\x55\xf2\x8a\x6a\x43\xb7\x63\x2c\xb3\xc1\x17\xf3\xa6\x82\xf3\x0b\xfa\xe2\x73\x31\xd0\xd9\x1b\x32\x3e\xd4\x3b\x8a\xc6\xd0\x61\x51\x34\x6f\x2a\x5f\x29\x67\xe0\x3f\x08\xb3\x0f\xc8\xca\xd7\x42\x24\x5e\x96\xfa\xc7\xb0\x6a\x12\x4a\x9b\xb4\x92\x0b\x27\x1d\x47\x14\xc9\xbb\xd8\x77\xb2\x76\xee\xa5\xb6\xf7\xca\x55\xbf\x57\xed\xda\xee\xae\x93\xdf\x4a\x2d\xd2\xa5\x26\x99\xc0\xe3\x44\xd5\x47\x42\x19\xd4\x89\xfb\x80\xa5\x4e\xfb\xc6\xb9\x3f\x99\x37\x95\xeb\x6b\x51\xc0\x98\x81\x2e\x03\x05\x57\x9d\x5b\x83\xca\xac\xa4\x4f\xab\x62\x0c\x3a\x98\xd1\x32\x9b\xcd\x40\xa0\x4c\xda\xd4\x4b\xa9\xe4\x3f\xe9\x27\x5f\xe8\x34\xbe\xb3\x38\xbc\xd4\x73\x9c\x6b\x64\x57\x41\x57\x9e\x83\x63\xd8\x7e\x78\xb8\x9c\x0c\xe9\x56\x6d\x16\xb8\xef\x8c\x92\x8b\x96\x84\xa9\xef\x39\xd3\xd4\xce\x92\xcc\xc1\x51\xc2\x3b\x7c\x3f\x67\x0b\xc4\xba\x63\x7a\x1e\x2c\xb8\x48\x87\xbd\x3c\x90\x8c\xef\xe2\xdf\x03\x00\x00\xff\xff\xb2\x68\x07\x88\x09\x10\x00\x00")
+var __hardcodedDoerGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x3c\x7f\x6f\xdc\xb8\xb1\x7f\xaf\x3e\xc5\xdc\x02\x67\x4b\xce\x5a\x76\xd2\xcb\xb5\xcf\x77\x7b\x40\x7e\xbe\x0b\xd0\x4b\x82\x73\xae\x2d\x5e\x10\xb4\x5a\x89\xf2\xb2\xd1\x92\x5b\x92\x9b\xb5\xeb\xf3\x77\x7f\x98\xe1\x90\xa2\x76\xb5\x4e\xae\x2d\x1e\xf0\x0e\x45\xbc\xa2\xc8\x99\xe1\xfc\x9e\x21\xd5\x75\x55\x7f\xac\xae\x04\xd4\x9d\x14\xca\x65\x99\x5c\xad\xb5\x71\x90\x67\x93\xe9\xe2\xc6\x09\x3b\xcd\x26\xd3\x5a\x2b\x27\xae\x1d\xfe\x14\xc6\x68\x43\x83\xed\x8a\x06\xa4\xf6\xff\x9e\x49\xbd\x71\xb2\xc3\x87\x55\xe5\x96\x67\xa6\x52\x0d\x3e\x28\xe1\xf8\xcf\xd9\xd2\xb9\x35\xfe\xb6\xce\xd4\x5a\x7d\xa2\x9f\x37\xaa\xf6\x7f\x6d\x5d\x75\xb4\xda\xc9\x95\x98\x66\xd9\x64\x5b\x77\x30\xbd\x92\x6e\xb9\x59\x94\xb5\x5e\x9d\x3d\xeb\xc4\x27\x61\xce\xb6\xd5\xd5\x59\xa7\xaf\xae\xa4\xba\xc2\xdf\x9e\x6c\x1c\x10\x66\x9a\x15\x59\x76\x76\x06\xcf\xb5\x30\x20\x2d\x54\x0a\xa4\x72\xc2\xb4\x55\x2d\xa0\xd5\x06\xa6\x8d\x96\xea\x6a\x0a\x48\x08\x18\xf1\x8f\x8d\xb0\xce\xc2\x5a\x5b\x2b\x17\xdd\x0d\x6c\xa5\x5b\xc2\xd6\x54\xeb\xb5\x54\x57\x99\xbb\x59\x0b\x06\x15\x81\xdc\x66\x93\xe7\x3a\xaf\xe1\x04\x21\x94\xcf\x08\xf7\x0c\x0c\x3f\xff\xec\x21\x16\x90\x87\x67\xbb\xd6\xca\x8a\x19\x10\xd7\x8a\xec\x2e\x92\xf7\x72\xa3\x6a\x26\xb1\x6a\xaa\xb5\x13\x06\x9c\x86\x8d\x15\x50\x41\xbb\x51\xb5\x93\x5a\x41\x65\xa1\xa2\xd9\x65\x4f\x0c\x2d\xc4\x19\xff\x3a\x15\x9e\x06\x40\x7e\x5b\x68\xf3\x7a\x06\xa6\x28\x33\x84\x09\x79\x1b\x91\x14\xf0\x6f\xec\x14\x6e\xb3\x89\x11\x6e\x63\x54\x40\xc0\x7b\xff\x49\x36\x4d\x27\xb6\x95\x11\xc4\x68\x0b\x6e\xc9\x4c\x76\xcb\xca\xc1\xaa\xfa\x28\xfc\x58\x94\x8e\x6e\xa1\x62\xe5\x3c\xb6\xa0\xd7\xc2\x54\xc8\x1c\x3b\x03\x51\x5e\x95\xc8\x35\x2b\xaf\x54\x9c\x8f\x9b\xd3\x06\x8c\xa8\xb5\x69\x60\x25\x9c\x91\xb5\x2d\xe1\x4d\x58\xf7\xd2\xe8\xd5\x33\xaf\xce\xe0\x29\xf4\xf8\x22\x60\xa8\x02\x2c\x94\xcf\xaa\x6a\x48\x75\x58\x02\x09\xf9\xc8\xaf\x5c\x89\x6b\x47\xe4\x23\xb7\x84\xc9\xfc\x2c\xbd\x7e\x5d\xad\xc4\x33\x77\x0d\xd6\x99\x4d\xed\x6e\xfd\xde\x23\x0d\xf8\x76\x80\x5c\xe1\x80\x6e\x87\x84\xf0\x06\xa7\x57\xc2\x3d\xd5\xfa\xe3\xd3\x9b\x57\xcf\xa7\x33\xcf\xa6\x9e\x44\x52\x59\xb7\x14\x08\xff\x4a\x7e\x12\x0a\xd8\x56\x53\xe2\x67\x80\xba\x3f\x05\xd9\x82\x74\x20\xad\x3a\x46\x4e\x37\x02\x16\x37\xa3\xbc\x65\x65\x18\xd0\x9b\xd7\xee\x3a\xc0\x2e\x99\x81\x05\xee\x4f\xaa\x2b\x94\x36\x6e\x61\x06\x7f\x85\x8b\x39\xd4\xee\xba\xfc\x53\xd5\x6d\x44\x1e\x19\x71\x7b\x57\x94\xb9\x9f\x5c\x44\xcd\xc0\x25\xd9\x5d\xe4\x19\x23\x3b\xcc\x36\xdc\x52\x45\x6a\x8b\x52\xd7\x4a\x1c\xd4\x0d\x96\x56\xbf\xd2\x03\x44\x3a\xcf\xce\x00\x69\x02\xf9\x1b\x39\x5f\x66\x13\x5a\xe7\x37\x41\x70\x5e\xa9\xf5\xc6\x05\x40\x92\x1e\x06\x60\x60\x5b\x59\x22\x57\x34\xe4\x5a\x18\x6c\x05\x27\x2b\xdd\x88\xce\x96\xff\xdd\xc3\x27\x58\x24\x27\x25\x3b\x90\x2d\x21\x18\x42\x6b\xb4\x20\xc9\x2d\xab\x4f\x02\x2a\x75\x53\x66\x13\xa6\x20\xb8\x27\x64\xd8\x64\xb5\x01\xff\x9f\xbd\x51\x75\xf9\xd3\xc6\x89\xeb\x6c\xa2\xd5\xcf\xc2\x6e\x3a\x07\xef\x3f\xa0\x68\x73\xbd\x71\x3b\x2b\xc9\x47\x05\x0f\xb1\xc3\xf8\xcf\xdb\xcc\x01\xad\xbc\x5f\x25\x11\x87\xdf\xed\xbf\xa6\x96\x09\x59\xe3\xda\x79\x12\xa7\xa2\xe4\x23\x9c\x11\x35\xe5\x37\x41\x53\xfb\x85\xbd\xb6\xc6\x49\x81\x3b\x81\xa5\x55\xd3\xd8\xd4\x6b\x23\x2f\x8e\x07\x82\x87\x2d\xb2\x67\xc8\xb1\xc0\x47\xad\x6a\x81\xdb\x5f\x56\x16\x1a\x51\xeb\x46\x34\xc1\x9e\x0d\x3b\xd5\x0b\x90\xe8\x07\x37\x6e\xa8\x22\xb8\x6a\xa0\x13\x64\x11\x06\x87\xdb\x4a\x76\x76\x06\x95\x6a\x68\x29\x49\xb5\x84\x97\xda\xf4\x04\x58\xc4\x80\x5a\x09\xeb\x0a\x63\x29\xc8\x84\x68\x8c\x95\xa2\xaa\xe9\x9d\x60\xb6\xe7\x3a\xe1\x67\x11\xb7\x9f\xb7\xf0\x05\x1a\x55\x90\x00\xca\xd5\xa6\xfc\xa3\xae\x3f\xe6\x45\x36\x69\x44\x2b\x0c\xd0\xd0\x2f\xaa\xe3\x41\x5d\x46\x45\x9d\x43\xb5\x5e\x0b\xd5\xe4\xfd\xd8\x0c\x5a\x52\xcd\x31\x72\x5a\xa9\xa4\x5d\x7e\x86\x0a\x24\x42\xb6\xf8\x00\x5f\xcd\x89\x8b\xb7\xd9\x64\xc2\x6b\x68\x20\x9b\xdc\xed\xd0\x19\x09\xba\x98\x43\x4f\x4a\x36\xd9\xa1\x1c\x39\xf6\xd7\x19\xb4\xe8\xff\x4c\xa5\xae\x04\xc4\x85\x88\xa3\x65\xca\x88\x27\x05\x62\xf1\x4a\xb4\xa8\xac\xe0\x58\xfa\x63\xa5\x9a\x4e\x18\x58\x0b\xd3\x6a\xb3\xf2\x16\x86\xef\x07\xd9\x8a\x77\x6c\x38\x8c\xf1\x26\x71\x94\x9e\x29\x4d\x7c\xf5\x9f\x0a\xdf\x75\xf9\x5c\xe7\xd1\x25\x18\xe1\xcc\x4d\xa0\x14\x1f\xa4\xb0\xf0\xf8\xfc\x2f\xc3\x8c\xca\x13\x89\xaf\x6f\x12\x2a\x91\xfb\x0d\xfb\x26\xfc\x1f\xbe\x22\xeb\x32\x37\x6f\x75\x27\xeb\x1b\xf8\xb9\xff\xcd\xf8\x9e\x8b\xb6\xda\x74\xee\xa7\xea\x9a\xde\x3d\x69\x31\x51\x62\x8f\xdb\x69\x75\x85\x21\x10\x83\xa1\x33\x37\xb0\xad\x50\xd7\x51\x10\xdb\xa5\x50\xe4\x8d\xbc\xfd\x1c\x5b\x0f\xf9\xd4\x2f\x5f\x8a\xaa\x11\x06\x2a\xfb\x91\x6c\x40\x3a\x8c\x26\xb8\xd8\x43\x34\x18\x62\x15\xd9\xcd\xa2\xaa\x3f\xea\xb6\x2d\x81\x68\xc2\xad\xd6\x95\x02\x2b\xc8\x96\xa5\x01\xbd\x55\xb0\xaa\xae\xe5\x6a\xb3\xf2\x76\x5e\x21\xc0\xbf\x0d\xa8\xcd\x0b\xc0\x84\xb6\x7c\xbe\xf1\xaa\xfa\x37\xcc\x49\x96\xba\x29\xb3\x5a\x2b\xeb\xc6\x77\x38\x87\xdf\x9d\xc3\x89\x5f\x78\x29\x6a\xad\x1a\xe2\x46\xc2\x1f\x68\x44\x2b\x95\xb0\x71\xf7\x6b\x1a\xe6\xc0\x97\x4e\x8c\xb6\xc0\xd1\xef\xa9\xdf\x94\x1d\x78\x72\xb5\x59\x2d\x90\x27\xaa\x41\xa4\xe8\x0e\x74\xcb\x80\x2b\xe7\xc4\x6a\xed\x6c\x99\x4d\xc2\xd2\xbc\x80\xf7\x1f\x06\xbb\x22\xc8\x84\x15\x33\x2f\x21\x3f\x71\x16\x97\xaa\xc5\x0c\xf3\xd9\xad\xe8\x3a\xfc\x8b\xca\x6d\xc8\x81\x80\xf6\xc1\x2e\x14\x08\xac\xac\xc7\x16\xfe\xf6\x5c\xf7\xdc\x9a\x10\xf4\xa8\xaf\x0c\xf1\x80\xfa\x2e\xb4\xee\x58\x83\x2e\xa5\xba\xea\xc4\x7d\x9c\x43\x7f\x1d\x75\xb9\x8f\x61\xe8\x98\x3d\x37\xf7\x41\x0c\xf2\x93\x11\x86\x56\x0e\x6e\xf4\x06\xec\x52\x6f\xba\x26\xa2\x89\x49\x2d\x3c\xb4\x24\x54\xa8\x48\xda\xc1\x61\x07\x47\xbb\x87\xaf\x80\xc3\x9c\x4f\x4c\x75\xe7\xcd\xed\xc3\xa1\x0a\x05\x9f\x43\x80\x61\x2b\xbb\x8e\x29\x63\xaa\x98\xf0\x6f\x1e\xfd\x17\x2b\xf3\x88\xd5\xf8\x98\xa2\xb4\x3a\x7d\xfb\xe6\xf2\xdd\xcc\xff\x7a\xf2\xee\xd9\x8f\x61\x6b\x64\x52\x01\xce\x0c\x1e\xff\xe5\x2f\x18\xb0\x30\x1e\x05\xa0\xb5\x56\x4a\xd4\x1c\x03\xd1\x96\xb4\x21\x2a\xf5\xc6\xdd\xc3\x00\x7a\xc8\x8d\xf8\xc7\xd0\x83\xcd\xc8\xc8\xc7\xd4\x20\x55\x85\x84\x47\x8d\x37\xb7\x08\xce\x03\x60\xb7\xec\xf9\xf3\xe2\x7a\xad\x95\x50\x4e\x56\xdd\xa8\xda\x28\x10\xfd\x0c\x66\xa1\xb7\x3e\xaf\x2e\x07\xd6\xdf\xaf\x33\xad\xfc\x84\x6e\x9d\x47\x89\x55\x09\x96\x0e\xcd\xb8\x36\xa2\xb2\x68\x9a\xe4\xab\x90\x65\xc4\xec\x85\x70\x5b\x21\xfa\x02\xe8\x02\x1e\x9e\x9f\xcf\xe0\x11\xfe\xf3\x0d\xfe\xf3\x07\xfc\x07\xc5\xf6\xf0\xdb\xf3\x73\x58\xc9\xae\x93\x5e\xfd\x2c\x3c\x38\x3b\x85\xcd\x1a\xfd\xdf\xe3\xaf\xe1\xef\xd2\x39\x61\x82\x0c\xc6\x77\xf1\x05\x9a\x88\x11\x10\xcb\xb8\x7c\xe7\xf5\x0c\x1e\x17\xd9\x84\x0a\xa6\x8b\x39\xd2\x18\xb4\xf3\xa7\x9e\xa2\x6c\x62\x54\xc3\x21\xb4\x29\x5f\x8b\x6d\x1e\x7e\x5c\xea\x8d\xa9\x45\x4e\x10\x5f\xeb\x6d\x5e\x94\xbf\x28\x79\xfd\xba\x52\x3a\x2f\x8a\x22\x9b\x08\x5c\x75\x5e\x9e\x3f\x86\xb3\x33\xda\xd6\x63\x0c\xa4\xb5\x50\x8e\xf7\x95\x4d\x30\x32\xc8\x3e\x3e\x23\xa9\x18\x9a\x8d\x70\xef\xe5\x07\x98\x03\x91\xf6\x60\xe8\xad\xf3\x3c\x37\xaa\x29\x5f\x76\xba\x72\xdf\x7e\x93\x17\x27\x8f\x8a\xd3\x87\xc5\x89\x38\x69\x79\x04\x17\x21\x7e\xbf\xb1\x93\x39\x3c\xc2\xe0\x1e\xb4\xcd\x08\xf7\xff\xc6\xe8\x0e\x09\xfc\xff\xd0\xf2\xd2\x29\x21\xca\x13\xfa\x50\xa1\x2d\x36\xb2\x73\xa7\x52\xa5\x56\x27\x85\x2d\xe1\x09\xba\x9b\x98\x0d\x57\xdd\xb6\xba\xb1\xb0\x12\x95\x4a\xdd\x2e\x5a\xcb\xb6\xa2\x19\x6b\xa3\x6b\x61\xad\x68\x62\x4d\xb6\x36\xfa\xfa\x86\xe3\xbb\x6a\x28\x97\xf6\xde\x19\x01\x58\x61\x3e\xa1\x38\x2a\x3b\x03\xab\x01\x7d\x1f\x3a\x82\x06\x48\x0c\x18\x36\x0c\x26\x7c\xdd\x4d\xc8\x91\xa4\x68\x78\x79\x45\x94\x51\x46\xc2\x59\xfe\x98\x88\xc1\x56\x37\x64\xda\x38\xcf\x69\xc0\xdd\x55\x57\x95\x0c\x35\xcf\x2e\xf3\xfe\x55\x59\x48\x8c\xea\xff\x28\x7f\xa2\x14\x04\xe6\x73\x98\xe2\x5e\xa6\xf0\xeb\xaf\x7b\xe3\xb8\xb3\x29\xae\x99\xfc\x75\x06\xfa\x23\x19\x4e\xcc\x50\x72\x44\x38\x83\xde\x1c\xd1\x04\x58\xd0\xa8\x02\x73\xca\xa6\xe1\xe8\x88\xb4\xa4\xbc\x74\x95\xdb\xd8\x67\xba\x11\x88\x93\xc8\xf4\x43\xef\xb4\xfe\xa9\x52\x37\xbc\x0f\x8b\x0b\xf4\x47\xb2\x20\xd9\xfe\x5b\x80\xd8\xb2\x51\xf1\x9c\xd9\x88\x14\x64\x92\xfa\xf3\x0c\x94\xd8\x4d\xb5\xe8\xc4\x0b\xe4\x16\xf2\x97\xd5\x72\x60\xca\x43\xf4\x3f\xcc\xe1\xf1\xf9\x39\x2b\xee\x10\x00\x18\x81\x2d\x54\x0b\xdb\xa5\x70\x4b\xd4\x01\x85\xe0\xe8\x05\xc2\x12\x0d\x56\xb8\x07\xf2\x1d\x69\x47\xcc\x95\x9b\x5a\xc1\x62\x81\xe8\xb4\xd0\x1a\xbd\x4a\x15\xfc\xd8\xc6\x52\x7b\x21\x50\x9d\x1a\x52\x63\x23\x50\xe5\x23\x8d\xac\x52\x43\x9a\xc7\x94\xea\xb0\xfe\x84\xaa\xbb\x28\x5f\x18\x93\x17\x23\x2c\x6d\xab\xce\xa6\x5c\xd7\xc6\x96\xaf\x6c\x2e\x8c\x99\x01\xf7\x7a\xcb\x17\xcf\xde\xbc\x7e\xfd\xf3\x8b\xcb\x17\xef\x0a\x54\xc0\xfb\x67\xbd\xfc\xe5\xf2\xc5\x73\x9c\x97\x4d\x26\x87\x66\xbe\x7d\xf5\xf6\xc5\x18\x28\xa9\xcb\x17\x6f\x5e\x1e\x7a\x63\xcc\x2f\x4a\x5c\xaf\x45\xed\x44\x43\xd3\xc6\x74\xe7\x53\x65\x40\x09\xf7\xc2\xd0\x1f\x5c\xa4\x4d\xd4\x0d\x06\xfa\x84\x81\x1e\xf9\x89\x05\x6a\xb3\xff\x59\xbe\xf3\x92\xcb\x83\xa7\x7b\xad\x47\x53\x0b\xce\xe2\xd1\xdd\x29\x6c\x76\xa3\xc2\x18\x72\x0c\x31\x2f\xe5\x0c\xff\xb5\xfe\xf2\xdc\xa2\x52\x80\x19\xfc\x0d\xd8\x4e\xd6\xb1\x9c\x7f\xad\x7f\x5b\x5c\x1f\xcb\x30\x87\x29\x25\xbb\xdd\x98\xd2\xa0\x0a\x1c\xc0\xf6\x5b\x93\xf9\x84\x06\xaf\x59\xa1\x6d\x48\x0c\x62\x75\x1c\xb2\xe1\xcf\xd2\x2d\x13\x9c\x3d\x37\x40\x89\x6d\xb4\x13\x4a\x55\xf5\x27\x61\x8c\x6c\xb8\x66\xf1\x8d\x27\xd0\x8b\xbf\x8b\xda\x1d\xdb\x68\xdf\xb1\xc4\xa2\x1d\xed\x80\x1f\x6b\x40\xa1\x5b\xee\xf1\x27\x93\x8b\xdd\x99\xc9\xf6\xc2\x1b\x84\xef\x9b\xa8\xb5\xbb\x66\x48\x3c\xfb\xf6\x6e\x00\x39\xe9\x89\x34\x70\x12\xab\xee\xff\x44\x03\x20\xa0\x88\x01\x20\x10\x9c\x17\xdc\x3a\x1b\xd2\x55\x94\x79\xba\x4d\x32\xfe\xaf\xf4\xc7\xe0\x18\xc2\x0b\x98\x43\x53\x26\xcf\xe4\x27\x62\xf2\x1b\x02\x8d\x9f\x5b\xf6\x8a\xe9\xad\x70\x24\xd4\xf9\x17\xd1\x5d\x65\x54\x4f\x5e\x62\x9b\x34\x71\x8e\xb0\xd0\x0d\xa6\xd1\x50\x63\x4f\x65\x2b\x7c\xcf\xcc\x69\x8f\xac\x84\x37\xe8\xaa\xb7\xd2\xbf\xa3\xea\x88\x26\x54\x9d\x11\x55\x83\xf9\x5a\xd5\xc4\xa6\xec\x62\xd3\x52\xf7\x2a\x24\x22\x98\x0a\xa4\xa8\x68\x39\xe6\x5f\x25\x5c\x0a\x41\xcb\x90\xe0\x8b\xb3\x33\xeb\x70\x3b\x9f\x84\x69\x3b\xbd\xa5\x63\x2d\x5a\x81\x4d\xeb\xb3\x47\xbf\x3b\xff\xfd\xf9\x1f\x7e\xff\xed\x19\xe2\x92\xea\xea\x14\x29\x3e\xd5\xed\x29\xae\x3d\x65\xd8\xa7\x98\x26\xea\x8d\x3b\x5d\xe9\x46\xb6\xe8\x1b\xe2\x1b\xeb\x2a\xc7\xbc\x58\x6c\x5a\x78\xff\x01\xcf\xef\x48\x06\xa6\x7c\x8a\x9b\x4f\xdc\xf4\x90\x61\x93\xc9\x62\xd3\x7a\x87\x3f\x07\x7f\x8e\x57\xfe\x2c\xaa\xe6\x49\xd7\xe5\x7e\x2d\xc6\xf7\xfd\xf8\x19\x94\x56\xc9\x8e\x56\x67\x13\x94\xe4\x5d\xe6\x93\xed\x50\x53\x63\x66\xfe\x1d\xb9\xd2\xef\xc2\xd8\x83\x07\x44\xc5\x28\x69\x13\xd3\x18\xb8\x88\x74\xbc\xd6\xeb\x67\x9d\xb6\xc2\xe4\xb8\x1d\x8b\xc5\xc0\x53\x62\x7f\xbe\xd8\xb4\x94\x7a\x4f\x18\xc6\x1c\x4c\x83\x7b\xb9\x23\x6d\xe3\xdc\x92\x74\xad\xc1\x26\x16\x9d\x73\x31\x52\xa6\x6c\x3e\x87\x4e\xa8\x3c\xa8\x1e\x45\x88\xaf\x52\xe5\xe3\x84\x2b\xcd\x55\x3d\x8d\x0b\x23\xaa\x8f\x8c\x8b\x97\x23\xcd\x01\xd2\x7b\xde\xe7\x07\x8f\x0f\x2b\xb6\x2f\x49\xa2\xbe\xc3\x39\x47\x47\xd4\xca\x82\x1f\x02\x34\x8f\xd1\x17\x11\xc8\x6d\xee\x9d\x90\xea\x83\xa9\x50\x6f\x31\xfb\x57\xfb\x4d\x2c\x9c\xc9\x21\xa5\xea\x3a\xbd\xb5\xd9\x24\xd2\x03\x3f\xc0\x2a\x6d\x3a\xe5\xc9\xbe\x79\x93\x71\x97\xb4\xcd\x49\x20\x67\x4e\xeb\x79\xf3\xd8\xa4\xd3\x31\xbf\xc0\x23\x26\x44\xca\x5e\xec\x18\x7b\xda\x55\xd3\x49\x25\x60\x4b\xbd\x90\x75\x65\x2d\x2c\x44\xab\x4d\x30\x4e\xce\xf5\xb1\xf1\xef\x99\x15\x56\x8c\x39\x9d\xe7\xfc\x2e\x8f\xac\xea\xb9\x57\x3e\x69\x9a\x20\xca\xa2\xf4\x69\x6a\x80\x35\x22\xb5\xb3\x33\x20\xbd\x1a\xb2\x13\x4d\x0e\x37\x11\x07\xa4\x05\xa5\x1d\x2a\x67\x50\x1d\xbb\xde\x31\x01\xbb\x26\xfd\x2b\x09\x1c\xba\xa9\xc9\x5d\x6f\x2d\x17\x73\xb0\x9d\x10\xeb\x3c\xd9\xc6\x2c\x48\xb6\xf8\xee\x4b\x2d\x2a\x8c\x47\x3d\xe4\xc8\x3b\x10\x61\x8c\x71\x63\xed\x51\xd6\x83\xbe\x4b\x3a\x56\x87\x70\x70\x3b\xa8\x18\xc3\x28\xb6\x97\x26\xc8\x16\xd6\x03\x3d\x67\x33\xca\xfb\x86\x3c\xdc\xdb\x1d\x85\x3b\x2f\xd8\x24\x05\x5b\x97\x3b\x2b\x52\x76\x8c\x75\x4f\x99\x33\x66\x9f\x2d\x4b\xbd\xa5\x1e\x6f\x5f\x58\x0e\x8b\x30\x3a\x4e\x8c\x92\xb7\x58\x43\x72\x6b\x78\xc6\xe7\x2d\x78\x4a\xa3\x95\x28\xe1\x95\xc3\xbc\x00\xcb\xc5\x05\x1e\xd9\x73\xff\x54\xb7\x10\x5a\x2b\x9a\xd2\xfe\x1f\xdf\xbd\x7b\x0b\x4d\xe5\x42\x1e\xb4\x63\xfc\x3b\x71\x6c\x06\x4a\x6f\xbd\x3f\xc0\x84\xb1\x80\x7c\xc0\x9c\x19\xa5\xe1\x05\x33\x1a\xe9\x0c\xe5\x51\xc2\xae\xf3\x59\x92\x7b\xf3\xbe\x28\xa2\xda\x75\xf9\x23\x3d\xe2\xb1\x63\x3e\x4d\xb6\x3f\x2d\x28\x48\xf0\x64\x2c\xfe\xa6\x07\x21\xca\xb8\xc3\x59\x54\x6f\x7f\x9b\xa3\x7c\xe2\xb4\xcc\x3d\x90\xe2\xbb\x9d\xe2\x2d\x70\xe5\x87\x39\x9c\xa7\xb0\x07\xfb\xcb\x79\x56\x31\xec\x5b\xce\x06\x15\x1c\x32\x33\xa2\x26\xee\xbd\xad\x8c\x15\xc8\xaf\x51\xe4\x1c\x66\x50\xed\x51\x2b\x71\x79\x79\xb9\x59\xe4\x4a\x6f\x8b\xef\x82\x23\x3c\x1f\xd8\x1e\x0e\x06\xa4\x93\xbb\x01\x23\x22\x25\xfd\x50\xcc\x47\xcf\xce\xbc\xa5\x27\x07\x11\x0d\x1e\x73\x0e\xac\xb2\x77\x8c\x14\x79\xbd\x5a\xa1\x9b\xc4\x4a\xad\x95\xc6\x86\x7e\x0c\x81\x1a\xcf\x2a\x9b\x21\xdb\x0a\x06\x75\x9b\x4d\x70\x9c\x18\x83\x3f\x30\x52\x22\x5b\x4c\xde\xc4\xf3\x36\x1c\x37\xe5\xa5\xd3\x6b\xf4\x53\x56\x74\xc2\x9f\x8a\x53\x4e\xf4\xfd\xa9\x7f\xfd\xec\xa2\xdf\x33\xf9\x3d\x7e\x8b\xe7\xfa\xcf\xb5\x12\x79\x91\x4c\xc0\x41\x2a\x02\xfb\x43\xad\x67\xd2\xd4\x1b\xe9\xb0\x56\x8f\xe7\xec\x94\x9b\xf0\x79\xbd\x7f\x0d\x14\x5c\xe2\xdd\x96\xe1\x22\xbc\x88\xe4\xcf\x47\xf2\x6c\xd2\x83\x24\x0f\xdb\x40\x27\x9c\x0d\xb9\x16\xe6\xec\x46\x6f\xae\x96\xd4\x8f\xa9\xf5\x46\x51\x4f\x4d\x48\xdf\x00\xdb\x18\x81\x27\x17\xc3\xf5\xfc\xe4\x09\x9c\x83\xd4\xae\x4a\xb1\xbc\x59\x0b\x45\x8b\x13\x24\x98\x75\x61\xd9\x9d\x4e\xe1\x4c\x0c\x2f\xad\x60\x89\xe6\x96\x62\xd5\xa3\x42\x20\x29\xd0\x1f\xab\xae\xc5\x31\x4f\x7c\x05\xad\xd8\xc2\xda\xe8\x85\xd8\xdf\x88\xd3\x78\x12\x2c\x1b\x11\x1b\x08\x4e\x43\x1d\x63\x55\xed\x01\x12\x70\xee\x11\x4d\x76\x90\xe0\x3d\x28\x72\xe4\xb9\x0d\xf8\x69\xb3\x05\x5c\xd2\xf5\x85\x3c\xbd\xb9\x61\xb7\xd2\xd5\x4b\xb0\x51\x0f\x78\x85\x67\x76\x22\xea\x29\xd1\xd0\x4c\x87\xd3\x10\x5f\x3a\x49\xaf\x85\xda\x99\x12\xc8\x4a\xa7\x2d\xab\xae\x3d\xe5\xb9\xbd\x3d\xb5\x2b\x57\x5e\xae\x8d\x54\xae\xcd\xa7\xbc\x9a\x28\xcf\xbf\x6e\x8a\xe9\x0c\x15\x23\xb7\x45\xa8\xa2\x77\xe4\x21\x43\xed\xc9\x27\xd8\x09\x63\xf1\x76\x82\x6f\x83\x60\x9e\x01\x0b\x51\x57\x78\xd7\xca\x1f\xe3\xed\x28\x24\xaa\x2c\x12\xc6\x8a\xb9\x83\xa4\x3f\xcb\xec\x65\x3b\x76\x99\x84\x81\x5e\x0c\x8f\xfd\x8f\xad\x9f\xd4\x47\x13\x8c\xc6\x78\x8e\xb8\x43\x04\x81\xd7\x6b\x5c\xc3\x07\xf9\xa1\x0f\x29\x6b\x11\xa0\xe8\x50\xb2\xf4\x1a\xc0\x72\xed\x4b\xc2\xdd\x0d\x14\xf8\xac\xcd\x40\x03\xc6\x98\xbf\x43\x0f\x1c\x7f\x6d\x8f\x03\x67\xa6\xd8\x35\x65\x98\x41\x16\xfc\xf8\xd4\x1b\xf5\x1b\x4f\x39\xfa\xae\x56\x5e\xa1\x11\x8e\x18\x3e\xfc\x8f\x30\x1a\x5a\x29\xba\xc6\x02\x8b\x23\x74\x3a\xc3\x15\x9e\x71\xb0\x03\x21\xbc\xd4\xa6\x16\x6c\xd9\x89\xa3\xdd\xa1\x1f\xcf\x71\xb3\x49\x3a\x17\x83\x29\x01\x20\x86\xbc\xf5\xa7\x05\xef\x96\x46\xd8\xa5\xee\x9a\x20\x53\x3e\x45\xc0\x1b\x92\xba\x25\xaf\x20\x9a\xde\x64\xa5\xcf\xb1\xb7\x52\x35\x7a\x0b\x95\x83\xed\x52\xd6\x74\xf5\x85\x20\x87\x1d\x23\xd3\x6c\x09\x5c\x75\x63\x6b\xc4\x5f\x7b\x71\x4b\x71\xc3\x3a\xdb\x37\x09\x31\x73\xa0\x1e\x7e\xc8\x44\xca\x90\xe6\x5c\xc0\xe3\xf3\x32\x9b\x1c\xa0\x57\x39\x3e\x85\x25\x24\x7f\xd2\xdd\x66\x25\xf6\xb6\xb3\x92\x8a\x8e\xab\xfb\x84\xe5\xc0\x5e\x92\x1c\x3d\xec\xa2\xae\x14\xab\xa5\x50\x09\x4d\x8f\x90\xa6\x43\x58\x99\xa8\x3f\x7b\x06\x49\x9f\x83\xb5\x95\xa1\x0c\x78\x54\x50\xec\xc2\x03\x59\x09\xa2\x87\xe7\x21\xf7\x28\xb3\x09\x43\x1c\x44\x42\x42\x75\x89\x81\x73\x88\x2f\xe6\x7c\x01\x97\x75\x98\xdb\xa1\x54\x42\x2d\x82\xa7\xff\xe8\x98\xc7\x5d\x72\x49\x90\x23\x21\x8f\x13\x3a\x52\x74\xfb\xc4\x04\xcf\xc7\xec\xb1\x41\x0c\x3d\xfb\xf7\x10\xe2\x2d\xc8\x8d\x75\x60\x37\x75\x2d\x44\x33\xea\xfb\x4b\x78\x37\xa6\x61\x3e\x1c\xe0\xf1\xba\xd5\xfe\xfa\x28\x5f\x92\xc3\xd0\xc4\x67\xcc\x09\x3b\xcb\x6c\xb2\x4f\x1f\x0b\xec\x8d\x22\xb7\xfb\x6c\x89\x77\x59\x28\xfb\xb5\xc2\xcd\x40\xc6\x2b\x42\x74\xa2\x91\xf2\xb4\xa6\xa9\x68\x9b\x94\xa1\x49\x05\x55\xd3\x48\xb4\x57\xdc\x02\xcd\xa4\x19\x04\xde\xb7\xa7\xe9\xd6\x2e\x5f\x91\xea\x3b\x6f\xc7\xd6\xbf\x30\x65\x36\x19\x90\xe1\x2f\x1a\x05\x7c\xde\x7d\xcd\xa8\x09\x3e\x43\x14\xec\x2a\x68\x41\xf0\x4a\xbc\xd9\x71\x2f\x52\x19\x31\xaa\x81\xec\x76\xbd\x2c\xfe\x99\xf8\x28\xf6\x4d\xe0\x74\x99\x7d\xaa\xcc\xfd\xd0\xe7\xe3\xbe\xeb\xf6\x80\xfd\x5e\x00\x3c\x3e\x9f\x1d\x32\xa4\x0b\x78\x84\x2f\xbd\x5a\x5f\x84\x1b\x34\xf1\xbf\x87\x3b\xb7\x45\x66\x03\xbd\x1c\xcc\x7f\xbc\x37\x73\x57\x07\xc2\xf4\x87\xb3\x3e\x8a\xe8\xf1\xcd\x14\x24\x3b\x66\x03\xb6\x8e\x47\x67\x71\xc1\xa2\xcb\x71\xc7\xf5\x7d\xa8\x09\x0e\x4d\x98\xdf\xcb\xe8\xf1\x45\xa1\x5c\xd0\xe5\x38\x43\x53\xac\x07\x66\x7c\x06\xed\xf8\xaa\x1e\x2f\xfb\x84\x04\x0f\x8f\x7c\x06\xae\x9f\xd5\xc3\x49\x1d\x4c\x02\x2c\x1d\xfe\x0c\xc4\x64\x6a\x0f\x76\xcf\xf2\x13\xd8\x7b\xef\x3e\x83\x60\x77\x7e\x9a\xd1\x69\xb6\x44\x36\xb2\xa7\x9b\xfa\xa3\x18\xf3\x83\x0b\x7e\x91\x84\xa0\x41\xb5\x20\x2d\xd8\x75\x87\x3f\x14\x9a\x9f\x2f\x0e\x76\x80\xe2\xb5\x02\x3e\x17\x18\xbc\x49\xf2\x05\xeb\x2a\xe3\x50\xbb\xa5\x72\xdf\x7e\x83\xa9\x0f\x6f\x91\x1c\x5f\xa8\x18\x10\xc9\x90\xee\xbd\x3a\x46\xab\x88\x24\x38\x0e\x4e\x59\xc2\x68\x8f\x94\x52\x3e\x00\x76\x59\x78\x13\x95\x34\x77\xdc\x5a\x0e\x5d\xe1\xf5\x98\x01\xc2\x2a\xf2\x73\x08\x4b\x28\xd1\x3c\x71\x7d\xdb\x80\x1c\x2c\x85\x95\xcf\x87\x9b\x8e\xee\xb2\xf9\xfa\xc9\x4a\x55\xef\x38\x44\x51\x23\xe5\x31\x55\xa7\x44\x94\xc0\x53\x70\xb2\x56\xec\x82\xa7\x40\x43\x7e\x93\xc3\x97\x68\xca\x6c\xc2\xc4\x78\xae\x67\x93\x7e\x31\xb2\x79\x12\x24\x0f\xf0\x7e\x28\xcf\x0f\x83\xc7\xe8\x8c\x94\xd8\x32\x0b\x72\xd5\xdf\xc8\x9e\x45\xb7\x3d\xca\xd5\x02\x4e\x18\x5a\x92\xf0\x1e\xf1\xd0\x2d\xc2\xb9\xa0\x9c\x3a\x82\xb9\x08\x3f\xca\xa1\x8b\x0b\x45\x2e\xf5\x53\xf7\x4f\x7a\x03\x6b\x43\x6b\x08\xcb\x8d\x59\xa2\x39\x29\x7b\xb7\x15\x72\x00\xd7\xe2\x75\x43\xba\x2d\x4e\x50\x45\x33\x43\x0c\x3e\x95\x94\x96\x7a\xa6\x3e\x11\xf0\x5f\x35\xf4\xf5\x80\x8f\xb9\x21\x73\x8b\xba\x99\x20\xa1\xd0\x4d\x97\xc5\x89\x77\x78\x20\xc4\x6f\x0a\x8f\x2c\xdf\x6d\x39\x61\x5a\x3c\x1b\x28\xd9\x2c\xae\xf1\xe9\x00\x75\xa0\xea\xfd\xcb\xbc\xf5\xf0\x32\x2f\x46\x48\x26\x70\xb8\x9e\xa2\x41\x5d\x7a\x72\xe7\x31\x4e\xa2\x07\x09\xa7\x02\x4a\x6f\xa9\x4b\x53\x97\x41\xbf\x0b\xf8\x1e\xf0\x69\xcf\xa5\x0d\x5a\x37\xd4\x8c\x99\x05\xe0\x33\x6e\xdb\xde\x65\x93\x09\x53\x32\xc7\x77\xc2\xb7\x19\x72\x46\x1c\xdc\x17\xb5\xdf\x8a\xe0\x22\xf7\x09\x0c\xf3\x02\x91\x75\xc9\x8a\xfd\xc3\x3c\x21\x6d\xd7\x19\xde\x4b\x5f\xcd\x0c\x41\x9c\x93\x00\xef\xc1\x83\xd4\x81\x62\xcb\x69\x7f\x45\xe8\x71\xa2\x3e\xf0\x9f\x9d\x4b\x95\x89\x2e\x92\x41\x26\xea\x15\x14\xc6\xdf\xce\x27\xc0\x49\x71\x42\xb3\xfd\x7d\x6a\xc4\xd1\xdf\x82\x61\x21\x52\xd9\xea\x09\x6f\x58\xe7\x30\x93\x92\x57\x4a\x1b\xd1\x8c\x29\x9a\xa7\x6f\xa8\x69\xb3\x40\xcc\x2b\xb5\xa3\x6c\x5c\x63\xa1\x1e\xf6\x56\xcb\x19\xe0\x17\x29\x9e\x6c\x13\xd8\x5f\xcd\x03\xef\xd2\xbe\x63\xb8\xea\xcd\xbd\x8f\x64\xc6\xa1\xbe\x85\x8c\xc5\x5f\x2a\xcf\x7d\x65\x4a\x15\x89\xa5\x1a\xfd\x1d\x0a\x96\x55\x2b\xba\xc0\x2f\xd7\x9d\x7d\x5c\xbe\xe8\x4d\xb1\x8d\xf7\x6f\xac\xfc\x27\xdd\x8f\xa3\x98\x97\xf7\xe8\x7c\xaa\x51\xc0\x6e\x74\xf6\x54\xd2\xaa\x98\x15\x4c\xe8\x71\x0e\x0f\x79\x5b\x3e\x90\x5e\xcc\x11\x79\x72\x17\x0f\xce\x00\x27\xe2\xa9\x18\xc1\x42\xb4\x47\x75\xc9\x3e\xfe\x3d\xad\xfa\x7a\x88\x8d\xcf\xc8\xfc\x14\x14\x84\x71\x78\x22\xe2\x7f\x10\x03\x4e\x18\xd6\x7c\x48\xe7\x2d\x4d\xb9\x40\x1d\x34\xee\x8e\xe9\x62\x30\xac\xfa\xf6\xc1\x83\x7d\xd9\xf1\x94\x10\xec\x69\x0a\x9e\x59\xc6\x64\x60\x16\x5b\x87\x48\xfe\xf9\x0c\xce\xf1\x0a\xbf\xbf\xe1\xbf\xe8\x6f\x10\xc6\x6d\x79\xb0\x58\x23\x21\x25\xa7\x0b\xde\xc4\xf7\x3b\x6c\xe5\x43\xb5\x80\x05\x1e\xcc\x61\x51\x86\x27\x7a\x15\xd1\xd2\xab\xf0\x14\x0e\xe0\xf8\x54\x29\xae\x1f\xa8\xce\x78\x3a\x0a\x47\x47\x59\x02\xf7\x04\xaf\x5d\x0e\x96\x8d\x26\xcf\x27\xe6\xcb\xf4\x6f\x57\xd7\x7b\xa7\x85\xf6\x15\x5c\x54\x27\x50\x29\xd1\xd7\xd8\xbe\xea\x27\x4f\x07\xb6\xd3\x0e\x5c\xf5\x11\xeb\xf0\x9b\xdf\xe6\xb0\x42\xf7\xb5\x77\x83\xe1\x9a\x0c\xf9\x3f\x3c\xe0\x92\x6e\xdc\x19\x11\x41\x79\xef\x22\x86\x1d\xd2\xdf\xee\x62\x46\x22\xc4\xd1\xd1\x7d\xf1\xe3\xe8\x28\x89\x1d\x6c\x5f\x61\xe0\xf4\xb4\x6f\xa4\x0f\x7d\x9f\xbf\x19\x36\x1e\xf1\x63\x9e\x3c\xcc\x41\x79\x65\x9f\x89\x8e\x16\xcb\x8c\x2d\x48\x97\x71\xd8\x83\x39\xc5\x18\x57\xa3\x6a\xf8\x05\x29\xf8\xbd\x53\xad\x93\x21\x75\x4c\x16\xda\x15\xf3\x2c\x9b\x44\xe6\x41\x1c\x60\x7e\xcd\xd1\x18\x53\x07\x4a\x03\xa1\x87\x3d\xe6\xc5\x83\x07\xef\x73\x09\xbc\xec\xab\xb7\xc3\x59\xbd\xb7\xec\xcd\x7a\x7e\x7f\x46\x7a\x3b\x50\xf9\x90\x4c\xfa\x5d\xdd\x22\xa7\x2f\x62\x73\x82\x9c\x94\x13\x3b\x82\xe5\x14\x15\x3f\x84\x19\x3b\x6f\xd8\x39\x5b\xc0\xde\x62\xb7\xdb\xb3\x0e\xbd\xea\xfe\xab\x38\xba\xb0\x64\x97\xa1\xb9\xd1\xb7\x8c\xc3\x92\x8d\xea\x84\x25\xe9\xde\xf0\x1d\x98\xf8\x31\x4b\xf0\x0a\x03\x25\x4a\xa9\xdc\xff\x92\x07\x89\xc7\x93\x24\xc2\x12\x2b\x1c\xdf\xc0\x01\xd8\xd6\x5d\xf9\xe7\xea\xca\x7f\xd2\xf1\x47\x1a\x4c\xeb\x9b\x61\x85\x13\x08\x04\x88\x8a\x95\x7c\xb5\x87\xdf\x0e\xae\xdf\x7b\x04\x1f\xe2\xfb\xa8\xba\x9c\xe7\xe3\xd7\x39\xe1\xe3\x44\x3f\xa0\xdb\x71\x3e\xd0\xd1\x1c\x96\x98\xc9\xa7\x7d\xc7\xc3\x5e\xbc\x6c\x11\x78\x7c\xcb\x9f\x28\x4e\xa7\x78\xfc\x8b\x27\xaa\xc2\x7d\xde\x48\x1a\x38\xd9\xe7\x63\x91\x50\xdc\x7f\x7d\xf8\xa5\x95\x0c\x7e\x47\xb5\xef\xa1\x9a\x3d\x0f\xd5\x03\xee\xcf\x74\x9b\x92\xa9\x81\x79\x5a\x48\x35\x25\xf3\x27\x22\xef\x2f\xf4\x86\x84\xb8\x29\x23\x40\x9b\x9e\xae\x0e\xc7\xc7\xa4\xc4\x86\x92\x4e\x7c\x1f\x7f\x7e\x18\x52\x12\xc7\x13\x4a\xee\xb2\xfb\x99\xc9\x63\x2f\xb5\xd9\x63\x66\xef\x6d\xbe\x98\x6d\x75\xb8\xc1\x70\x80\xde\xdd\x0b\x0a\x75\xea\x05\x22\x7f\x3f\x4b\xf4\xbf\x79\x3b\x6f\xf7\x6b\xd6\xf4\x96\xcc\xbd\x1f\x5f\x33\x2d\xb8\xa6\x29\xc7\x38\xc7\x5c\xe0\x69\x2c\xe8\x5f\x7f\x0d\x23\x31\x75\x48\xcf\x52\x12\x76\x0c\x6f\x5b\x61\x5e\xc5\x91\x72\x16\x5a\xc3\x1c\xc3\x2e\x62\x3a\x57\xd2\x8c\xe4\xe3\x0e\x94\x8e\x0f\x05\xde\x9b\x36\xa1\xeb\x1b\x16\xf3\xd5\x42\x86\x9c\xa2\xa7\x8b\x33\xc3\x23\xaf\x5b\xfe\x7d\x11\xf1\x61\xa5\x1f\x9c\x37\xdf\xa7\x81\x8b\xdd\x8b\x62\xfd\x59\x0a\x9f\x28\xe1\x97\xdc\x06\x0b\xfb\x5a\x74\xa2\xc1\x2b\xfb\x78\x35\x68\x49\x79\xc7\x42\xf3\xd7\xdf\x4b\x51\x75\x6e\xb9\xe3\x75\xc6\x6e\x41\xc7\x63\x7d\x86\x47\x52\x0d\x3e\xb0\x0c\x59\x0a\x31\xa1\xb7\xc5\xe4\xfe\x0f\x52\xcf\x59\xed\xc5\x3c\xbd\x46\xf4\xeb\xaf\x87\x2e\xab\x1f\x66\x6a\xc4\x8a\x89\x54\x22\x88\x28\x33\x8f\xa9\x28\xb2\x7d\x42\x3e\xa7\xe8\x63\x28\xe1\x64\x47\xa0\x63\x2d\x06\xb4\x45\xae\xda\x7b\x6f\x93\xf8\x24\xfc\xff\x03\xe9\x50\x6e\x18\x65\x5e\xa9\x56\x27\x4b\x4a\xa7\xc7\x5a\x0b\x7e\x89\x5f\x41\xa9\x2f\x3b\xa6\xc4\x2d\x70\xe8\x22\x75\xf0\xbf\xb3\xc9\xbe\x93\xe0\x59\xcc\x72\x02\x4d\x23\xe5\x1f\xf5\x55\x4e\x58\x66\x30\xf5\xe7\x19\xa7\xbc\xb3\x53\x62\xc4\x29\x57\xce\xd3\x59\xea\x29\xfb\xfb\x51\x77\x08\x6b\x32\xc5\x44\x59\xa8\x66\x7a\x01\xbd\x6b\xa6\x17\x0c\x6c\x3a\x54\x66\xff\x0e\xb3\x8d\x29\xb5\xef\x99\x09\x38\x50\x86\x23\x7f\x3f\xc7\xe9\x29\x37\xf8\x23\xa3\x06\x33\xee\xfa\x06\xc8\x8e\xbd\x0f\xcf\x62\x92\x9d\xdf\x3b\x31\x48\xdc\x93\x19\x90\x22\x61\x41\xf4\xa5\xd3\xe1\x8b\xe8\xfb\xf5\x48\x70\x06\x91\x77\x63\x79\xc5\x17\xc6\xc5\x20\x54\x98\x43\x97\xdd\x65\xff\x3b\x00\xa6\xfd\x0e\xe7\xfe\x46\x00\x00")
 
 func _hardcodedDoerGoBytes() ([]byte, error) {
 	return bindataRead(
@@ -86,11 +86,11 @@ func _hardcodedDoerGo() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "../_hardcoded/doer.go", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf, 0xf6, 0xfb, 0x53, 0x95, 0x50, 0x6c, 0x8f, 0x54, 0xa2, 0x25, 0xc8, 0x20, 0x72, 0x72, 0x60, 0x47, 0x1f, 0x36, 0x2d, 0x2c, 0xa5, 0xa8, 0x41, 0xc5, 0x4f, 0x13, 0x72, 0x55, 0x59, 0x7f, 0x33}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x92, 0xf8, 0x89, 0xe7, 0xda, 0x21, 0xd0, 0x1c, 0x7b, 0x77, 0x90, 0xb0, 0x2e, 0x98, 0x1b, 0x4b, 0xe0, 0x78, 0xee, 0xdb, 0x92, 0x58, 0xd0, 0x6d, 0x1f, 0x86, 0x19, 0xa6, 0x64, 0x73, 0x35, 0x65}}
 	return a, nil
 }
 
-var __hardcodedMiddlewareGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\x5f\x6b\xe3\x46\x10\x7f\xb6\x3e\xc5\x74\xcb\x91\xd5\xe1\xc8\xf7\x50\x28\x04\xfc\x50\xd2\x4b\xf3\x72\xe5\xb8\x84\xb6\x50\x0a\xb7\x59\x8d\xa4\xc5\xf2\xae\x3a\x3b\xb2\x6a\x82\xbf\x7b\x99\x95\xe4\x58\x21\x5c\x9f\xfa\x60\x6b\x77\x66\x7e\xbf\xf9\xbf\x9d\xb1\x3b\x53\x23\x44\xa4\x03\x52\x96\xb9\x7d\x17\x88\x41\x67\x2b\x85\x44\x81\xa2\xca\x56\xaa\xda\xb3\x7c\x3c\xf2\xa6\x61\xee\xe4\x4c\xbd\x67\xb7\xc7\x4d\x89\x4f\x7d\xad\xb2\x6c\xa5\x6a\xc7\x4d\xff\x54\xd8\xb0\xdf\xdc\xb6\x78\x40\xda\xec\xcc\xf1\x80\x78\x5d\x87\xcd\xe1\xc7\x4d\x1b\xea\x1a\x49\x65\x79\x96\x6d\x36\xf0\xd9\x78\x67\x3f\xb9\xb2\x6c\x71\x30\x84\xd0\x86\x3a\x82\xf1\x47\xe8\x44\x11\x0b\xb8\x0b\x04\x3e\x0c\x6b\x18\xf0\x8a\x10\x6c\xf0\xec\x7c\x8f\xc0\x0d\x85\xc1\xf9\x1a\xb8\xc1\xd1\x18\xfa\x4e\x18\xe5\x1e\xd9\xd8\x1d\xc4\x00\xdc\xb8\x08\x7b\x73\x04\x4b\x26\x36\xa3\x2d\x05\x8b\x31\x16\x59\xd5\x7b\xfb\xda\xbf\x6e\x40\x12\x2b\xee\x8d\x2f\x5b\xa4\x7c\x71\x83\xe7\x6c\x45\xc8\x3d\xf9\x85\xf8\xae\xf7\x56\x0b\x99\x1e\x46\xf9\x17\x8c\x5d\xf0\x11\x7f\x27\xc7\x48\x6b\x20\x78\x3f\xc9\xff\xee\x31\x72\x2e\x3c\xab\x12\x2b\x24\x48\xb0\x51\xb0\x4a\x49\x7c\x24\x82\x9b\x2d\x10\xda\x70\x40\xd2\xb9\x28\x5c\x05\x67\xdd\x76\x0b\xde\xb5\x23\x60\x0a\x46\x8e\x27\xf9\x3b\x18\x02\xa4\xf4\x0b\x94\x89\x24\x0e\x8e\x6d\x03\x97\xcc\xf3\xb9\xd0\x7c\xec\x70\x72\x6d\x4d\x94\xa2\x91\xf3\xf5\x4d\x62\x16\x9a\xed\x48\x14\x8b\x5f\x71\xd0\x33\x2c\x3f\x9b\x27\xe5\xa5\xf5\x6c\x22\xa2\x12\x2b\xd3\xb7\x7c\xa9\xae\xf6\x5c\x7c\x14\x4c\xa5\x55\xef\x77\x3e\x0c\x7e\xea\xdb\xbb\xef\x0f\x10\x2a\x90\x78\xe0\xdd\xa3\x5a\x9f\x99\x5e\x4e\xf9\x98\xa4\xfc\x8f\xf3\x53\xdc\x51\xd8\xdf\x06\xcf\xf8\x0f\x6b\x2a\xe6\x53\x9e\x8f\x3e\x7e\xd6\x2a\x41\xd5\x3a\x45\x30\x61\x3e\x3d\xcb\x2c\xab\x1b\x89\x7d\x0d\x2a\x4d\x09\x93\xb1\xa8\x6e\xa6\xec\x75\x1a\xe3\xe2\x41\x34\x3a\xcf\x4f\xf9\xb9\x31\x8b\x0a\x9c\x52\x63\x9a\xe2\x41\x96\xe5\xfe\xf1\xf1\xb3\x1e\xd6\x20\x9a\x53\x9e\x9d\xd2\x5c\x47\x36\xdc\xc7\xe5\x24\xc0\x40\xa6\x8b\x60\x80\x26\x31\x0c\x49\x9e\xa5\xcc\xdf\x44\x44\xa6\xde\xb2\x34\xe9\x8d\xc9\xca\x56\x23\x06\x9c\x67\x71\x9b\x06\x5a\x47\x78\xff\x16\x55\x0e\xe9\x7b\x8f\xa6\x44\xd2\x36\x94\x28\xb0\xd4\xff\x58\x4c\x3c\x5b\x10\xb9\x08\x96\xd8\xe2\x35\x74\xce\xf2\x37\xa4\xe8\x82\xff\x62\x7c\x8d\x50\xa2\x75\x25\x46\x18\x1a\xe4\x06\x09\x38\x80\xb1\x16\x3b\x06\x03\x87\xd1\xb0\x18\x53\x5d\xc0\xd2\x0a\x4c\xfa\xa9\x0b\x39\x3c\x85\xd0\x26\x0f\xb7\xad\x43\xcf\x13\xe0\xb6\x41\xbb\xbb\x78\x2c\xac\xdc\x63\xda\x6a\x9b\xec\x5e\xfc\xa4\x5a\x7c\x0b\xfc\x6a\xd3\xd7\x40\xbe\x5e\x04\xf6\x3f\xee\xfe\x9c\xad\x2c\x7a\x31\x96\xb5\xf8\x05\x59\xab\x3f\xae\xc7\x90\xaf\xa7\x40\x94\x8c\xd9\x7f\x0d\xfc\x4f\x65\x39\xdf\xd4\x58\x86\xeb\xc9\x81\x5a\xcf\x05\x11\x1e\x57\xc1\x77\xe4\xeb\xb9\xd6\xd3\xea\x0f\x8b\xe6\xfe\xf0\xe1\x43\x7e\x21\xd5\x7f\xfe\xf5\x74\x64\xd4\xb2\xbb\x0f\x1d\x39\xcf\x95\xfe\xfa\xac\xf6\x18\xa3\xa9\x65\x6b\xd4\xb2\xee\x70\xf5\x2e\x5e\x81\x0f\x3c\x75\x1e\xcb\x35\x74\x2d\xca\x73\xd1\x77\x35\x99\x12\xd5\xe9\xeb\x4b\x50\x79\xf2\x75\x7e\xc5\x4e\xdf\xd8\xa9\x7f\x03\x00\x00\xff\xff\xdb\xb3\x12\x2d\x9f\x06\x00\x00")
+var __hardcodedMiddlewareGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\xcd\x6e\xe3\x36\x10\x3e\x8b\x4f\x31\x65\x11\x84\x5a\x28\xf2\x1e\x0a\x14\x30\xe0\x43\x91\x6e\x9a\xcb\x16\x8b\x4d\xd0\x16\x28\x0a\x2c\x43\x8d\x24\xc2\x32\xa9\x0e\x29\xab\x46\xe0\x77\x2f\x86\x92\x1c\x3b\x48\x73\xeb\xc1\x16\x39\x33\xdf\xfc\x7e\xc3\x5e\x9b\xad\x6e\x10\x02\xd2\x1e\x49\x08\xbb\xeb\x3d\x45\x50\x22\x93\x48\xe4\x29\x48\x91\xc9\x7a\x17\xf9\xe3\x30\xae\xda\x18\x7b\x3e\xd3\xe0\xa2\xdd\xe1\xaa\xc2\xa7\xa1\x91\x42\x64\xb2\xb1\xb1\x1d\x9e\x4a\xe3\x77\xab\xdb\x0e\xf7\x48\xab\xad\x3e\xec\x11\x6f\x1a\xbf\xda\xff\xb8\xea\x7c\xd3\x20\x49\x91\x0b\xb1\x5a\xc1\x17\xed\xac\xf9\x6c\xab\xaa\xc3\x51\x13\x42\xe7\x9b\x00\xda\x1d\xa0\x67\x45\x28\xe1\xce\x13\x38\x3f\x16\x30\xe2\x35\x21\x18\xef\xa2\x75\x03\x42\x6c\xc9\x8f\xd6\x35\x10\x5b\x9c\x8c\x61\xe8\xd9\x23\xdf\x43\xd4\x66\x0b\xc1\x43\x6c\x6d\x80\x9d\x3e\x80\x21\x1d\xda\xc9\x96\xbc\xc1\x10\x4a\x51\x0f\xce\xbc\x8e\xaf\x5a\xe0\xc2\xca\x7b\xed\xaa\x0e\x29\xbf\xb8\xc1\xb3\xc8\x08\xe3\x40\xee\x42\x7c\x37\x38\xa3\xd8\x99\x1a\x27\xf9\x57\x0c\xbd\x77\x01\x7f\x27\x1b\x91\x0a\x20\xf8\x30\xcb\xff\x1e\x30\xc4\x9c\xfd\x64\x15\xd6\x48\x90\x60\x93\x20\x4b\x45\x7c\x22\x82\xf5\x06\x08\x8d\xdf\x23\xa9\x5c\x64\x59\x66\x6b\x38\xe9\x36\x1b\x70\xb6\x9b\x00\x73\x32\x7c\x3c\xf2\xdf\x5e\x13\x20\xa5\x9f\x27\xc1\x92\x30\xda\x68\xda\x17\xf4\x7a\x73\x3a\x97\x2a\x1e\x7a\x9c\x43\x1b\x1d\xb8\x69\x64\x5d\xb3\x66\x5c\xc6\x6e\x36\x93\xa3\x50\xfe\x8a\xa3\x5a\x60\xf9\xc9\x3c\x29\xcf\xad\x17\x13\x16\x55\x58\xeb\xa1\x8b\xe7\xea\x7a\x17\xcb\x4f\x8c\xa9\x95\x1c\xdc\xd6\xf9\xd1\xcd\x73\xbb\xfa\x7e\x0f\xbe\x06\xce\x07\xae\x1e\x65\x71\xf2\xf4\x72\x4a\x61\x8f\xa9\xa6\x89\x3f\xe5\x1d\xf9\xdd\xad\x77\x11\xff\x89\x8a\xca\xe5\x94\xe7\x53\x8c\x9f\x95\x4c\x50\x59\x30\x64\xc1\x7c\x7e\x66\x2e\xcb\x35\x17\x56\x80\x4c\x2c\x89\xa4\x0d\xca\xf5\x5c\xbd\x4a\x34\x2e\x1f\x58\xa3\xf2\xfc\x98\x9f\x06\x73\xd1\x81\x63\x1a\x4c\x5b\x3e\xf0\xb2\xdc\x3f\x3e\x7e\x51\x63\x01\xac\x39\xe6\xe2\x98\x78\x1d\xa2\x8e\x43\xb8\x64\x02\x8c\xa4\xfb\x00\x1a\x68\x16\xc3\x98\x18\x22\x52\xe5\x6f\x22\x42\xa4\xc1\x44\x1e\xd2\x1b\xcc\x12\xd9\x84\x01\xeb\x22\x87\x65\x32\x81\x0a\xf0\xe1\x2d\x57\x39\xa4\xef\x3d\xea\x0a\x49\x19\x5f\x21\xc3\xd2\xfc\x43\x39\xfb\xd9\x00\xcb\x45\x16\x5e\x05\x2a\x5f\x43\x97\x2a\x7f\x43\x0a\xd6\xbb\xaf\xda\x35\x08\x15\x1a\x5b\x61\x80\xb1\xc5\xd8\x22\x41\xf4\xa0\x8d\xc1\x3e\x82\x86\xfd\x64\x58\x4e\xa5\x5e\xc0\x38\x6b\x35\xeb\xe7\x29\xe4\xf0\xe4\x7d\x97\xfa\x78\xdb\x59\x74\x71\x06\xdc\xb6\x68\xb6\x67\x8f\x85\xe1\x7b\x48\x5b\x6d\x92\xdd\x4b\x1c\xf6\xfa\x2e\xf8\xd5\xa6\x17\x40\xae\xb9\x48\xec\x7f\xdc\xfd\xa5\x5a\x5e\xf4\x72\x9a\x48\xf9\x0b\x46\x25\xff\xb8\x99\x52\xbe\x99\x13\x91\xb9\x38\x91\xf7\x3f\x09\xff\x53\x55\x2d\x37\x39\xb5\xe1\x66\x0e\x20\x8b\xa5\x21\xec\xc7\xd6\xf0\x1d\xb9\x66\xe9\xf5\xbc\xfa\xe3\xc5\x70\x7f\xf8\xf8\x31\x3f\x93\xaa\x3f\xff\x7a\x3a\x44\x54\xbc\xbb\x0f\x3d\x59\x17\x6b\xf5\xed\x59\xee\x30\x04\xdd\xf0\xd6\xc8\xcb\xbe\xc3\xf5\x55\xb8\x06\xe7\xe3\x3c\x79\xac\x0a\xe8\x3b\xe4\xe7\x62\xe8\x1b\xd2\x15\xca\xe3\xb7\x97\xa4\xf2\x5c\x9c\xbf\x62\xc7\x77\x76\xea\xdf\x01\x00\xdb\xb3\x12\x2d\x9f\x06\x00\x00")
 
 func _hardcodedMiddlewareGoBytes() ([]byte, error) {
 	return bindataRead(
@@ -110,7 +110,7 @@ func _hardcodedMiddlewareGo() (*asset, error) {
 	return a, nil
 }
 
-var __hardcodedTracingGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x58\xdd\x6f\xe3\x36\x12\x7f\x96\xfe\x8a\x39\x01\xed\x49\x0b\x45\x4a\xfa\xb1\x2d\x52\xe4\x21\x5f\xbb\x6b\x34\x49\x83\x24\x7b\xc5\xe1\x70\x08\x68\x69\x2c\x13\x2b\x91\x2a\x49\xd9\x31\x16\xf9\xdf\x0f\x43\x52\xb2\x9c\xd8\xbe\x74\xf7\x21\x2b\x93\xc3\xe1\x7c\xcf\x6f\xd8\xb2\xe2\x0b\xab\x10\x34\xaa\x05\x2a\xa3\x58\xc1\x45\x15\x86\xbc\x69\xa5\x32\x10\x87\x41\x54\x48\x61\xf0\xc9\x44\x61\x10\xa1\x28\x64\xc9\x45\x95\x4f\xb9\x60\x6a\xb5\xb1\x34\xc7\x27\xfa\x3d\x6b\x2c\xa5\x40\x93\xcf\x8d\x69\xe9\x5b\x6a\xfa\xab\x8d\x2a\xa4\x58\xd0\xa7\xe1\x0d\x46\x61\x18\x44\x15\x37\xf3\x6e\x9a\x15\xb2\xc9\x2b\x29\xab\x1a\xf3\xae\xe3\xe5\xcb\xad\xf3\x1a\x17\xa8\xf2\x2f\x6c\xb5\x40\x3c\xa8\x64\xbe\xf8\x25\xaf\x65\x55\xa1\x72\x94\x32\x93\x2d\x0a\x83\x35\x36\x68\xd4\x2a\xe3\x32\x27\x99\x15\x9f\xe6\x5c\x68\xa3\xba\x06\x85\x61\x86\x4b\x91\x6f\x5c\xa8\x78\x5d\xb3\xbc\xe9\x9e\x72\x69\xb0\x6e\x3a\x2b\xff\x36\x6e\xb4\xbd\x6f\x2f\x9f\xb2\xaa\x62\x15\xee\xa5\xc1\x27\xb2\x28\x2a\x9d\x4b\x53\xb7\xf6\x0f\x59\xfb\xdb\x0e\xad\xbf\x2a\xd5\x16\x7b\x59\xb4\x4a\xb6\xac\xb2\xea\xef\xa5\xd3\xe5\x97\x5c\xa1\x96\x9d\xb2\x32\xe9\xf2\x8b\xe5\x0f\xfb\x4f\xfc\x7f\x15\x06\x2a\xf7\xd7\xa0\xa6\x00\xd1\xd8\x50\x34\xec\xe3\xee\x28\xf2\xc5\x51\x76\x74\x98\x1d\xee\xbd\xc3\x4b\x91\x84\xe1\x82\x29\x28\x71\xc6\xba\xda\x9c\xcb\xba\xc6\xc2\x48\xf5\x49\x6a\x03\xda\x28\x2e\x2a\x38\x81\xa8\x96\x05\xab\xe7\x92\xc4\xd8\x46\x7d\x4b\x71\xdf\x71\x61\x8e\xde\xc3\x09\xfc\xf4\xe3\xd1\x2f\x61\x98\xe7\x70\x8f\xa6\x6b\x3f\xd6\x72\xca\xea\x07\xba\xed\x56\xc9\x05\x2f\x51\x9d\x8a\xf2\xd2\x3b\x09\x34\x1a\x0d\x5d\x0b\x66\x8e\x50\x59\x52\x70\x36\x6c\x3d\x31\x30\x51\x42\xef\xd3\x2c\x9c\x75\xa2\x78\x0b\xe3\xb8\x30\x4f\xe0\xd3\x30\x3b\x77\xff\x27\x10\xf7\x2e\xca\xee\x5b\x26\x7a\xda\x14\xde\x0d\xeb\x96\x9f\xea\x19\xa6\x80\x4a\x49\x95\xc0\xd7\x30\x0c\xf2\x1c\x2e\x17\xa8\x56\x70\xf4\x33\x68\x2c\xa4\x28\x35\x2c\xf1\x9f\x35\x49\xbc\x02\x23\xe9\x3a\x81\x85\xa1\xcf\x0d\x9b\x43\xd1\x1b\x0a\x98\xb1\x7c\x48\x5b\x6f\x44\x20\xdb\x52\xa4\x81\x9c\xc1\x60\xe7\x63\x6b\x44\x22\xfd\x73\x8e\x02\x54\x27\x04\xb9\x82\x0b\xb2\x4b\xd9\x15\xf6\x80\x99\x73\x0d\x5c\x03\x03\xcd\x4b\x2c\x98\x4a\xad\xb1\x96\xa3\x13\x96\x85\xe5\x5a\xaf\x46\xf4\xfd\x4a\xcf\x57\xb6\x38\x48\x7b\x30\x48\x9b\x85\x01\x79\x5b\x8f\x4c\x05\x5b\x0d\x18\x06\xac\x2c\x15\x1c\x9f\xc0\xac\x31\xd9\x7d\xab\xb8\x30\xb3\x38\xfa\x4e\x1f\x7f\x57\x46\xe9\xd6\xe0\x7a\xbd\x4a\x41\x94\x84\x01\x2a\xcb\xc8\xda\x3d\x16\xbc\x4e\xc2\x80\xcf\x20\x96\x3a\xfb\x88\x06\xc5\x22\x8e\x1e\x1f\xee\x4e\xcf\x27\x37\x1f\x1f\x2f\x6f\x4e\xcf\xae\x2e\x2f\xa2\x24\x81\x93\x13\x88\x8c\xea\x30\xb2\x9e\x0a\x28\xd5\xcf\x6b\x8e\xc2\x10\xaf\x8d\xc4\xcf\x6e\x70\xe9\xb6\xe2\x30\x70\x94\xeb\xbd\x3f\xb9\x99\xdf\xa1\xf7\x23\x97\xe2\x16\x15\x97\x65\x7c\xf4\xf3\x3b\xaa\xbf\xd9\xbd\xf5\x7a\x92\x6e\x3f\x78\x29\xca\x56\x72\x61\x62\x32\xc6\x2e\xa2\x89\xd0\x58\x74\x0a\x63\x4b\x90\x84\x41\xa0\x37\x22\x91\xd4\x1f\x49\x4c\xd2\x52\x28\xa7\xb0\x56\x89\x0e\xf1\x99\xa5\xfc\xc7\x09\x08\x5e\xc3\x57\xba\x4b\xa1\xe9\x94\xa0\xdf\xa9\xfb\x43\xbe\xb8\x24\x33\xce\xe2\xc8\x9a\x13\x0a\x85\xcc\x90\xbf\xfb\x7c\x3a\x86\xef\x16\x91\xbd\x94\x98\x3e\x87\xc1\x33\x60\xad\xd1\x32\xdc\x70\xfb\x09\x0c\x95\x88\x44\xba\x91\xb2\x1d\x32\x2d\x09\x83\xe7\x30\x0c\x4c\x4b\xb6\x16\xb8\xdc\x4c\xa1\x78\x53\x3f\x81\xcb\x3b\x5f\x31\xe3\x24\x09\x03\xaa\x45\xd9\x3d\x9a\x17\x87\x4c\x9b\x84\x61\xe0\x3a\x56\xf6\x41\xc9\xc6\xa7\x30\xd9\x22\xc9\x26\x62\x26\x2f\xe2\x48\x1b\xa6\x48\x9d\x03\x2b\x9b\x8a\x52\xf0\x07\xae\x49\xfe\x88\xdc\x80\x5a\x47\xc7\x40\x5f\x69\x18\x3c\x27\x61\x6f\xa5\x4d\xa1\x4c\x6b\x4d\x16\x3e\x87\xae\xbe\xbc\x56\x02\xf7\xc6\x7f\x0a\x7d\x17\x80\x77\xfd\x57\xd6\xab\x99\xec\xac\x2e\x64\x66\xcd\x9a\xb6\xe6\xa2\xba\x55\x72\xca\xa6\xbc\xe6\x66\x45\x66\x3c\xcc\x0e\x7f\x0e\x03\xae\xaf\x28\x51\x6d\x0c\x8f\xe2\x7f\x72\xff\x78\xf5\xc7\xf9\xe9\x55\x34\x8a\x7b\x9b\x25\x3d\xbd\x75\xdf\x16\xc6\x27\x70\x94\x1d\x0e\x4e\xe6\x33\x58\xbc\x60\xdd\x67\xd6\xfd\xe9\xf5\xed\x15\x7d\xdc\xde\xfd\x71\x76\x7a\x36\xb9\x9a\x3c\xfc\x3b\x4a\x7e\x83\x05\x45\x5c\x14\xed\xba\x80\x1c\x75\x29\x16\x2e\x8a\x8f\x4f\xc0\xe3\x97\xec\x96\x29\x8d\x1f\x6a\xc9\x4c\xbc\x48\xe1\xfd\x4f\x3b\x22\x78\x37\x47\x92\xdc\x05\xe8\x0e\xbd\x76\x1f\x1d\x07\xe7\xe0\x87\x9b\x57\x0e\x0e\x03\x5b\x6c\x11\x3a\x8d\x1b\xe5\x79\x72\x01\x15\x0a\x54\x8c\x0a\x22\x4c\x04\x48\x45\xae\x9b\x49\x35\x5c\x4a\x05\x7f\x29\xd5\x17\x88\x99\x81\x1a\x99\x36\xb0\xe4\x66\xee\xea\xad\x25\x42\x9b\x5f\xbe\xf0\x8f\x39\x42\xd3\x69\xd3\xff\x44\xdf\xfa\x26\x17\x1a\x3a\xc1\x67\x52\x35\xf5\x0a\x98\x01\xc5\x44\x29\x1b\x98\x29\xd9\x58\x0e\x28\x0c\x57\x48\x41\x5c\x20\xb5\x0d\x6a\xbd\xef\x7f\xca\xdc\x15\x1f\xa4\x02\x7c\xb2\xb7\xa6\x1b\x9a\x3c\x1d\x28\xb6\xda\xbc\xbd\x94\xa8\x41\x48\x03\xa5\xb4\xd2\x7a\x16\x83\x9d\xa8\x62\xdd\x3b\x05\xe2\xcd\x20\x9e\x5c\xdc\x51\xd7\x3a\x63\x1a\xcb\x38\xb1\xc5\x6c\xff\xa9\x5b\xa6\x50\x18\x47\xbf\x87\xd5\x16\x47\x26\x8e\x7d\x9e\xc3\xc3\x1c\x35\x42\xc3\x9e\x78\xd3\x35\x1a\x98\x42\x32\x7d\xd5\x31\x55\x02\xab\x18\x21\x57\xd0\xb2\x41\x33\x27\xaf\x54\x92\xfe\x2e\x95\x14\x95\xed\x8b\x1a\x05\xa1\x6e\x60\x60\x5c\xb7\x2d\x99\x61\xd0\x09\x7c\x6a\xb1\x30\x58\xd6\xab\x57\x4a\xb4\x4c\x5c\xf1\x86\x1b\xbd\x09\x1c\xdc\x9a\x8d\xd9\x53\x43\xa0\xb9\x33\x78\x2e\x3b\x61\xec\xc6\x31\x1c\x1d\x1e\xda\xf2\x7f\xb9\x40\x61\xc6\x1b\xf4\xaf\xdf\xbc\xe2\xe2\xcb\xcb\xbd\x7e\xf3\x39\x49\xc3\x97\xc2\x9c\x31\x53\xcc\x47\xa5\xe8\xb5\xcd\x87\xc2\xda\xd7\x20\x22\xd9\x5f\x63\xd7\x7b\xf8\x64\xae\x59\x7b\xeb\x91\xaf\x54\xf1\x08\x04\xdb\x9e\x29\x9b\x56\x6a\x6e\x70\x3f\xa5\xbd\xc3\x17\xeb\xaf\xcf\x29\x8c\xf7\xce\x1c\xe4\xff\xfa\x9c\x50\x71\xf7\x95\xd8\xb4\x54\x76\xdf\x86\x16\x3f\x48\xf5\x80\xda\x10\x86\x69\x90\x09\x8b\xb4\xa6\x36\x65\x4b\x82\x46\x9d\xe0\x06\xa8\x45\x71\x51\xa5\xc4\x92\xbc\xde\x70\x6a\x7d\xda\x66\x82\xa6\x2b\x80\x4d\xe5\x02\x6d\x02\xcb\xce\x10\x72\xb2\x29\x34\x3a\x9b\xc1\xc4\x80\x13\x4f\x03\x13\xc0\xc5\x41\x83\x8d\x54\x2b\xe2\x39\x74\x82\x99\xcf\x33\x6e\x11\x54\x9f\xc2\xa5\x6d\x2d\xfa\xed\x40\xd5\xeb\x14\x27\x10\xbf\x5b\xf7\xd8\x89\xb8\xb6\x37\xfe\x2d\x88\x1a\x0c\xb2\x1d\xbf\xe8\xd7\x2f\xd9\x51\xcf\xde\xd5\xaf\xf1\x5b\x7a\x75\xef\x4d\xdc\xd6\x53\xf3\x1c\xae\xbb\xa7\x7b\x3b\x2d\x5f\xf3\xb2\xac\x71\x49\xa9\xdb\x5b\xb8\x59\x2f\x99\x39\x33\xa0\xe7\xb2\xab\x4b\x72\x2c\x33\x86\x15\x73\x2c\xc9\xcf\x0c\x46\xd3\xa7\x1f\xbd\x33\x62\x3d\x31\xae\x8c\x99\xa5\xad\x61\xa2\xd2\xc7\x60\x91\x81\x76\xae\x70\xa0\x98\x95\xa5\xfb\xed\x06\x1f\xe0\x62\x26\x89\x2d\x85\x85\xc2\xbf\x3a\xd4\xe6\x40\xb7\x58\xf0\x19\x2f\x7a\x18\x41\xdc\xef\x78\x35\x37\x20\xe4\x12\x96\x08\x52\xd4\x2b\xd0\x5d\x6b\xe7\x7c\x22\x22\xcf\x53\xb5\xe6\xc2\x32\xa2\x8a\xcd\x8c\xd3\xe2\x82\x19\x56\x4a\x0b\xb5\xb0\x30\x7d\x40\x6c\xb1\x43\x4c\xba\xf0\x02\x6f\x58\x83\x7e\xe4\x4a\x80\x88\xe3\xb9\x31\x6d\xf6\x89\x89\x92\xfa\x07\x8c\x7f\x91\xb3\xa5\xa1\xf9\xdb\xe1\x5b\x3b\x8a\x67\xdb\x79\xa6\xc3\x3e\xd5\x88\x75\xda\xea\xd8\xfa\xf3\xe3\xb6\xdc\x4f\xc8\xdd\x79\x6e\x21\xe4\x2d\xa1\xf9\x5a\xc4\xd1\x69\x69\xcb\xe7\xda\xfc\x23\xcf\x45\xeb\x10\x70\xa2\xc3\x7e\xe1\x7b\x62\xa7\xc4\x86\xa6\x1f\xe8\xbc\x65\xa2\x96\xee\xdc\x1d\xea\x56\x0a\x8d\x7f\x2a\xee\xc0\x16\xbc\xf3\xeb\xd6\x6f\x89\x43\x0f\x7c\x06\xaa\x5f\xfa\x7c\x37\xb1\xd0\x28\x7f\x9c\x23\xab\xcd\xdc\x21\x96\x20\x98\x67\xd6\xfa\x9f\x1e\x1e\x6e\x63\xb5\x4c\xc1\xb6\xe5\x5e\x18\xfa\x24\x84\x11\xd0\xe8\x78\x7c\x02\xaa\x9f\x1b\x63\x2a\x57\x41\xa0\x87\xb4\xb2\x4d\xe0\x25\x2a\x25\x92\x29\xab\x2c\x95\x7f\xdc\x78\x85\x5c\x43\x2f\x28\xd1\x65\xd7\xd8\x4c\x51\xc5\x51\x61\xdf\x6a\x0e\xfa\x28\xe4\x65\x94\x64\xf7\x36\x0e\x62\x07\xf0\x4e\x22\xf8\x0a\x79\x4e\x80\xed\x15\x2d\xd5\x42\x6a\xe0\x1a\x8d\x57\xe5\x2f\x5e\x0e\x00\xac\x97\xe3\x06\x97\xbb\x6f\x4b\xa1\xeb\x78\x69\x27\x8d\xf5\xc5\xce\x30\x5b\x40\x5a\xb0\x13\x95\xdb\x59\xe3\xe2\xd5\xac\xe1\x65\x80\xc6\x0a\x30\x06\xe9\x8e\x32\x3a\xa6\x3b\xdc\xe9\x38\x79\x76\x17\x8f\xe6\x10\x6f\xd6\x7e\x34\xb2\xa6\xbb\x47\xe3\xf5\xb1\xfa\xba\x33\xdb\xa5\x7d\xa3\xb8\x1a\xcd\xb7\x4b\x6b\x21\x66\x1f\x3d\xee\x3b\xcf\xe1\xb4\x2c\x6d\x55\xe8\x59\xfa\x6a\xe3\x18\x12\x0d\x75\x90\xc7\x94\xf6\x6d\xb8\x31\x51\xe1\x38\x32\x74\xec\x23\x7b\xa7\x0a\xa7\x65\xd9\xff\x9e\xb2\x2a\xfb\x1d\x57\x71\x62\xf9\x65\xff\x62\x75\x87\xde\x8b\x1b\x02\xbd\x10\xc6\xbf\x90\xf4\x51\xbf\x8e\x18\xcf\xd7\x01\x0f\xbb\xe4\xc6\x50\x92\x2f\xe9\x19\x5e\x8a\x42\x96\x0e\x2e\xbb\xe7\x2a\x2a\xb0\xc0\xcb\xa1\x20\x5e\x5c\xf8\x9a\xe8\xe3\x5e\x17\x16\x86\xdb\xfc\x19\x92\xeb\x37\xd0\x45\xf6\x89\x69\x0f\x06\x63\xf7\xda\xe2\x6e\xb8\x92\x15\x85\xbd\x03\xd1\x65\x1f\x93\x2f\x18\x64\x13\xed\xe0\x66\x39\x58\xec\x2d\x26\x8b\x3c\xd7\x28\xf5\x33\xd4\xb6\xd0\xfb\x9b\x7c\x66\xac\xd6\x03\x23\xa7\x05\xd9\x64\x72\x91\x3a\x0b\x4d\x2e\xac\x01\x0a\xab\x00\xe9\x3a\x24\x5c\x4a\xab\x83\x09\x86\xe5\xde\x10\xa5\x6f\x27\x34\x4f\x21\xf5\xb6\x39\x3e\xf9\x6e\xa1\xc9\x99\x6e\x04\xa0\x66\x94\x82\x96\x14\x64\x60\xe6\x52\x23\xfd\xb0\xfd\xa8\x90\x4a\x61\xed\x52\xb2\x96\x95\xb6\x5d\xd1\xca\xa4\xed\xf0\xa2\x7b\xe3\xd6\x28\x62\x2f\xab\x2d\x3f\x3f\xfe\x00\xdf\x7f\x6f\x57\x9d\x26\x76\xf1\xe8\xbd\x2b\x49\x9b\xef\x5c\xce\xd7\xc7\xb4\x3b\x5d\x19\x84\xf8\xc7\x1f\x0e\x8a\x39\x53\x24\x6c\x92\xc2\xaf\x7e\xf5\xe8\xfd\x7a\xd5\x8f\x3c\x16\x9f\xfb\xe0\x71\x66\x0b\xbc\x0c\x67\x3a\x85\x47\x32\xda\x1c\x9f\xb2\x0b\xa4\x80\xf3\xb6\xe9\x85\x7c\xbb\xa3\xca\x32\xb3\x87\x1e\xa9\xea\xf9\xf2\xb0\xf9\x62\x45\x4e\x74\x0f\xf5\xd9\x19\xaf\x2e\x45\xc9\x99\xc8\x3e\x5b\xdb\xc6\x83\x40\xff\xf9\xf5\xf8\xbf\x89\xaf\x90\xde\xbd\xbb\xa5\xf4\x46\xfb\x5b\x42\xd2\x99\x6f\x93\xb1\x97\xa6\x17\x6f\x54\x91\xa8\x78\x2a\x8b\x01\x5e\x76\xad\xad\x5d\x91\x40\x7a\xf0\xec\xe1\xdb\x08\x09\xae\x81\xf1\xfa\xd1\xa3\x44\x5d\x28\x3e\xb5\xa3\x30\xcd\xbc\xac\x6d\x6b\xee\x5e\x35\x2d\x88\xfa\x4c\x00\x7d\x36\xaa\xb2\x5d\xeb\xfc\xae\x86\x67\xde\xe1\xe1\x65\x8d\x38\xb7\xbc\xa5\x50\x56\x2e\x98\xa2\x0b\x46\x70\xc9\xbe\x7d\x8c\x1f\x48\x4e\x6f\x6f\x1f\x6f\x4e\xaf\x2f\xa3\x64\xf4\x5e\xd1\x9f\x39\xd9\x41\x3a\x7e\x17\x19\x51\xbc\x9d\xd7\x06\xab\x30\x50\x3e\x22\x06\x25\xae\x51\x55\x18\x5b\xec\xe3\x57\x2e\xdc\x64\xee\xde\x02\x87\xd5\x1b\x5c\x92\x97\x86\xc9\x52\xdb\x87\x4a\xff\xb6\x9f\xdd\x17\x73\x6c\xd8\xe7\xbb\xab\x74\x63\x75\x8d\xf7\x7e\xc7\x55\x5f\x3d\xbc\x98\xee\xa9\xd1\xcd\x82\x1e\x77\xa9\xf0\x39\xfc\x5f\x00\x00\x00\xff\xff\x06\xb9\x8e\xbf\xc7\x1a\x00\x00")
+var __hardcodedTracingGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x58\x6d\x4f\xe4\xb8\x93\x7f\x9d\x7c\x8a\xda\x48\xbb\x97\x8c\x42\x02\xfb\x30\xbb\x62\xc5\x0b\xa0\x99\x99\xd6\x02\x8b\x80\xb9\xd1\xe9\x74\x42\xee\xb8\x3a\x6d\x4d\x62\x67\x6d\xa7\x1f\x34\xe2\xbb\x9f\xca\x71\xd2\x69\xa0\xf9\xb3\xf3\x06\xd2\x76\xb9\x5c\xcf\xf5\x2b\x37\xac\xf8\xca\x4a\x04\x83\x7a\x89\xda\x6a\x56\x08\x59\x86\xa1\xa8\x1b\xa5\x2d\xc4\x61\x10\x15\x4a\x5a\x5c\xdb\x28\x0c\x22\x94\x85\xe2\x42\x96\xf9\x4c\x48\xa6\x37\x3b\x4b\x0b\x5c\xd3\xef\x79\xed\x28\x25\xda\x7c\x61\x6d\x43\xdf\xca\xd0\x5f\x63\x75\xa1\xe4\x92\x3e\xad\xa8\x31\x0a\xc3\x20\x2a\x85\x5d\xb4\xb3\xac\x50\x75\x5e\x2a\x55\x56\x98\xb7\xad\xe0\x4f\xb7\xce\x2b\x5c\xa2\xce\xbf\xb2\xcd\x12\xf1\xa0\x54\xf9\xf2\xf7\xbc\x52\x65\x89\xba\xa3\x54\x99\x6a\x50\x5a\xac\xb0\x46\xab\x37\x99\x50\x39\xc9\xac\xc5\x2c\x17\xd2\x58\xdd\xd6\x28\x2d\xb3\x42\xc9\x7c\xe7\x42\x2d\xaa\x8a\xe5\x75\xbb\xce\x95\xc5\xaa\x6e\xd7\xd1\x1e\x6e\xb4\xfd\xda\x5e\x3e\x63\x65\xc9\x4a\x7c\x95\x06\xd7\x64\x51\xd4\x26\x57\xb6\x6a\xdc\x1f\xb2\xf6\xf7\x1d\xda\x7e\x95\xba\x29\x5e\x65\xd1\x68\xd5\xb0\xd2\xa9\xff\x2a\x9d\xe1\x5f\x73\x8d\x46\xb5\xda\xc9\x64\xf8\x57\x27\x1e\xbc\x7e\xe2\x3f\xab\x30\x50\x75\x7f\x2d\x1a\x0a\x10\x83\x35\x45\xc3\x6b\xdc\x3b\x8a\x7c\x79\x94\x1d\x1d\x66\x87\xaf\xde\xe1\xa5\x48\xc2\x70\xc9\x34\x70\x9c\xb3\xb6\xb2\xe7\xaa\xaa\xb0\xb0\x4a\x7f\x52\xc6\x82\xb1\x5a\xc8\x12\x4e\x20\xaa\x54\xc1\xaa\x85\x22\x31\x5e\xa2\xbe\xa1\xb8\x6f\x85\xb4\x47\xef\xe1\x04\x7e\xfd\xe5\xe8\xf7\x30\xcc\x73\xb8\x43\xdb\x36\x1f\x2b\x35\x63\xd5\x3d\xdd\x76\xa3\xd5\x52\x70\xd4\xa7\x92\x5f\x78\x27\x81\x41\x6b\xa0\x6d\xc0\x2e\x10\x4a\x47\x0a\x4e\x32\x68\x3c\x31\x30\xc9\xa1\xf7\x69\x16\xce\x5b\x59\xbc\x85\x71\x5c\xd8\x35\xf8\x34\xcc\xce\xbb\xff\x09\xc4\xbd\x8b\xb2\xbb\x86\xc9\x9e\x36\x85\x77\xc3\xba\xe3\xa7\x7b\x86\x29\xa0\xd6\x4a\x27\xf0\x2d\x0c\x83\x3c\x87\x8b\x25\xea\x0d\x1c\xfd\x06\x06\x0b\x25\xb9\x81\x15\xfe\x57\x55\x81\xd5\x1b\xb0\x8a\xae\x93\x58\x58\xfa\xdc\xb1\x39\x14\xbd\xa1\x80\x59\xc7\x87\xb4\xf5\x46\x04\xb2\x2d\x45\x1a\xa8\x39\x0c\x76\x3e\x76\x46\x24\xd2\x2f\x0b\x94\xa0\x5b\x29\xc9\x15\x42\x92\x5d\x78\x5b\xb8\x03\x76\x21\x0c\x08\x03\x0c\x8c\xe0\x58\x30\x9d\x3a\x63\xad\x46\x27\xdc\x6d\x8e\x6b\xb5\x19\xd1\xf7\x2b\x3d\x5f\xd5\xe0\x20\xed\xc1\x20\x6d\x16\x06\xe4\x6d\x33\x32\x15\xbc\x68\xc0\x30\x60\x9c\x6b\x38\x3e\x81\x79\x6d\xb3\xbb\x46\x0b\x69\xe7\x71\xf4\xa3\x39\xfe\x91\x47\xe9\xb3\x70\xa1\xe0\x7a\xbe\x4a\x41\x94\x84\x01\x6a\xc7\xc8\xd9\x3d\x96\xa2\x4a\xc2\x40\xcc\x21\x56\x26\xfb\x88\x16\xe5\x32\x8e\x1e\xee\x6f\x4f\xcf\xa7\xd7\x1f\x1f\x2e\xae\x4f\xcf\x2e\x2f\x26\x51\x92\xc0\xc9\x09\x44\x56\xb7\x18\x39\x4f\x05\x94\xea\xe7\x95\x40\x69\x89\xd7\x4e\xe2\x67\xd7\xb8\xea\xb6\xe2\x30\x08\x82\xdd\xbd\x2f\xc2\x2e\x6e\xd1\xfb\x51\x28\x79\x83\x5a\x28\x1e\x1f\xfd\xf6\x8e\xea\x6f\x76\x47\x5b\x3c\x49\x5f\x3e\x78\x21\x79\xa3\x84\xb4\x31\x19\x63\x1f\xd1\x54\x1a\x2c\x5a\x8d\xb1\x23\x48\xc2\x20\x18\x9b\xd7\xc5\x1b\x8c\x24\x26\x69\x29\x94\x53\xd8\xaa\x44\x87\xc4\xdc\x51\xfe\x70\x02\x52\x54\xf0\x8d\xee\xd2\x68\x5b\x2d\xe9\x77\xda\xfd\x21\x5f\x5c\x90\x19\xe7\x71\xe4\xcc\x09\x85\x46\x66\xc9\xdf\x7d\x3e\x1d\xc3\x8f\xcb\xc8\x5d\x4a\x4c\x1f\xc3\xe0\x11\xb0\x32\x08\xdf\x9e\xc8\x05\x27\x30\x54\x22\x12\xe9\x5a\xa9\xa6\xdf\x8b\x93\x30\x78\x0c\xc3\xc0\x36\x64\x6b\x89\xab\xdd\x14\x8a\x77\xf5\x93\xb8\xba\xf5\x15\x33\x4e\x92\x30\xa0\x5a\x94\xdd\xa1\x7d\x72\xc8\x36\x49\x18\x06\x5d\xc7\xca\x3e\x68\x55\xfb\x14\x26\x5b\x24\xd9\x54\xce\xd5\x24\x8e\x8c\x65\x9a\xd4\x39\x70\xb2\xe9\x28\x05\x7f\xe0\x8a\xe4\x8f\xc8\x0d\x68\x4c\x74\x0c\xf4\x95\x86\xc1\x63\x12\xf6\x56\xda\x15\xca\x36\xce\x64\xe1\x63\xd8\xd5\x97\xe7\x4a\xe0\xab\xf1\x9f\x42\xdf\x05\xe0\x5d\xff\x95\xf5\x6a\x26\x7b\xab\x0b\x99\xd9\xb0\xba\xa9\x84\x2c\x6f\xb4\x9a\xb1\x99\xa8\x84\xdd\x90\x19\x0f\xb3\xc3\xdf\xc2\x40\x98\x4b\x4a\x54\x5a\x18\xc7\xff\xf4\xee\xe1\xf2\xef\xf3\xd3\xcb\x68\x14\xf7\x2e\x4b\x7a\x7a\x52\xff\x25\xc6\x27\x70\x94\x1d\x0e\x4e\x16\x73\x58\x3e\x61\xdd\x67\xd6\xdd\xe9\xd5\xcd\x25\x7d\xdc\xdc\xfe\x7d\x76\x7a\x36\xbd\x9c\xde\xff\x4f\x94\xfc\x09\x4b\xf8\xe1\x04\xa2\x68\xdf\x05\xe4\xa8\x0b\xb9\x74\x01\x45\x9c\x3d\x7e\xc9\x6e\x98\x36\xf8\xa1\x52\xcc\xc6\xcb\x14\xde\xff\xba\x27\x82\xf7\x73\x84\x13\x38\xea\x02\x74\x8f\x5e\xfb\x8f\x8e\x83\x73\xf0\xc3\xf5\x33\x07\x87\x01\x55\xca\x2f\x08\xad\xc1\x9d\xf2\x3c\x9d\x40\x89\x12\x35\xa3\x82\x08\x53\x09\x4a\x93\xeb\xe6\x4a\x0f\x97\x52\xc1\x5f\x29\xfd\x15\x62\x66\xa1\x42\x66\x2c\xac\x84\x5d\x74\xf5\xd6\x11\xa1\x26\x95\x7d\xe1\x1f\x73\x84\xba\x35\xb6\xff\x89\xbe\xf5\x4d\x27\x06\x5a\x29\xe6\x4a\xd7\xd5\x06\x98\x05\xcd\x24\x57\x35\xcc\xb5\xaa\x1d\x07\x94\x56\x68\x04\xd3\xb0\x02\xa9\x6d\x50\xeb\x7d\xff\x6b\xd6\x5d\xf1\x41\x69\xc0\xb5\xbb\x35\xdd\xd1\x64\x7d\xa0\xd9\x66\xf7\x76\xae\xd0\x80\x54\x16\xb8\x72\xd2\x7a\x16\x83\x9d\xa8\x62\xdd\x39\x56\x7a\xdb\x3a\x9d\xe5\xa6\x93\x5b\xc2\x47\x67\xcc\x20\x8f\x13\x57\xcc\x5e\x3f\x75\xc3\x34\x4a\xdb\xd1\xbf\xc2\xea\x05\x47\x26\x1d\xfb\x3c\x87\xfb\x05\x1a\x84\x9a\xad\x45\xdd\xd6\x06\x98\x46\x32\x7d\xd9\x32\xcd\x81\x95\x8c\x90\x2b\x18\x55\xa3\x5d\x50\x91\x2b\x15\xfd\x5d\x69\x25\x4b\xd7\x17\x0d\x4a\x42\xdd\xc0\xc0\x76\xdd\x96\x33\xcb\xa0\x95\xb8\x6e\xb0\xb0\xc8\xab\xcd\x33\x25\x1a\x26\x2f\x45\x2d\xac\xd9\x8a\xbc\x5d\x73\x31\x7b\x6a\xad\x16\xb3\xd6\xe2\xb9\x6a\xa5\x75\x1b\xc7\x70\x74\x78\x48\x12\x07\x17\x4b\x94\x76\xbc\x01\x00\xc3\xe6\xa5\x90\x5f\x9f\xee\xf5\x9b\x8f\x49\x1a\x3e\x15\xe6\x8c\xd9\x62\x31\x2a\x45\xcf\x6d\x3e\x14\xd6\xbe\x06\x11\xc9\xeb\x35\x76\xbb\x87\x6b\x7b\xc5\x9a\x1b\x8f\x7c\x95\x8e\x47\x20\x98\x4a\xfe\xb9\xaa\x1b\x65\x84\xc5\xd7\x29\x5d\x70\xf8\x62\xfd\xed\x31\x85\xf1\xde\x59\x07\xf9\xbf\x3d\x26\x49\x38\x54\x62\xdb\x84\x8f\x6f\x45\x8b\x1f\x94\xbe\x47\x63\x09\xc3\xd4\xc8\xa4\x43\x5a\x33\x97\xb2\x1c\x84\xa4\x9c\xb1\x40\x2d\x4a\xc8\x32\x25\x00\x4a\x5e\xaf\x05\xb5\x3e\xe3\x32\xc1\xd0\x15\xc0\x66\x6a\x89\x2e\x81\x55\x6b\x09\x39\x51\x2c\x8c\xcf\x66\x30\xb5\xd0\x89\x67\x80\x49\x10\xf2\xa0\xc6\x5a\xe9\x0d\xf1\x1c\x3a\xc1\xdc\xe7\x99\x70\xc8\xac\x4f\x61\x4e\x59\x29\xcd\xdb\x81\xaa\xd7\x29\x4e\x20\x7e\xb7\xed\xb1\x53\x79\xe5\x6e\xfc\x57\x10\x35\x18\x64\x3b\x7e\xd2\xaf\x9f\xb2\x8b\x93\xfd\xfd\x1a\xbf\xa7\x57\xf7\xde\xc4\x97\x7a\x6a\x9e\xc3\x55\xbb\xbe\x73\xd3\xf2\x95\xe0\xbc\xc2\x15\xa5\x6e\x6f\xe1\x7a\xbb\x64\x17\xcc\x82\x59\xa8\xb6\xe2\x30\x43\x60\xd6\xb2\x62\x81\x9c\xfc\xcc\x60\x34\x7d\xfa\xd1\x3b\x23\x87\x4c\xa9\x7a\xa1\x01\xbb\x72\x35\x4c\x96\xe6\x18\x1c\x32\x30\x9d\x2b\x3a\x50\xcc\x38\xef\x7e\x77\x23\x15\x08\x39\x57\xc4\x96\xc2\x42\xe3\x3f\x2d\x1a\x7b\x60\x1a\x2c\xc4\x5c\x14\x3d\x8c\x20\xee\xb7\xa2\x5c\x58\x90\x6a\x05\x2b\x04\x25\xab\x0d\x98\xb6\x21\x1d\x1d\x11\x79\x9e\xaa\xb5\x90\x2e\xbe\xa8\x62\x33\xdb\x69\x31\x61\x96\x71\xe5\xa0\x16\x16\xb6\x0f\x88\x17\xec\x10\x93\x2e\xa2\xc0\x6b\x56\xa3\x1f\xb9\x12\xa0\xe8\x89\xe9\x31\x20\xfb\xc4\x24\xaf\x50\x27\x30\xfe\x45\xce\x56\x96\xe6\x6f\xf2\xa1\x1f\xc5\xb3\x97\x79\xa6\xc3\x3e\xd5\x88\x6d\xda\x9a\x98\xd6\xb3\x8f\x2f\xe5\x7e\x42\xee\xce\x73\x07\xe7\x6f\x08\xcd\x57\x32\x8e\x4e\xb9\x2b\x9f\x5b\xf3\x8f\x3c\x17\x6d\x43\xa0\x13\x1d\x5e\x17\xbe\x27\xee\x94\xd8\xd1\xf4\x03\x9d\x77\x4c\xf4\xaa\x3b\x77\x8b\xa6\x51\xd2\xe0\x17\x2d\x5c\x68\x69\x78\xe7\xd7\x9d\xdf\x68\x3a\x0b\x1c\xa0\xd0\xfd\xd2\xe7\xdb\xa9\x83\x46\xf9\xc3\x02\x59\x65\x17\x1d\x62\x09\x82\x45\xe6\xa2\xf0\xd3\xfd\xfd\x4d\xac\x57\x29\xb8\xb6\xdc\x43\x67\xfa\x7c\xa4\x3f\x34\x3a\x1e\x9f\x80\xee\xe7\xc6\x98\xca\x55\x10\x98\x21\xad\xdc\xf0\xf3\x14\x95\x12\xc9\x8c\x95\x8e\xca\x3f\x6e\x3c\x43\xae\xa1\x17\x94\xe8\xb2\x2b\xac\x67\xa8\xe3\xa8\x70\x6f\x35\x07\x7d\x14\x0a\x1e\x25\xd9\x9d\x8b\x83\xb8\x03\x78\x27\x11\x7c\x83\x3c\x07\x31\x87\x67\xb4\x54\x0b\xa9\x81\x1b\xb4\x5e\x95\x7f\x04\x1f\x00\x58\x2f\xc7\x35\xae\xf6\xdf\x96\x02\xbd\x20\x51\x8d\x8f\xb7\x17\x77\x86\x79\x01\xa4\x05\x7b\x51\xb9\x9b\x35\x26\xcf\x66\x0d\x2f\x03\xd4\x4e\x80\x31\x48\xef\x28\xa3\x63\xba\xa3\x3b\x1d\x27\x84\xd1\x83\x60\x3c\x87\x78\xb3\xf6\xa3\x91\x33\xdd\x1d\x5a\xaf\x8f\xc6\x7f\x04\xef\xce\xbc\x2c\xed\x1b\xc5\x35\x68\xbf\x5f\x5a\x07\x31\xfb\xe8\xe9\xbe\xf3\x1c\x4e\x39\x77\x55\xa1\x67\xe9\xab\x4d\xc7\x90\x68\xa8\x83\x3c\xa4\x74\x25\xc5\x8c\x66\xb2\xc4\x71\x64\x98\xd8\x47\xf6\x5e\x15\x4e\x39\xef\x7f\xcf\x58\x99\xfd\x85\x9b\x38\x71\xfc\xb2\xff\x66\x55\x8b\xde\x8b\x3b\x02\x3d\x11\xc6\xbf\x90\xf4\x51\xbf\x8d\x18\xcf\x97\x4a\x86\x6f\xdb\x14\xc0\x8e\xb9\x49\x7a\x86\x17\xf4\xb6\x49\x55\xdb\x23\xd7\x9c\x0a\x2c\x08\x3e\x14\xc4\xc9\x84\xfa\x6c\x4d\xaf\x1e\x2e\x98\x4c\x41\x9a\x1a\x97\x3f\xbd\xe4\xc9\x9f\x60\x8a\xec\x13\x33\x1e\x0c\x3a\xad\x89\x9e\xc0\xec\xa5\x2a\x29\xec\x1d\x2a\x44\xde\xc7\xe4\x13\x06\xd9\xd4\x74\x20\x95\x0f\x16\x7b\x8b\xc9\x22\xcf\x35\x4a\xfd\x0c\xf5\x52\xe8\xfd\x4b\x3e\x73\x56\x99\x81\x91\x33\x93\x9b\xa3\xa7\x93\xb4\xb3\xd0\x74\xe2\x0c\x50\x38\x05\xa6\x93\x51\xc2\xa5\x64\x85\xc1\x04\xc3\x72\x6f\x08\xee\xdb\x09\xbd\xef\xa1\xb6\x06\x16\xb8\xf6\xdd\xc2\x50\x1f\xeb\x46\x00\x98\x4e\x4c\x0a\x46\x51\x8e\x81\x5d\x28\x83\xf4\xc3\xf5\xa3\x42\x69\x8d\x55\x37\xfe\x57\xaa\x24\x60\xc3\x3b\x99\x8c\x1b\x5e\x4c\x6f\xdc\x0a\x65\xec\x65\x75\xe5\xe7\x97\x9f\xe1\xa7\x9f\xdc\x6a\xa7\x89\x5b\x3c\x7a\xdf\x95\xa4\xdd\x77\xae\xce\xd7\xc7\x70\xf4\x1e\x66\x1b\x8b\x10\xff\xf2\xf3\x41\xb1\x60\x9a\x84\x4d\x52\xf8\xc3\xaf\x1e\xbd\xdf\xae\xfa\x91\x87\x84\xe9\x83\xa7\x33\x5b\xe0\x65\x38\x33\x29\x3c\x90\xd1\x16\xb8\xce\x26\x48\x01\xe7\x6d\xd3\x0b\xf9\x76\x47\x71\x9e\xb9\x43\x0f\x82\x47\xa9\x2f\x0f\xbb\x2f\x56\xe4\xc4\xee\xa1\x3e\x3b\x13\xe5\x85\xe4\x82\xc9\xec\xb3\xb3\x6d\x6f\x94\x33\xf3\xbf\x7f\x1c\xff\x5f\xe2\x2b\xa4\x77\xef\x7e\x29\xbd\xd1\xfe\x95\x90\x74\xe6\xfb\x64\xec\xa5\xe9\xc5\x1b\x55\x24\x2a\x9e\xda\x4d\x12\xe3\xab\xc3\x7d\x5d\x91\x40\x7a\xf0\xe8\xe1\xdb\x08\x09\x6e\x81\xf1\xf6\xd1\x83\xa3\x29\xb4\x98\x51\x68\xd1\x14\x09\xac\x69\x2a\xd1\xbd\x6a\x3a\x88\xf6\xd9\x20\xa7\x42\x30\x54\x59\x7a\xf0\x25\x6b\x6a\x9a\x0f\x1c\xec\x1c\x1e\x5e\xb6\x88\xf3\x85\xb7\x14\xca\xca\x25\xd3\x74\xc1\x08\x2e\xb9\xb7\x8f\xf1\x03\xc9\xe9\xcd\xcd\xc3\xf5\xe9\xd5\x45\x94\x8c\xde\x2b\xfa\x33\x27\x7b\x48\xc7\xef\x22\x23\x8a\xb7\xf3\xda\x61\x15\x06\xda\x47\xc4\xa0\xc4\x15\xea\x12\xe9\xd1\x71\x58\x99\x74\x93\x79\xf7\x16\x38\xac\x5e\xe3\x8a\xbc\x34\x4c\x96\x86\xce\xf4\xaf\xff\xd9\x5d\xb1\xc0\x9a\x7d\xbe\xbd\x4c\x77\x56\xb7\x78\xef\x2f\xdc\xf4\xd5\xc3\x8b\xe9\xd8\xfb\x59\x50\xa3\x6d\xb5\x04\x1d\x3e\x86\xff\x3f\x00\x06\xb9\x8e\xbf\xc7\x1a\x00\x00")
 
 func _hardcodedTracingGoBytes() ([]byte, error) {
 	return bindataRead(
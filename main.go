@@ -2,23 +2,30 @@ package main
 
 import (
 	"bufio"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/go-openapi/loads"
-	"github.com/go-openapi/loads/fmts"
 	"github.com/go-openapi/spec"
 	"github.com/go-openapi/swag"
 
+	"github.com/Clever/wag/v9/bundle"
 	goclient "github.com/Clever/wag/v9/clients/go"
 	jsclient "github.com/Clever/wag/v9/clients/js"
+	"github.com/Clever/wag/v9/diff"
 	"github.com/Clever/wag/v9/hardcoded"
+	"github.com/Clever/wag/v9/mock"
 	"github.com/Clever/wag/v9/models"
+	"github.com/Clever/wag/v9/openapi"
 	"github.com/Clever/wag/v9/server"
 	"github.com/Clever/wag/v9/server/gendb"
 	"github.com/Clever/wag/v9/swagger"
@@ -31,6 +38,7 @@ type config struct {
 	clientOnly         *bool
 	dynamoOnly         *bool
 	withTests          *bool
+	withServerTest     *bool
 	outputPath         *string
 	versionFlag        *bool
 	swaggerFile        *string
@@ -48,11 +56,20 @@ type config struct {
 	generateJSClient bool
 	generateServer   bool
 	generateTracing  bool
+	// generateServerTest is set if both the server and the Go client are generated
+	generateServerTest bool
 }
 
 var version string
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		os.Exit(runDiff(os.Args[2:], os.Stdout))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "mock" {
+		os.Exit(runMock(os.Args[2:]))
+	}
+
 	conf := config{
 		swaggerFile:        flag.String("file", "swagger.yml", "the spec file to use"),
 		goPackageName:      flag.String("go-package", "", "package of the generated go code"),
@@ -64,6 +81,7 @@ func main() {
 		dynamoOnly:         flag.Bool("dynamo-only", false, "only generate dynamo code"),
 		relativeDynamoPath: flag.String("dynamo-path", "", "path to generate dynamo code relative to go package path"),
 		withTests:          flag.Bool("with-tests", false, "generate tests for the generated db code"),
+		withServerTest:     flag.Bool("with-servertest", false, "generate the servertest package, which runs the server with a Go client for it in tests"),
 	}
 	flag.Parse()
 	if *conf.versionFlag {
@@ -75,8 +93,7 @@ func main() {
 		log.Fatal(err.Error())
 	}
 
-	loads.AddLoader(fmts.YAMLMatcher, fmts.YAMLDoc)
-	doc, err := loads.Spec(*conf.swaggerFile)
+	doc, err := loadSpec(*conf.swaggerFile)
 	if err != nil {
 		log.Fatalf("Error loading swagger file: %s", err)
 	}
@@ -122,6 +139,12 @@ func main() {
 		}
 	}
 
+	if conf.generateServerTest {
+		if err := generateServerTest(*conf.goPackageName, conf.goAbsolutePackagePath, *conf.outputPath, swaggerSpec); err != nil {
+			log.Fatal(err.Error())
+		}
+	}
+
 	if conf.generateJSClient {
 		if err := generateJSClient(*conf.jsModulePath, swaggerSpec); err != nil {
 			log.Fatal(err.Error())
@@ -129,6 +152,194 @@ func main() {
 	}
 }
 
+// loadSpec loads a swagger spec in either YAML or JSON format. References to other files are
+// resolved, OpenAPI 3.0 and 3.1 specs are converted to Swagger 2.0, and inline object schemas are
+// moved to definitions.
+func loadSpec(swaggerFile string) (*loads.Document, error) {
+	raw, err := bundle.Load(swaggerFile)
+	if err != nil {
+		return nil, err
+	}
+	if openapi.IsOpenAPI3(raw) {
+		raw, err = openapi.Convert(raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if raw, err = hoistInlineSchemas(raw); err != nil {
+		return nil, err
+	}
+	// loads.Spec records the path of the spec, which validation needs to resolve references, so
+	// load the spec through a loader that returns the bundled spec for the path
+	bundledSpecs.Lock()
+	bundledSpecs.raw[swaggerFile] = raw
+	bundledSpecs.Unlock()
+	return loads.Spec(swaggerFile)
+}
+
+// bundledSpecs holds the specs loadSpec has bundled by their path. go-openapi's loaders are
+// global, so a single loader for all of them is registered in init.
+var bundledSpecs = struct {
+	sync.Mutex
+	raw map[string]json.RawMessage
+}{raw: map[string]json.RawMessage{}}
+
+func bundledSpec(path string) (json.RawMessage, bool) {
+	bundledSpecs.Lock()
+	defer bundledSpecs.Unlock()
+	raw, ok := bundledSpecs.raw[path]
+	return raw, ok
+}
+
+func init() {
+	loads.AddLoader(func(path string) bool {
+		_, ok := bundledSpec(path)
+		return ok
+	}, func(path string) (json.RawMessage, error) {
+		raw, _ := bundledSpec(path)
+		return raw, nil
+	})
+}
+
+// hoistInlineSchemas moves the inline object schemas of a spec to definitions. Specs without inline
+// objects are returned as is.
+func hoistInlineSchemas(raw json.RawMessage) (json.RawMessage, error) {
+	var s spec.Swagger
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+	hoisted, err := swagger.HoistInlineSchemas(&s)
+	if err != nil || !hoisted {
+		return raw, err
+	}
+	return json.Marshal(s)
+}
+
+// runDiff implements the `wag diff` command, which reports the breaking changes between two
+// swagger specs. It returns the exit code for the process: 0 if there are no breaking changes,
+// 1 if there are, and 2 if the specs could not be compared.
+func runDiff(args []string, out io.Writer) int {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	format := flags.String("format", "text", "output format [text|json]")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: wag diff [-format text|json] OLD_SPEC NEW_SPEC\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return 2
+	}
+	if *format != "text" && *format != "json" {
+		log.Printf("format must be one of \"text\" or \"json\"")
+		return 2
+	}
+
+	oldDoc, err := loadSpec(flags.Arg(0))
+	if err != nil {
+		log.Printf("Error loading swagger file %s: %s", flags.Arg(0), err)
+		return 2
+	}
+	newDoc, err := loadSpec(flags.Arg(1))
+	if err != nil {
+		log.Printf("Error loading swagger file %s: %s", flags.Arg(1), err)
+		return 2
+	}
+
+	changes, err := diff.Compare(*oldDoc.Spec(), *newDoc.Spec())
+	if err != nil {
+		log.Printf("Error comparing swagger files: %s", err)
+		return 2
+	}
+	if err := writeDiffReport(out, *format, changes); err != nil {
+		log.Printf("Error writing report: %s", err)
+		return 2
+	}
+	if len(changes) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// listenAndServe serves the mock server. Tests replace it to check the handler without listening.
+var listenAndServe = http.ListenAndServe
+
+// runMock implements the `wag mock` command, which serves the operations of a swagger spec with
+// example responses. It returns the exit code for the process if the server stops.
+func runMock(args []string) int {
+	flags := flag.NewFlagSet("mock", flag.ContinueOnError)
+	addr := flags.String("addr", "localhost:8000", "the address to listen on")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: wag mock [-addr localhost:8000] SPEC\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	doc, err := loadSpec(flags.Arg(0))
+	if err != nil {
+		log.Printf("Error loading swagger file %s: %s", flags.Arg(0), err)
+		return 2
+	}
+	swaggerSpec := *doc.Spec()
+	injectDefaultDefinitions(&swaggerSpec)
+	if err := validation.Validate(*doc, false); err != nil {
+		log.Printf("Swagger file not valid: %s", err)
+		return 2
+	}
+	if err := swagger.ValidateResponses(swaggerSpec); err != nil {
+		log.Printf("Failed processing the swagger spec: %s", err)
+		return 2
+	}
+
+	log.Printf("Serving mock responses for %s on %s. Set the %s header to pick a status code.",
+		flags.Arg(0), *addr, mock.StatusHeader)
+	if err := listenAndServe(*addr, mock.NewHandler(swaggerSpec)); err != nil {
+		log.Printf("Error serving: %s", err)
+		return 1
+	}
+	return 0
+}
+
+// writeDiffReport writes the breaking changes in either the "text" or "json" format.
+func writeDiffReport(out io.Writer, format string, changes []diff.BreakingChange) error {
+	if format == "json" {
+		if changes == nil {
+			changes = []diff.BreakingChange{}
+		}
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			Breaking bool                  `json:"breaking"`
+			Changes  []diff.BreakingChange `json:"changes"`
+		}{
+			Breaking: len(changes) > 0,
+			Changes:  changes,
+		})
+	}
+
+	if len(changes) == 0 {
+		_, err := fmt.Fprintln(out, "No breaking changes found.")
+		return err
+	}
+	if _, err := fmt.Fprintf(out, "Found %d breaking change(s):\n", len(changes)); err != nil {
+		return err
+	}
+	for _, c := range changes {
+		if _, err := fmt.Fprintf(out, "  - %s [%s]\n", c, c.Kind); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func generateGoModels(packageName, basePath, outputPath string, swaggerSpec spec.Swagger) error {
 	if err := prepareDir(filepath.Join(basePath, "models")); err != nil {
 		return err
@@ -194,6 +405,16 @@ func generateGoClient(goPackageName, basePath, outputPath string, swaggerSpec sp
 	return nil
 }
 
+func generateServerTest(goPackageName, basePath, outputPath string, swaggerSpec spec.Swagger) error {
+	if err := prepareDir(filepath.Join(basePath, "servertest")); err != nil {
+		return err
+	}
+	if err := server.GenerateServerTest(goPackageName, basePath, outputPath, swaggerSpec); err != nil {
+		return fmt.Errorf("Failed to generate servertest: %s", err)
+	}
+	return nil
+}
+
 func generateJSClient(jsModulePath string, swaggerSpec spec.Swagger) error {
 	if err := prepareDir(jsModulePath); err != nil {
 		return err
@@ -229,6 +450,8 @@ func (c *config) parse() error {
 		return err
 	}
 
+	c.generateServerTest = swag.BoolValue(c.withServerTest) && c.generateServer && c.generateGoClient
+
 	c.setGeneratedFilePaths()
 
 	return nil
@@ -1,8 +1,15 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/Clever/wag/v9/diff"
+	"github.com/Clever/wag/v9/validation"
 	"github.com/go-openapi/swag"
 	"github.com/stretchr/testify/assert"
 )
@@ -218,3 +225,68 @@ func Test_config_validate(t *testing.T) {
 		})
 	}
 }
+
+func Test_runDiff(t *testing.T) {
+	var out bytes.Buffer
+	assert.Equal(t, 0, runDiff([]string{"diff/testyml/old.yml", "diff/testyml/additive.yml"}, &out))
+	assert.Equal(t, "No breaking changes found.\n", out.String())
+
+	out.Reset()
+	assert.Equal(t, 1, runDiff([]string{"-format", "json", "diff/testyml/old.yml", "diff/testyml/new.yml"}, &out))
+	var report struct {
+		Breaking bool                  `json:"breaking"`
+		Changes  []diff.BreakingChange `json:"changes"`
+	}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &report))
+	assert.True(t, report.Breaking)
+	assert.Len(t, report.Changes, 12)
+
+	assert.Equal(t, 2, runDiff([]string{"diff/testyml/old.yml"}, &out))
+	assert.Equal(t, 2, runDiff([]string{"diff/testyml/old.yml", "does-not-exist.yml"}, &out))
+}
+
+func Test_runMock(t *testing.T) {
+	defer func(original func(string, http.Handler) error) { listenAndServe = original }(listenAndServe)
+	var handler http.Handler
+	listenAndServe = func(addr string, h http.Handler) error {
+		assert.Equal(t, ":9000", addr)
+		handler = h
+		return nil
+	}
+	assert.Equal(t, 0, runMock([]string{"-addr", ":9000", "mock/testyml/swagger.yml"}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/v1/books/1", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	listenAndServe = func(string, http.Handler) error { return errors.New("address already in use") }
+	assert.Equal(t, 1, runMock([]string{"mock/testyml/swagger.yml"}))
+	assert.Equal(t, 2, runMock([]string{}))
+	assert.Equal(t, 2, runMock([]string{"does-not-exist.yml"}))
+}
+
+func Test_loadSpecOpenAPI3(t *testing.T) {
+	doc, err := loadSpec("openapi/testyml/petstore30.yml")
+	assert.NoError(t, err)
+	s := doc.Spec()
+	assert.Equal(t, "2.0", s.Swagger)
+	assert.Equal(t, "/v1", s.BasePath)
+	assert.NoError(t, validation.Validate(*doc, false))
+	assert.Contains(t, s.Definitions, "Pet")
+
+	_, err = loadSpec("diff/testyml/old.yml")
+	assert.NoError(t, err)
+}
+
+func Test_loadSpecMultiFile(t *testing.T) {
+	doc, err := loadSpec("bundle/testyml/service/swagger.yml")
+	assert.NoError(t, err)
+	assert.NoError(t, validation.Validate(*doc, true))
+	for _, name := range []string{"Audit", "BadRequest", "Book", "NotFound", "PageInfo", "Person"} {
+		assert.Contains(t, doc.Spec().Definitions, name)
+	}
+	assert.NotNil(t, doc.Spec().Paths.Paths["/books"].Get)
+
+	_, err = loadSpec("bundle/testyml/invalid/missing-file.yml")
+	assert.Error(t, err)
+}
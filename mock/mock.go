@@ -0,0 +1,153 @@
+// Package mock serves the operations of a spec with example responses, so that clients can be
+// developed against an API before its server is implemented.
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/gorilla/mux"
+
+	"github.com/Clever/wag/v9/swagger"
+)
+
+// StatusHeader is the request header that picks which of the documented responses of an
+// operation the mock server returns, e.g. "X-Mock-Status: 404". Without it the mock server
+// returns the lowest success status code of the operation.
+const StatusHeader = "X-Mock-Status"
+
+// NewHandler returns a handler that mounts the same routes as the router of the generated
+// server, and answers each of them with an example of the operation's response.
+//
+// The body of a response is the example of the response for application/json, or its x-example,
+// or a value built from its schema when it has neither.
+func NewHandler(s spec.Swagger) http.Handler {
+	router := mux.NewRouter()
+	for _, path := range swagger.SortedPathItemKeys(s.Paths.Paths) {
+		ops := swagger.PathItemOperations(s.Paths.Paths[path])
+		for _, method := range swagger.SortedOperationsKeys(ops) {
+			router.Methods(method).Path(s.BasePath + path).Handler(operationHandler(&s, ops[method]))
+		}
+	}
+	return router
+}
+
+// operationHandler returns a handler that writes the responses of an operation.
+func operationHandler(s *spec.Swagger, op *spec.Operation) http.HandlerFunc {
+	statusCodes := []int{}
+	if op.Responses != nil {
+		for statusCode := range op.Responses.StatusCodeResponses {
+			statusCodes = append(statusCodes, statusCode)
+		}
+	}
+	sort.Ints(statusCodes)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		statusCode, err := pickStatusCode(r.Header.Get(StatusHeader), statusCodes)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{
+				"message": fmt.Sprintf("%s for %s: %s", StatusHeader, op.ID, err),
+			})
+			return
+		}
+		if statusCode == 0 {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		response := resolveResponse(s, op.Responses.StatusCodeResponses[statusCode])
+		for name, header := range response.Headers {
+			if example, ok := headerExample(header); ok {
+				w.Header().Set(name, fmt.Sprint(example))
+			}
+		}
+		body, ok := responseExample(s, response)
+		if !ok || statusCode == http.StatusNoContent {
+			w.WriteHeader(statusCode)
+			return
+		}
+		writeJSON(w, statusCode, body)
+	}
+}
+
+// pickStatusCode returns the status code requested in the StatusHeader, which must be one of the
+// documented status codes. If no status code is requested it returns the lowest success status
+// code, or the lowest status code if there are no successful responses. It returns 0 if the
+// operation doesn't document any responses.
+func pickStatusCode(requested string, statusCodes []int) (int, error) {
+	if requested != "" {
+		statusCode, err := strconv.Atoi(strings.TrimSpace(requested))
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a status code", requested)
+		}
+		for _, documented := range statusCodes {
+			if documented == statusCode {
+				return statusCode, nil
+			}
+		}
+		documented := []string{}
+		for _, statusCode := range statusCodes {
+			documented = append(documented, strconv.Itoa(statusCode))
+		}
+		return 0, fmt.Errorf("%d is not a documented response, use one of %s",
+			statusCode, strings.Join(documented, ", "))
+	}
+	for _, statusCode := range statusCodes {
+		if statusCode < 400 {
+			return statusCode, nil
+		}
+	}
+	if len(statusCodes) > 0 {
+		return statusCodes[0], nil
+	}
+	return 0, nil
+}
+
+// resolveResponse follows a reference to a response in the responses section of a spec.
+func resolveResponse(s *spec.Swagger, response spec.Response) spec.Response {
+	ref := response.Ref.String()
+	if !strings.HasPrefix(ref, "#/responses/") {
+		return response
+	}
+	if resolved, ok := s.Responses[strings.TrimPrefix(ref, "#/responses/")]; ok {
+		return resolved
+	}
+	return response
+}
+
+// responseExample returns the body of a response. It returns false if the response has no body.
+func responseExample(s *spec.Swagger, response spec.Response) (interface{}, bool) {
+	if example, ok := response.Examples["application/json"]; ok {
+		return example, true
+	}
+	if example, ok := response.Extensions["x-example"]; ok {
+		return example, true
+	}
+	if response.Schema == nil {
+		return nil, false
+	}
+	return swagger.Example(s, *response.Schema), true
+}
+
+// headerExample returns the example of a response header. Headers without an example aren't set,
+// since a made up value for a header such as X-Next-Page-Path would be followed by clients.
+func headerExample(header spec.Header) (interface{}, bool) {
+	if header.Example != nil {
+		return header.Example, true
+	}
+	if example, ok := header.Extensions["x-example"]; ok {
+		return example, true
+	}
+	return nil, false
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
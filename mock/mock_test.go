@@ -0,0 +1,99 @@
+package mock
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/loads/fmts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	loads.AddLoader(fmts.YAMLMatcher, fmts.YAMLDoc)
+	doc, err := loads.Spec("testyml/swagger.yml")
+	require.NoError(t, err)
+	server := httptest.NewServer(NewHandler(*doc.Spec()))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func do(t *testing.T, method, url, status string) (*http.Response, string) {
+	req, err := http.NewRequest(method, url, strings.NewReader(`{}`))
+	require.NoError(t, err)
+	if status != "" {
+		req.Header.Set(StatusHeader, status)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(body)
+}
+
+func TestSynthesizedResponse(t *testing.T) {
+	server := newTestServer(t)
+
+	resp, body := do(t, "GET", server.URL+"/v1/books", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.JSONEq(t, `[{"id": 1, "name": "stringxxxx", "genre": "scifi", "published": "2006-01-02"}]`, body)
+	assert.Equal(t, "1", resp.Header.Get("X-Total-Count"))
+	assert.Empty(t, resp.Header.Get("X-Next-Page-Path"))
+
+	resp, body = do(t, "GET", server.URL+"/v1/books/7", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"id": 1, "name": "stringxxxx", "genre": "scifi", "published": "2006-01-02"}`, body)
+}
+
+func TestExampleResponses(t *testing.T) {
+	server := newTestServer(t)
+
+	resp, body := do(t, "POST", server.URL+"/v1/books", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"id": 42, "name": "Dune", "genre": "scifi"}`, body)
+
+	resp, body = do(t, "GET", server.URL+"/v1/books/7", "404")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.JSONEq(t, `{"message": "book not found"}`, body)
+}
+
+func TestStatusHeader(t *testing.T) {
+	server := newTestServer(t)
+
+	// responses defined in the responses section of the spec
+	resp, body := do(t, "GET", server.URL+"/v1/books", "500")
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.JSONEq(t, `{"message": "string"}`, body)
+
+	resp, body = do(t, "DELETE", server.URL+"/v1/books/7", "")
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Empty(t, body)
+
+	for _, status := range []string{"teapot", "404"} {
+		resp, body = do(t, "DELETE", server.URL+"/v1/books/7", status)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		var message struct {
+			Message string `json:"message"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(body), &message))
+		assert.Contains(t, message.Message, "deleteBook")
+	}
+	assert.Contains(t, body, "use one of") // lists the documented status codes
+}
+
+func TestRoutes(t *testing.T) {
+	server := newTestServer(t)
+
+	resp, _ := do(t, "PUT", server.URL+"/v1/books", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	resp, _ = do(t, "GET", server.URL+"/books", "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
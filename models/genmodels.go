@@ -51,14 +51,16 @@ func Generate(packageName, basePath, outputPath string, s spec.Swagger) error {
 	if err := generateInputs(basePath, s); err != nil {
 		return fmt.Errorf("error generating inputs: %s", err)
 	}
-	if err := CreateModFile("models/go.mod", basePath, packageName, outputPath); err != nil {
+	if err := CreateModFile("models/go.mod", basePath, packageName, outputPath,
+		swagger.HasPolymorphicDefinitions(&s)); err != nil {
 		return fmt.Errorf("error creating go.mod file: %s", err)
 	}
 	return nil
 }
 
-// CreateModFile creates a go.mod file for the client module.
-func CreateModFile(path string, basePath, packageName, outputPath string) error {
+// CreateModFile creates a go.mod file for the models module. The models of polymorphic
+// definitions use github.com/go-openapi/runtime to decode them.
+func CreateModFile(path string, basePath, packageName, outputPath string, hasPolymorphism bool) error {
 	outputPath = strings.TrimPrefix(outputPath, ".")
 	moduleName, versionSuffix := utils.ExtractModuleNameAndVersionSuffix(packageName, outputPath)
 
@@ -69,6 +71,10 @@ func CreateModFile(path string, basePath, packageName, outputPath string) error
 	}
 
 	defer f.Close()
+	runtimeRequirement := ""
+	if hasPolymorphism {
+		runtimeRequirement = "\n\tgithub.com/go-openapi/runtime v0.24.1"
+	}
 	modFileString := `
 module ` + moduleName + outputPath + `/models` + versionSuffix + `
 
@@ -76,7 +82,7 @@ module ` + moduleName + outputPath + `/models` + versionSuffix + `
 go 1.24
 
 require (
-	github.com/go-openapi/errors v0.20.2
+	github.com/go-openapi/errors v0.20.2` + runtimeRequirement + `
 	github.com/go-openapi/strfmt v0.21.2
 	github.com/go-openapi/swag v0.21.1
 	github.com/go-openapi/validate v0.22.0
@@ -97,12 +103,18 @@ require (
 func generateInputs(basePath string, s spec.Swagger) error {
 	g := swagger.Generator{BasePath: basePath}
 
+	// File parameters are passed as io.ReadCloser, so only import io when the spec has them
+	ioImport := ""
+	if hasFileParams(s) {
+		ioImport = "\n\"io\""
+	}
+
 	g.Printf(`
 package models
 
 import(
 		"encoding/json"
-		"fmt"
+		"fmt"%s
 		"net/url"
 		"strconv"
 		"strings"
@@ -119,7 +131,7 @@ var _ = strconv.FormatInt
 var _ = strings.Replace
 var _ = validate.Maximum
 var _ = strfmt.NewFormats
-`)
+`, ioImport)
 
 	paths := s.Paths
 	for _, pathKey := range swagger.SortedPathItemKeys(paths.Paths) {
@@ -134,7 +146,7 @@ var _ = strfmt.NewFormats
 			if singleSchemaedBodyParameter, _ := swagger.SingleSchemaedBodyParameter(op); singleSchemaedBodyParameter {
 				continue
 			}
-			if err := printInputStruct(&g, op); err != nil {
+			if err := printInputStruct(&g, &s, op); err != nil {
 				return err
 			}
 			if err := printInputValidation(&g, op, goClient.IsBinaryBody(op, s.Definitions)); err != nil {
@@ -149,21 +161,29 @@ var _ = strfmt.NewFormats
 	return g.WriteFile("models/inputs.go")
 }
 
-func printInputStruct(g *swagger.Generator, op *spec.Operation) error {
+// hasFileParams returns true if any operation in the spec has file parameters.
+func hasFileParams(s spec.Swagger) bool {
+	for _, path := range s.Paths.Paths {
+		for _, op := range swagger.PathItemOperations(path) {
+			if swagger.HasFileParams(op) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func printInputStruct(g *swagger.Generator, s *spec.Swagger, op *spec.Operation) error {
 	capOpID := swagger.Capitalize(op.ID)
 	g.Printf("// %sInput holds the input parameters for a %s operation.\n", capOpID, op.ID)
 	g.Printf("type %sInput struct {\n", capOpID)
 
 	for _, param := range op.Parameters {
-		if param.In == "formData" {
-			return fmt.Errorf("input parameters with 'In' formData are not supported")
-		}
-
 		typeName, pointer, err := swagger.ParamToType(param)
 		if err != nil {
 			return err
 		}
-		if pointer {
+		if pointer && !swagger.IsPolymorphic(s, param.Schema) {
 			typeName = "*" + typeName
 		}
 
@@ -332,9 +352,21 @@ var queryParamStr = `
 	{{if .Pointer -}}
 	if {{.AccessString}} != nil {
 	{{end}}
-	{{- if eq .Type "array" -}}
+	{{- if and (eq .Type "array") .Separator -}}
+	if len({{.AccessString}}) > 0 {
+		{{- if eq .ItemToStringCode "v"}}
+		urlVals.Add("{{.Name}}", strings.Join({{.AccessString}}, {{printf "%q" .Separator}}))
+		{{- else}}
+		var strs []string
+		for _, v := range {{.AccessString}} {
+			strs = append(strs, {{.ItemToStringCode}})
+		}
+		urlVals.Add("{{.Name}}", strings.Join(strs, {{printf "%q" .Separator}}))
+		{{- end}}
+	}
+	{{- else if eq .Type "array" -}}
 	for _, v := range {{.AccessString}} {
-		urlVals.Add("{{.Name}}", v)
+		urlVals.Add("{{.Name}}", {{.ItemToStringCode}})
 	}
 	{{- else -}}
 	urlVals.Add("{{.Name}}", {{.ToStringCode}})
@@ -357,9 +389,151 @@ func generateOutputs(basePath string, s spec.Swagger) error {
 		return err
 	}
 	g.Print(errorMethodCode)
+
+	successResponseCode, err := generateSuccessResponseTypes(&s)
+	if err != nil {
+		return err
+	}
+	g.Print(successResponseCode)
+	if swagger.ProblemDetails(&s) {
+		g.Print(problemDetailsCode)
+	}
 	return g.WriteFile("models/outputs.go")
 }
 
+// problemDetailsCode defines the types of RFC 7807 problem details, which the server responds
+// with for errors when the spec sets x-problem-details.
+var problemDetailsCode = `
+// ProblemDetails is an RFC 7807 problem details error response. The client returns it for
+// error responses, with the error model of the response's status code as Err.
+type ProblemDetails struct {
+	// Type is a URI reference that identifies the type of problem, "about:blank" by default.
+	Type string ` + "`json:\"type,omitempty\"`" + `
+	// Title is a summary of the type of problem.
+	Title string ` + "`json:\"title,omitempty\"`" + `
+	// Status is the HTTP status code of the response.
+	Status int ` + "`json:\"status,omitempty\"`" + `
+	// Detail explains this occurrence of the problem.
+	Detail string ` + "`json:\"detail,omitempty\"`" + `
+	// Instance is a URI reference that identifies this occurrence of the problem.
+	Instance string ` + "`json:\"instance,omitempty\"`" + `
+	// InvalidParams are the parameters of the request that are invalid.
+	InvalidParams []InvalidParam ` + "`json:\"invalid-params,omitempty\"`" + `
+
+	// Err is the error model of the response's status code, decoded from the same body.
+	Err error ` + "`json:\"-\"`" + `
+}
+
+func (p *ProblemDetails) Error() string {
+	if p.Detail != "" {
+		return p.Detail
+	}
+	if p.Err != nil {
+		return p.Err.Error()
+	}
+	return p.Title
+}
+
+// Unwrap returns the error model of the response, so errors.As finds it.
+func (p *ProblemDetails) Unwrap() error {
+	return p.Err
+}
+
+// InvalidParam is a parameter of a request that is invalid.
+type InvalidParam struct {
+	// Name is the name of the parameter, or the path of the invalid field in a body parameter.
+	Name string ` + "`json:\"name\"`" + `
+	// Reason explains why the parameter is invalid.
+	Reason string ` + "`json:\"reason\"`" + `
+}
+`
+
+// generateSuccessResponseTypes generates the <OperationID>Response type for each operation with
+// more than one success response and the <OperationID>Output type for each other operation whose
+// success response declares headers.
+func generateSuccessResponseTypes(s *spec.Swagger) (string, error) {
+	var buf bytes.Buffer
+	for _, pathKey := range swagger.SortedPathItemKeys(s.Paths.Paths) {
+		path := s.Paths.Paths[pathKey]
+		pathItemOps := swagger.PathItemOperations(path)
+		for _, opKey := range swagger.SortedOperationsKeys(pathItemOps) {
+			op := pathItemOps[opKey]
+			if !swagger.HasMultipleSuccessResponses(op) && !swagger.HasOutputType(op) {
+				continue
+			}
+			responses := swagger.SuccessResponses(s, op)
+			for i := range responses {
+				responses[i].Type = strings.Replace(responses[i].Type, "models.", "", 1)
+			}
+			headers, err := swagger.ResponseHeaders(op)
+			if err != nil {
+				return "", err
+			}
+			tmpl := successResponseType{
+				Name:      swagger.SuccessResponseTypeName(op),
+				OpID:      op.ID,
+				Responses: responses,
+				Headers:   headers,
+			}
+			tmplStr := successResponseTypeStr
+			if swagger.HasOutputType(op) {
+				tmpl.Name = swagger.OutputTypeName(op)
+				tmplStr = outputTypeStr
+			}
+			str, err := templates.WriteTemplate(tmplStr, tmpl)
+			if err != nil {
+				return "", err
+			}
+			buf.WriteString(str)
+		}
+	}
+	return buf.String(), nil
+}
+
+type successResponseType struct {
+	Name      string
+	OpID      string
+	Responses []swagger.SuccessResponse
+	Headers   []swagger.ResponseHeader
+}
+
+var successResponseTypeStr = `
+// {{.Name}} holds the success responses of the {{.OpID}} operation. StatusCode is the status
+// code of the response, one of {{range $i, $r := .Responses}}{{if $i}}, {{end}}{{$r.StatusCode}}{{end}}, and the field for that status code holds its body.
+type {{.Name}} struct {
+	StatusCode int
+	{{- range .Responses}}
+	{{- if .Type}}
+	// {{.Field}} is the body of a {{.StatusCode}} response.
+	{{.Field}} {{.Type}}
+	{{- end}}
+	{{- end}}
+	{{- template "headerFields" .}}
+}
+` + headerFieldsStr
+
+var outputTypeStr = `
+// {{.Name}} is the output of the {{.OpID}} operation, which holds the {{with index .Responses 0}}{{if .Type}}body and {{end}}{{end}}headers
+// of its {{(index .Responses 0).StatusCode}} response.
+type {{.Name}} struct {
+	{{- with index .Responses 0}}{{if .Type}}
+	// Body is the body of the response.
+	Body {{.Type}}
+	{{- end}}{{end}}
+	{{- template "headerFields" .}}
+}
+` + headerFieldsStr
+
+// headerFieldsStr defines the fields that hold the response headers of an operation. Headers other
+// than strings are pointers so they can be left unset.
+var headerFieldsStr = `
+{{- define "headerFields"}}
+	{{- range .Headers}}
+	// {{.Field}} is the {{.Name}} header.
+	{{.Field}} {{if .Pointer}}*{{end}}{{.Type}}
+	{{- end}}
+{{- end}}`
+
 // generateErrorMethods finds all responses all error responses and generates an error
 // method for them.
 func generateErrorMethods(s *spec.Swagger) (string, error) {
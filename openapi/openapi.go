@@ -0,0 +1,846 @@
+// Package openapi converts OpenAPI 3.0 and 3.1 documents into the Swagger 2.0 documents the rest
+// of wag works with. Constructs that have a Swagger 2.0 equivalent are converted, e.g.
+// requestBody becomes a body or formData parameters and nullable becomes x-nullable. Constructs
+// without one, like oneOf or cookie parameters, are rejected with an error that says where they
+// are in the document.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/Clever/wag/v9/swagger"
+)
+
+// IsOpenAPI3 returns true if the JSON document is an OpenAPI 3.x document rather than a
+// Swagger 2.0 one.
+func IsOpenAPI3(doc json.RawMessage) bool {
+	var version struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(doc, &version); err != nil {
+		return false
+	}
+	return version.OpenAPI != ""
+}
+
+// Convert converts an OpenAPI 3.0 or 3.1 JSON document into the equivalent Swagger 2.0 JSON
+// document.
+func Convert(doc json.RawMessage) (json.RawMessage, error) {
+	var d map[string]interface{}
+	if err := json.Unmarshal(doc, &d); err != nil {
+		return nil, err
+	}
+	c := converter{doc: d}
+	out, err := c.convert()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(out)
+}
+
+type converter struct {
+	doc map[string]interface{}
+}
+
+func (c converter) convert() (map[string]interface{}, error) {
+	version, _ := c.doc["openapi"].(string)
+	if !strings.HasPrefix(version, "3.0.") && !strings.HasPrefix(version, "3.1.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %s. wag supports 3.0 and 3.1", version)
+	}
+
+	out := map[string]interface{}{
+		"swagger":  "2.0",
+		"schemes":  []interface{}{"http"},
+		"consumes": []interface{}{"application/json"},
+		"produces": []interface{}{"application/json"},
+	}
+	for _, key := range swagger.SortedKeys(c.doc) {
+		value := c.doc[key]
+		switch {
+		case isExtension(key), key == "info", key == "tags", key == "externalDocs", key == "security":
+			out[key] = value
+		case key == "openapi", key == "jsonSchemaDialect":
+		case key == "servers":
+			basePath, err := c.convertServers(value)
+			if err != nil {
+				return nil, err
+			}
+			if basePath != "" {
+				out["basePath"] = basePath
+			}
+		case key == "paths":
+			paths, err := c.convertPaths(asMap(value))
+			if err != nil {
+				return nil, err
+			}
+			out["paths"] = paths
+		case key == "components":
+			if err := c.convertComponents(asMap(value), out); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("#/%s isn't supported", key)
+		}
+	}
+	if _, ok := out["paths"]; !ok {
+		out["paths"] = map[string]interface{}{}
+	}
+	return out, nil
+}
+
+var serverVariableRegex = regexp.MustCompile(`{([^}]+)}`)
+
+// convertServers returns the basePath for the servers of a document, which must all have the
+// same path since Swagger 2.0 documents have a single basePath.
+func (c converter) convertServers(value interface{}) (string, error) {
+	servers, _ := value.([]interface{})
+	basePath := ""
+	for i, s := range servers {
+		server := asMap(s)
+		rawURL, _ := server["url"].(string)
+		variables := asMap(server["variables"])
+		rawURL = serverVariableRegex.ReplaceAllStringFunc(rawURL, func(v string) string {
+			variable := asMap(variables[strings.Trim(v, "{}")])
+			if def, ok := variable["default"].(string); ok {
+				return def
+			}
+			return v
+		})
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return "", fmt.Errorf("#/servers/%d has an invalid url: %s", i, err)
+		}
+		path := strings.TrimSuffix(u.Path, "/")
+		if i > 0 && path != basePath {
+			return "", fmt.Errorf("#/servers/%d has the path %s, but #/servers/0 has the path %s. "+
+				"wag requires every server to have the same path", i, path, basePath)
+		}
+		basePath = path
+	}
+	return basePath, nil
+}
+
+var operationMethods = map[string]bool{
+	"get": true, "put": true, "post": true, "delete": true, "options": true, "head": true, "patch": true,
+}
+
+func (c converter) convertPaths(paths map[string]interface{}) (map[string]interface{}, error) {
+	out := map[string]interface{}{}
+	for _, path := range swagger.SortedKeys(paths) {
+		pointer := "#/paths/" + swagger.EscapePointer(path)
+		pathItem := asMap(paths[path])
+		outItem := map[string]interface{}{}
+
+		// Swagger 2.0 allows path-level parameters, but wag doesn't, so they're copied to
+		// each operation.
+		var pathParams []interface{}
+		if params, ok := pathItem["parameters"].([]interface{}); ok {
+			pathParams = params
+		}
+
+		for _, key := range swagger.SortedKeys(pathItem) {
+			value := pathItem[key]
+			switch {
+			case isExtension(key):
+				outItem[key] = value
+			case key == "summary", key == "description", key == "parameters":
+			case operationMethods[key]:
+				op, err := c.convertOperation(pointer+"/"+key, pointer, asMap(value), pathParams)
+				if err != nil {
+					return nil, err
+				}
+				outItem[key] = op
+			default:
+				return nil, fmt.Errorf("%s/%s isn't supported", pointer, key)
+			}
+		}
+		out[path] = outItem
+	}
+	return out, nil
+}
+
+func (c converter) convertOperation(pointer, pathPointer string, op map[string]interface{}, pathParams []interface{}) (map[string]interface{}, error) {
+	out := map[string]interface{}{}
+	params := []interface{}{}
+	// parameters on the operation override parameters with the same name and location on the path
+	overridden := map[string]bool{}
+	opParams, _ := op["parameters"].([]interface{})
+	for i, p := range opParams {
+		param, err := c.convertParameter(fmt.Sprintf("%s/parameters/%d", pointer, i), p)
+		if err != nil {
+			return nil, err
+		}
+		overridden[param["in"].(string)+" "+param["name"].(string)] = true
+		params = append(params, param)
+	}
+	var pathLevelParams []interface{}
+	for i, p := range pathParams {
+		param, err := c.convertParameter(fmt.Sprintf("%s/parameters/%d", pathPointer, i), p)
+		if err != nil {
+			return nil, err
+		}
+		if !overridden[param["in"].(string)+" "+param["name"].(string)] {
+			pathLevelParams = append(pathLevelParams, param)
+		}
+	}
+	params = append(pathLevelParams, params...)
+
+	for _, key := range swagger.SortedKeys(op) {
+		value := op[key]
+		switch {
+		case isExtension(key), key == "tags", key == "summary", key == "description",
+			key == "externalDocs", key == "operationId", key == "deprecated", key == "security":
+			out[key] = value
+		case key == "parameters":
+		case key == "requestBody":
+			bodyParams, consumes, err := c.convertRequestBody(pointer+"/requestBody", op, value)
+			if err != nil {
+				return nil, err
+			}
+			params = append(params, bodyParams...)
+			if consumes != "" {
+				out["consumes"] = []interface{}{consumes}
+			}
+		case key == "responses":
+			responses, err := c.convertResponses(pointer+"/responses", asMap(value))
+			if err != nil {
+				return nil, err
+			}
+			out["responses"] = responses
+		default:
+			return nil, fmt.Errorf("%s/%s isn't supported", pointer, key)
+		}
+	}
+	if len(params) > 0 {
+		out["parameters"] = params
+	}
+	return out, nil
+}
+
+// convertParameter converts a path, query, or header parameter.
+func (c converter) convertParameter(pointer string, value interface{}) (map[string]interface{}, error) {
+	param, pointer, err := c.resolve(pointer, asMap(value), "#/components/parameters/")
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]interface{}{}
+	style, _ := param["style"].(string)
+	explode, hasExplode := param["explode"].(bool)
+	for _, key := range swagger.SortedKeys(param) {
+		value := param[key]
+		switch {
+		case isExtension(key), key == "name", key == "description", key == "required",
+			key == "allowEmptyValue":
+			out[key] = value
+		case key == "in":
+			if value == "cookie" {
+				return nil, fmt.Errorf("%s: cookie parameters aren't supported", pointer)
+			}
+			out[key] = value
+		case key == "schema":
+			schema, err := c.convertSimpleSchema(pointer+"/schema", value)
+			if err != nil {
+				return nil, err
+			}
+			if schema["x-nullable"] == true && param["required"] == true {
+				return nil, fmt.Errorf("%s: required parameters can't be nullable", pointer)
+			}
+			delete(schema, "x-nullable")
+			for k, v := range schema {
+				out[k] = v
+			}
+		case key == "content":
+			return nil, fmt.Errorf("%s: parameters with content aren't supported, use schema instead", pointer)
+		case key == "style", key == "explode", key == "deprecated", key == "example", key == "examples":
+		default:
+			return nil, fmt.Errorf("%s/%s isn't supported", pointer, key)
+		}
+	}
+	if _, ok := out["name"].(string); !ok {
+		return nil, fmt.Errorf("%s must have a name", pointer)
+	}
+	if _, ok := out["in"].(string); !ok {
+		return nil, fmt.Errorf("%s must have an in", pointer)
+	}
+	if _, ok := out["type"]; !ok {
+		return nil, fmt.Errorf("%s must have a schema with a type", pointer)
+	}
+
+	if out["type"] == "array" {
+		if !hasExplode {
+			explode = style == "" || style == "form"
+		}
+		switch {
+		case style == "deepObject":
+			return nil, fmt.Errorf("%s: the deepObject style isn't supported", pointer)
+		case style == "spaceDelimited":
+			out["collectionFormat"] = "ssv"
+		case style == "pipeDelimited":
+			out["collectionFormat"] = "pipes"
+		case (style == "" || style == "form") && out["in"] == "query" && explode:
+			// wag sends query arrays as multiple parameters by default
+		default:
+			out["collectionFormat"] = "csv"
+		}
+	} else if style == "deepObject" || style == "spaceDelimited" || style == "pipeDelimited" {
+		return nil, fmt.Errorf("%s: the %s style is only supported on arrays", pointer, style)
+	}
+	return out, nil
+}
+
+// convertRequestBody converts a requestBody into a body parameter or formData parameters. It also
+// returns the content type for formData parameters.
+func (c converter) convertRequestBody(pointer string, op map[string]interface{}, value interface{}) ([]interface{}, string, error) {
+	body, pointer, err := c.resolve(pointer, asMap(value), "#/components/requestBodies/")
+	if err != nil {
+		return nil, "", err
+	}
+	contentType, mediaType, err := singleMediaType(pointer, asMap(body["content"]))
+	if err != nil {
+		return nil, "", err
+	}
+	if contentType == "" {
+		return nil, "", fmt.Errorf("%s must have content", pointer)
+	}
+	schemaPointer := pointer + "/content/" + swagger.EscapePointer(contentType) + "/schema"
+	required, _ := body["required"].(bool)
+
+	switch contentType {
+	case "application/json":
+		schema, err := c.convertSchema(schemaPointer, mediaType["schema"])
+		if err != nil {
+			return nil, "", err
+		}
+		param := map[string]interface{}{
+			"name":     requestBodyName(op, asMap(mediaType["schema"])),
+			"in":       "body",
+			"required": required,
+			"schema":   schema,
+		}
+		if description, ok := body["description"]; ok {
+			param["description"] = description
+		}
+		return []interface{}{param}, "", nil
+	case "multipart/form-data", "application/x-www-form-urlencoded":
+		schema, schemaPointer, err := c.resolve(schemaPointer, asMap(mediaType["schema"]), "#/components/schemas/")
+		if err != nil {
+			return nil, "", err
+		}
+		properties := asMap(schema["properties"])
+		if schema["type"] != "object" || len(properties) == 0 {
+			return nil, "", fmt.Errorf("%s must be an object with properties", schemaPointer)
+		}
+		requiredProperties := map[string]bool{}
+		if list, ok := schema["required"].([]interface{}); ok {
+			for _, name := range list {
+				requiredProperties[fmt.Sprint(name)] = true
+			}
+		}
+		var params []interface{}
+		for _, name := range swagger.SortedKeys(properties) {
+			propertyPointer := schemaPointer + "/properties/" + swagger.EscapePointer(name)
+			property, propertyPointer, err := c.resolve(propertyPointer, asMap(properties[name]), "#/components/schemas/")
+			if err != nil {
+				return nil, "", err
+			}
+			param := map[string]interface{}{"name": name, "in": "formData"}
+			if property["type"] == "string" && property["format"] == "binary" {
+				param["type"] = "file"
+				if description, ok := property["description"]; ok {
+					param["description"] = description
+				}
+			} else {
+				simple, err := c.convertSimpleSchema(propertyPointer, property)
+				if err != nil {
+					return nil, "", err
+				}
+				delete(simple, "x-nullable")
+				for k, v := range simple {
+					param[k] = v
+				}
+			}
+			if requiredProperties[name] {
+				param["required"] = true
+			}
+			params = append(params, param)
+		}
+		return params, contentType, nil
+	default:
+		return nil, "", fmt.Errorf("%s has the content type %s. wag supports application/json, "+
+			"multipart/form-data, and application/x-www-form-urlencoded request bodies", pointer, contentType)
+	}
+}
+
+// requestBodyName returns the name of the body parameter for a request body: the
+// x-codegen-request-body-name of the operation, the name of the schema it refers to, or "body".
+func requestBodyName(op map[string]interface{}, schema map[string]interface{}) string {
+	if name, ok := op["x-codegen-request-body-name"].(string); ok {
+		return name
+	}
+	if ref, ok := schema["$ref"].(string); ok && strings.HasPrefix(ref, "#/components/schemas/") {
+		name := strings.TrimPrefix(ref, "#/components/schemas/")
+		return strings.ToLower(name[:1]) + name[1:]
+	}
+	return "body"
+}
+
+func (c converter) convertResponses(pointer string, responses map[string]interface{}) (map[string]interface{}, error) {
+	out := map[string]interface{}{}
+	for _, code := range swagger.SortedKeys(responses) {
+		value := responses[code]
+		if isExtension(code) {
+			out[code] = value
+			continue
+		}
+		if strings.HasSuffix(strings.ToUpper(code), "XX") {
+			return nil, fmt.Errorf("%s/%s: status code ranges aren't supported", pointer, code)
+		}
+		response, err := c.convertResponse(pointer+"/"+code, value)
+		if err != nil {
+			return nil, err
+		}
+		out[code] = response
+	}
+	return out, nil
+}
+
+func (c converter) convertResponse(pointer string, value interface{}) (map[string]interface{}, error) {
+	// wag doesn't support response references in operations, so they're inlined
+	response, pointer, err := c.resolve(pointer, asMap(value), "#/components/responses/")
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]interface{}{}
+	for _, key := range swagger.SortedKeys(response) {
+		value := response[key]
+		switch {
+		case isExtension(key), key == "description":
+			out[key] = value
+		case key == "headers":
+			headers := asMap(value)
+			outHeaders := map[string]interface{}{}
+			for _, name := range swagger.SortedKeys(headers) {
+				header, err := c.convertHeader(pointer+"/headers/"+swagger.EscapePointer(name), headers[name])
+				if err != nil {
+					return nil, err
+				}
+				outHeaders[name] = header
+			}
+			out[key] = outHeaders
+		case key == "content":
+			contentType, mediaType, err := singleMediaType(pointer, asMap(value))
+			if err != nil {
+				return nil, err
+			}
+			if contentType == "" {
+				continue
+			}
+			if contentType != "application/json" {
+				return nil, fmt.Errorf("%s has the content type %s. wag only supports application/json "+
+					"responses", pointer, contentType)
+			}
+			if schema, ok := mediaType["schema"]; ok {
+				converted, err := c.convertSchema(pointer+"/content/application~1json/schema", schema)
+				if err != nil {
+					return nil, err
+				}
+				out["schema"] = converted
+			}
+		case key == "links":
+		default:
+			return nil, fmt.Errorf("%s/%s isn't supported", pointer, key)
+		}
+	}
+	return out, nil
+}
+
+func (c converter) convertHeader(pointer string, value interface{}) (map[string]interface{}, error) {
+	header, pointer, err := c.resolve(pointer, asMap(value), "#/components/headers/")
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]interface{}{}
+	for _, key := range swagger.SortedKeys(header) {
+		value := header[key]
+		switch {
+		case isExtension(key), key == "description":
+			out[key] = value
+		case key == "schema":
+			schema, err := c.convertSimpleSchema(pointer+"/schema", value)
+			if err != nil {
+				return nil, err
+			}
+			delete(schema, "x-nullable")
+			for k, v := range schema {
+				out[k] = v
+			}
+		case key == "content":
+			return nil, fmt.Errorf("%s: headers with content aren't supported, use schema instead", pointer)
+		case key == "required", key == "deprecated", key == "style", key == "explode",
+			key == "example", key == "examples":
+		default:
+			return nil, fmt.Errorf("%s/%s isn't supported", pointer, key)
+		}
+	}
+	return out, nil
+}
+
+// singleMediaType returns the only media type of a content map. It returns an empty content type
+// if the map is empty.
+func singleMediaType(pointer string, content map[string]interface{}) (string, map[string]interface{}, error) {
+	contentTypes := swagger.SortedKeys(content)
+	if len(contentTypes) > 1 {
+		return "", nil, fmt.Errorf("%s has more than one content type (%s). wag supports one content "+
+			"type per request or response", pointer, strings.Join(contentTypes, ", "))
+	}
+	if len(contentTypes) == 0 {
+		return "", nil, nil
+	}
+	return contentTypes[0], asMap(content[contentTypes[0]]), nil
+}
+
+func (c converter) convertComponents(components map[string]interface{}, out map[string]interface{}) error {
+	for _, key := range swagger.SortedKeys(components) {
+		value := asMap(components[key])
+		pointer := "#/components/" + key
+		switch key {
+		case "schemas":
+			definitions := map[string]interface{}{}
+			for _, name := range swagger.SortedKeys(value) {
+				schema, err := c.convertSchema(pointer+"/"+swagger.EscapePointer(name), value[name])
+				if err != nil {
+					return err
+				}
+				definitions[name] = schema
+			}
+			out["definitions"] = definitions
+		case "responses":
+			// wag adds the global responses to every operation
+			responses := map[string]interface{}{}
+			for _, name := range swagger.SortedKeys(value) {
+				response, err := c.convertResponse(pointer+"/"+swagger.EscapePointer(name), value[name])
+				if err != nil {
+					return err
+				}
+				responses[name] = response
+			}
+			out["responses"] = responses
+		case "securitySchemes":
+			definitions := map[string]interface{}{}
+			for _, name := range swagger.SortedKeys(value) {
+				definition, err := convertSecurityScheme(pointer+"/"+swagger.EscapePointer(name), asMap(value[name]))
+				if err != nil {
+					return err
+				}
+				definitions[name] = definition
+			}
+			out["securityDefinitions"] = definitions
+		default:
+			// parameters, request bodies, and headers are inlined where they're referenced, and
+			// the other components don't affect the generated code
+		}
+	}
+	return nil
+}
+
+// oauth2Flows maps OpenAPI 3 oauth2 flows to their Swagger 2.0 names, in the order they're
+// preferred when a scheme has more than one.
+var oauth2Flows = []struct{ from, to string }{
+	{"clientCredentials", "application"},
+	{"authorizationCode", "accessCode"},
+	{"password", "password"},
+	{"implicit", "implicit"},
+}
+
+func convertSecurityScheme(pointer string, scheme map[string]interface{}) (map[string]interface{}, error) {
+	out := map[string]interface{}{}
+	if description, ok := scheme["description"]; ok {
+		out["description"] = description
+	}
+	switch scheme["type"] {
+	case "apiKey":
+		if scheme["in"] == "cookie" {
+			return nil, fmt.Errorf("%s: apiKey schemes in cookies aren't supported", pointer)
+		}
+		out["type"] = "apiKey"
+		out["in"] = scheme["in"]
+		out["name"] = scheme["name"]
+	case "http":
+		// Auth scheme names are case-insensitive
+		name, _ := scheme["scheme"].(string)
+		switch {
+		case strings.EqualFold(name, "basic"):
+			out["type"] = "basic"
+		case strings.EqualFold(name, "bearer"):
+			// wag sends oauth2 schemes as bearer tokens, so a bearer scheme is an oauth2 scheme
+			// without scopes
+			out["type"] = "oauth2"
+			out["scopes"] = map[string]interface{}{}
+		default:
+			return nil, fmt.Errorf("%s: the http %v scheme isn't supported. wag supports http basic "+
+				"and bearer schemes", pointer, scheme["scheme"])
+		}
+	case "oauth2":
+		flows := asMap(scheme["flows"])
+		for _, flow := range oauth2Flows {
+			f, ok := flows[flow.from]
+			if !ok {
+				continue
+			}
+			out["type"] = "oauth2"
+			out["flow"] = flow.to
+			for k, v := range asMap(f) {
+				if k == "authorizationUrl" || k == "tokenUrl" || k == "scopes" {
+					out[k] = v
+				}
+			}
+			return out, nil
+		}
+		return nil, fmt.Errorf("%s must have a flow", pointer)
+	default:
+		return nil, fmt.Errorf("%s: %v security schemes aren't supported", pointer, scheme["type"])
+	}
+	return out, nil
+}
+
+// schemaKeywords are the schema keywords that mean the same thing in Swagger 2.0.
+var schemaKeywords = map[string]bool{
+	"title": true, "description": true, "format": true, "default": true, "enum": true,
+	"multipleOf": true, "maxLength": true, "minLength": true,
+	"pattern": true, "maxItems": true, "minItems": true, "uniqueItems": true, "maxProperties": true,
+	"minProperties": true, "required": true, "readOnly": true, "example": true, "externalDocs": true,
+	"xml": true,
+}
+
+// ignoredSchemaKeywords only document a schema, so they're dropped.
+var ignoredSchemaKeywords = map[string]bool{
+	"deprecated": true, "examples": true, "$comment": true,
+}
+
+func (c converter) convertSchema(pointer string, value interface{}) (map[string]interface{}, error) {
+	schema := asMap(value)
+	out := map[string]interface{}{}
+	for _, key := range swagger.SortedKeys(schema) {
+		value := schema[key]
+		switch {
+		case isExtension(key), schemaKeywords[key]:
+			out[key] = value
+		case ignoredSchemaKeywords[key]:
+		case key == "$ref":
+			ref, err := convertRef(pointer, value)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = ref
+		case key == "type":
+			switch t := value.(type) {
+			case string:
+				if t == "null" {
+					return nil, fmt.Errorf("%s: the null type is only supported with another type", pointer)
+				}
+				out[key] = t
+			case []interface{}:
+				// OpenAPI 3.1 uses type: [T, "null"] instead of nullable
+				var types []string
+				for _, typ := range t {
+					if typ == "null" {
+						out["x-nullable"] = true
+					} else {
+						types = append(types, fmt.Sprint(typ))
+					}
+				}
+				if len(types) != 1 {
+					return nil, fmt.Errorf("%s: schemas with more than one type aren't supported", pointer)
+				}
+				out[key] = types[0]
+			}
+		case key == "nullable":
+			if value == true {
+				out["x-nullable"] = true
+			}
+		case key == "const":
+			out["enum"] = []interface{}{value}
+		case key == "minimum", key == "exclusiveMinimum":
+			convertBound(schema, out, "minimum", "exclusiveMinimum", true)
+		case key == "maximum", key == "exclusiveMaximum":
+			convertBound(schema, out, "maximum", "exclusiveMaximum", false)
+		case key == "properties":
+			properties := asMap(value)
+			outProperties := map[string]interface{}{}
+			for _, name := range swagger.SortedKeys(properties) {
+				property, err := c.convertSchema(pointer+"/properties/"+swagger.EscapePointer(name), properties[name])
+				if err != nil {
+					return nil, err
+				}
+				outProperties[name] = property
+			}
+			out[key] = outProperties
+		case key == "items":
+			items, err := c.convertSchema(pointer+"/items", value)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = items
+		case key == "additionalProperties":
+			if b, ok := value.(bool); ok {
+				out[key] = b
+				continue
+			}
+			additional, err := c.convertSchema(pointer+"/additionalProperties", value)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = additional
+		case key == "allOf":
+			list, _ := value.([]interface{})
+			var outList []interface{}
+			for i, s := range list {
+				converted, err := c.convertSchema(fmt.Sprintf("%s/allOf/%d", pointer, i), s)
+				if err != nil {
+					return nil, err
+				}
+				outList = append(outList, converted)
+			}
+			out[key] = outList
+		case key == "discriminator":
+			propertyName, err := convertDiscriminator(pointer, asMap(value))
+			if err != nil {
+				return nil, err
+			}
+			out[key] = propertyName
+		case key == "oneOf", key == "anyOf", key == "not":
+			return nil, fmt.Errorf("%s: %s isn't supported", pointer, key)
+		case key == "writeOnly":
+			return nil, fmt.Errorf("%s: writeOnly isn't supported", pointer)
+		default:
+			return nil, fmt.Errorf("%s: the %s keyword isn't supported", pointer, key)
+		}
+	}
+	return out, nil
+}
+
+// convertBound converts a bound and its exclusive keyword together. In OpenAPI 3.0 the exclusive
+// keyword is a boolean modifier of the bound, as in Swagger 2.0. In OpenAPI 3.1 it's a bound of its
+// own, so when a schema has both the stricter one is kept.
+func convertBound(schema, out map[string]interface{}, boundKey, exclusiveKey string, lower bool) {
+	bound, hasBound := schema[boundKey]
+	exclusive, hasExclusive := schema[exclusiveKey]
+	if b, ok := exclusive.(bool); ok || !hasExclusive {
+		if hasBound {
+			out[boundKey] = bound
+		}
+		if ok {
+			out[exclusiveKey] = b
+		}
+		return
+	}
+
+	boundNum, _ := bound.(float64)
+	exclusiveNum, _ := exclusive.(float64)
+	if hasBound && ((lower && boundNum > exclusiveNum) || (!lower && boundNum < exclusiveNum)) {
+		out[boundKey] = bound
+		delete(out, exclusiveKey)
+		return
+	}
+	out[boundKey] = exclusive
+	out[exclusiveKey] = true
+}
+
+// convertDiscriminator returns the Swagger 2.0 discriminator, which is just the property name.
+// Swagger 2.0 discriminator values are schema names, so mappings that use other values aren't
+// supported.
+func convertDiscriminator(pointer string, discriminator map[string]interface{}) (string, error) {
+	mapping := asMap(discriminator["mapping"])
+	for _, value := range swagger.SortedKeys(mapping) {
+		if mapping[value] != "#/components/schemas/"+value {
+			return "", fmt.Errorf("%s/discriminator: mapping %s to %v isn't supported. Discriminator "+
+				"values must be the names of the schemas", pointer, value, mapping[value])
+		}
+	}
+	propertyName, _ := discriminator["propertyName"].(string)
+	return propertyName, nil
+}
+
+// convertSimpleSchema converts the schema of a parameter or header, which Swagger 2.0 inlines
+// and restricts to primitives and arrays of primitives.
+func (c converter) convertSimpleSchema(pointer string, value interface{}) (map[string]interface{}, error) {
+	schema, pointer, err := c.resolve(pointer, asMap(value), "#/components/schemas/")
+	if err != nil {
+		return nil, err
+	}
+	if items, ok := schema["items"]; ok {
+		resolved, itemsPointer, err := c.resolve(pointer+"/items", asMap(items), "#/components/schemas/")
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := resolved["items"]; ok {
+			return nil, fmt.Errorf("%s: nested arrays aren't supported", itemsPointer)
+		}
+		copied := map[string]interface{}{}
+		for k, v := range schema {
+			copied[k] = v
+		}
+		copied["items"] = resolved
+		schema = copied
+	}
+	out, err := c.convertSchema(pointer, schema)
+	if err != nil {
+		return nil, err
+	}
+	if out["type"] == "object" || out["properties"] != nil || out["allOf"] != nil {
+		return nil, fmt.Errorf("%s: objects aren't supported here", pointer)
+	}
+	return out, nil
+}
+
+// resolve returns the object a $ref refers to, which must be under prefix, along with its
+// location. Objects without a $ref are returned as is.
+func (c converter) resolve(pointer string, object map[string]interface{}, prefix string) (map[string]interface{}, string, error) {
+	ref, ok := object["$ref"].(string)
+	if !ok {
+		return object, pointer, nil
+	}
+	if !strings.HasPrefix(ref, prefix) {
+		return nil, "", fmt.Errorf("%s: $ref %s must refer to %s", pointer, ref, strings.TrimSuffix(prefix, "/"))
+	}
+	section := strings.Split(strings.TrimPrefix(prefix, "#/"), "/")
+	current := c.doc
+	for _, key := range section {
+		if key == "" {
+			continue
+		}
+		current = asMap(current[key])
+	}
+	resolved, ok := current[swagger.UnescapePointer(strings.TrimPrefix(ref, prefix))]
+	if !ok {
+		return nil, "", fmt.Errorf("%s: $ref %s isn't defined", pointer, ref)
+	}
+	return c.resolve(ref, asMap(resolved), prefix)
+}
+
+// convertRef converts a $ref to a schema into a $ref to a definition. References to other files
+// keep the file but have their fragment converted.
+func convertRef(pointer string, value interface{}) (string, error) {
+	ref, _ := value.(string)
+	file, fragment := "", ref
+	if i := strings.Index(ref, "#"); i >= 0 {
+		file, fragment = ref[:i], ref[i:]
+	}
+	if !strings.HasPrefix(fragment, "#/components/schemas/") {
+		return "", fmt.Errorf("%s: $ref %s must refer to #/components/schemas", pointer, ref)
+	}
+	return file + "#/definitions/" + strings.TrimPrefix(fragment, "#/components/schemas/"), nil
+}
+
+func isExtension(key string) bool {
+	return strings.HasPrefix(key, "x-")
+}
+
+func asMap(value interface{}) map[string]interface{} {
+	m, _ := value.(map[string]interface{})
+	return m
+}
@@ -0,0 +1,316 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/loads/fmts"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	expected := loadTestFile(t, "testyml/petstore.swagger.yml")
+	for _, file := range []string{"testyml/petstore30.yml", "testyml/petstore31.yml"} {
+		doc := loadTestFile(t, file)
+		require.True(t, IsOpenAPI3(doc))
+		converted, err := Convert(doc)
+		require.NoError(t, err, file)
+		assert.JSONEq(t, string(expected), string(converted), file)
+	}
+}
+
+func TestIsOpenAPI3(t *testing.T) {
+	assert.False(t, IsOpenAPI3(loadTestFile(t, "testyml/petstore.swagger.yml")))
+	assert.False(t, IsOpenAPI3(json.RawMessage(`not json`)))
+}
+
+func TestConvertErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		err  string
+	}{
+		{
+			name: "version",
+			doc:  `openapi: 2.0.0`,
+			err:  "unsupported OpenAPI version 2.0.0. wag supports 3.0 and 3.1",
+		},
+		{
+			name: "webhooks",
+			doc: `
+openapi: 3.1.0
+webhooks: {}`,
+			err: "#/webhooks isn't supported",
+		},
+		{
+			name: "servers with different paths",
+			doc: `
+openapi: 3.0.0
+servers:
+  - url: http://localhost/v1
+  - url: http://localhost/v2`,
+			err: "#/servers/1 has the path /v2, but #/servers/0 has the path /v1. wag requires every " +
+				"server to have the same path",
+		},
+		{
+			name: "oneOf",
+			doc: `
+openapi: 3.0.0
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        owner:
+          oneOf:
+            - type: string
+            - type: integer`,
+			err: "#/components/schemas/Pet/properties/owner: oneOf isn't supported",
+		},
+		{
+			name: "multiple types",
+			doc: `
+openapi: 3.1.0
+components:
+  schemas:
+    Pet:
+      type: [string, integer]`,
+			err: "#/components/schemas/Pet: schemas with more than one type aren't supported",
+		},
+		{
+			name: "discriminator mapping",
+			doc: `
+openapi: 3.0.0
+components:
+  schemas:
+    Pet:
+      type: object
+      discriminator:
+        propertyName: kind
+        mapping:
+          dog: "#/components/schemas/Dog"`,
+			err: "#/components/schemas/Pet/discriminator: mapping dog to #/components/schemas/Dog isn't " +
+				"supported. Discriminator values must be the names of the schemas",
+		},
+		{
+			name: "cookie parameter",
+			doc: `
+openapi: 3.0.0
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: session
+          in: cookie
+          schema:
+            type: string`,
+			err: "#/paths/~1pets/get/parameters/0: cookie parameters aren't supported",
+		},
+		{
+			name: "required nullable parameter",
+			doc: `
+openapi: 3.0.0
+paths:
+  /pets/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+            nullable: true`,
+			err: "#/paths/~1pets~1{id}/get/parameters/0: required parameters can't be nullable",
+		},
+		{
+			name: "object parameter",
+			doc: `
+openapi: 3.0.0
+paths:
+  /pets:
+    get:
+      parameters:
+        - $ref: "#/components/parameters/Filter"
+components:
+  parameters:
+    Filter:
+      name: filter
+      in: query
+      style: deepObject
+      schema:
+        type: object`,
+			err: "#/components/parameters/Filter/schema: objects aren't supported here",
+		},
+		{
+			name: "request body with several content types",
+			doc: `
+openapi: 3.0.0
+paths:
+  /pets:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+          application/xml:
+            schema:
+              type: object`,
+			err: "#/paths/~1pets/post/requestBody has more than one content type (application/json, " +
+				"application/xml). wag supports one content type per request or response",
+		},
+		{
+			name: "request body content type",
+			doc: `
+openapi: 3.0.0
+paths:
+  /pets:
+    post:
+      requestBody:
+        content:
+          text/plain:
+            schema:
+              type: string`,
+			err: "#/paths/~1pets/post/requestBody has the content type text/plain. wag supports " +
+				"application/json, multipart/form-data, and application/x-www-form-urlencoded request bodies",
+		},
+		{
+			name: "response content type",
+			doc: `
+openapi: 3.0.0
+paths:
+  /pets:
+    get:
+      responses:
+        200:
+          description: the pets
+          content:
+            text/csv:
+              schema:
+                type: string`,
+			err: "#/paths/~1pets/get/responses/200 has the content type text/csv. wag only supports " +
+				"application/json responses",
+		},
+		{
+			name: "http scheme",
+			doc: `
+openapi: 3.0.0
+components:
+  securitySchemes:
+    token:
+      type: http
+      scheme: digest`,
+			err: "#/components/securitySchemes/token: the http digest scheme isn't supported. wag supports " +
+				"http basic and bearer schemes",
+		},
+		{
+			name: "callbacks",
+			doc: `
+openapi: 3.0.0
+paths:
+  /pets:
+    post:
+      callbacks: {}`,
+			err: "#/paths/~1pets/post/callbacks isn't supported",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			yamlDoc, err := swag.BytesToYAMLDoc([]byte(test.doc))
+			require.NoError(t, err)
+			doc, err := swag.YAMLToJSON(yamlDoc)
+			require.NoError(t, err)
+			_, err = Convert(doc)
+			require.Error(t, err)
+			assert.Equal(t, test.err, err.Error())
+		})
+	}
+}
+
+func TestConvertHTTPSecuritySchemes(t *testing.T) {
+	tests := []struct {
+		name     string
+		scheme   string
+		expected string
+	}{
+		{
+			name:     "basic",
+			scheme:   `{"type": "http", "scheme": "Basic", "description": "username and password"}`,
+			expected: `{"type": "basic", "description": "username and password"}`,
+		},
+		{
+			name:     "bearer",
+			scheme:   `{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"}`,
+			expected: `{"type": "oauth2", "scopes": {}}`,
+		},
+		{
+			name:     "bearer in capitals",
+			scheme:   `{"type": "http", "scheme": "Bearer"}`,
+			expected: `{"type": "oauth2", "scopes": {}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var scheme map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(test.scheme), &scheme))
+			converted, err := convertSecurityScheme("#/components/securitySchemes/token", scheme)
+			require.NoError(t, err)
+			out, err := json.Marshal(converted)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(out))
+		})
+	}
+}
+
+func TestConvertSchemaBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		expected string
+	}{
+		{
+			name:     "3.0 exclusive bounds",
+			schema:   `{"minimum": 0, "exclusiveMinimum": true, "maximum": 10, "exclusiveMaximum": false}`,
+			expected: `{"minimum": 0, "exclusiveMinimum": true, "maximum": 10, "exclusiveMaximum": false}`,
+		},
+		{
+			name:     "3.1 exclusive bounds",
+			schema:   `{"exclusiveMinimum": 0, "exclusiveMaximum": 10}`,
+			expected: `{"minimum": 0, "exclusiveMinimum": true, "maximum": 10, "exclusiveMaximum": true}`,
+		},
+		{
+			name:     "3.1 exclusive bounds stricter than the bounds",
+			schema:   `{"minimum": 0, "exclusiveMinimum": 5, "maximum": 20, "exclusiveMaximum": 10}`,
+			expected: `{"minimum": 5, "exclusiveMinimum": true, "maximum": 10, "exclusiveMaximum": true}`,
+		},
+		{
+			name:     "3.1 bounds stricter than the exclusive bounds",
+			schema:   `{"minimum": 5, "exclusiveMinimum": 0, "maximum": 10, "exclusiveMaximum": 20}`,
+			expected: `{"minimum": 5, "maximum": 10}`,
+		},
+		{
+			name:     "3.1 equal bounds",
+			schema:   `{"minimum": 5, "exclusiveMinimum": 5}`,
+			expected: `{"minimum": 5, "exclusiveMinimum": true}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var schema interface{}
+			require.NoError(t, json.Unmarshal([]byte(test.schema), &schema))
+			converted, err := converter{}.convertSchema("#/components/schemas/Count", schema)
+			require.NoError(t, err)
+			out, err := json.Marshal(converted)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(out))
+		})
+	}
+}
+
+func loadTestFile(t *testing.T, path string) json.RawMessage {
+	doc, err := fmts.YAMLDoc(path)
+	require.NoError(t, err)
+	return doc
+}
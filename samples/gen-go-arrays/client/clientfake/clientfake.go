@@ -0,0 +1,88 @@
+// Package clientfake has an in-memory implementation of the arrays-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-arrays/client/v9"
+	"github.com/Clever/wag/samples/gen-go-arrays/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	getBooksStub  func(ctx context.Context, i *models.GetBooksInput) (*models.BookQuery, error)
+	getBooksQueue []getBooksResult
+	getBooksCalls []GetBooksCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// GetBooksCall records a call to GetBooks.
+type GetBooksCall struct {
+	Ctx   context.Context
+	Input *models.GetBooksInput
+}
+
+type getBooksResult struct {
+	resp *models.BookQuery
+	err  error
+}
+
+// StubGetBooks sets the function that answers calls to GetBooks once its queued responses
+// are used up.
+func (f *Fake) StubGetBooks(stub func(ctx context.Context, i *models.GetBooksInput) (*models.BookQuery, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBooksStub = stub
+}
+
+// QueueGetBooks adds a response for a call to GetBooks.
+func (f *Fake) QueueGetBooks(resp *models.BookQuery, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBooksQueue = append(f.getBooksQueue, getBooksResult{resp: resp, err: err})
+}
+
+// GetBooksCalls returns the calls made to GetBooks.
+func (f *Fake) GetBooksCalls() []GetBooksCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetBooksCall{}, f.getBooksCalls...)
+}
+
+// GetBooks returns the next queued response or calls the stub.
+func (f *Fake) GetBooks(ctx context.Context, i *models.GetBooksInput) (*models.BookQuery, error) {
+	f.mu.Lock()
+	f.getBooksCalls = append(f.getBooksCalls, GetBooksCall{Ctx: ctx, Input: i})
+	if len(f.getBooksQueue) > 0 {
+		result := f.getBooksQueue[0]
+		f.getBooksQueue = f.getBooksQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getBooksStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.BookQuery
+	return resp, notStubbed("GetBooks")
+}
@@ -0,0 +1,600 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	wcl "github.com/Clever/wag/logging/wagclientlogger"
+)
+
+// Doer is an interface for "doing" http requests possibly with wrapping
+type Doer interface {
+	Do(c *http.Client, r *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to use a function as a Doer.
+type DoerFunc func(c *http.Client, r *http.Request) (*http.Response, error)
+
+// Do calls f(c, r).
+func (f DoerFunc) Do(c *http.Client, r *http.Request) (*http.Response, error) {
+	return f(c, r)
+}
+
+// Middleware wraps the Doer that makes the requests of a client's operations, e.g. to sign requests
+// or record metrics. OperationFromContext returns the operation a request is made for.
+type Middleware func(next Doer) Doer
+
+type opNameCtx struct{}
+
+// OperationName returns the name of the operation, e.g. "getBookByID", that a request with the
+// given context is made for, or "" if it isn't made by a client's operation.
+func OperationName(ctx context.Context) string {
+	name, _ := ctx.Value(opNameCtx{}).(string)
+	return name
+}
+
+type operationCtx struct{}
+
+// Operation is a call to one of a client's operations.
+type Operation struct {
+	// Name is the name of the operation, e.g. "getBookByID".
+	Name string
+	// Input is the input the operation was called with, e.g. a *models.GetBookByIDInput, or nil if
+	// the operation doesn't have any.
+	Input interface{}
+
+	mu       sync.Mutex
+	onResult []func(output interface{}, err error)
+}
+
+// OperationFromContext returns the operation that a request with the given context is made for, or
+// nil if it isn't made by a client's operation.
+func OperationFromContext(ctx context.Context) *Operation {
+	operation, _ := ctx.Value(operationCtx{}).(*Operation)
+	return operation
+}
+
+// OnResult adds a function that's called with what the operation returns once it has decoded the
+// response: its output, or nil if it doesn't have one or it fails, and its error. For operations
+// with paging it's called for each page.
+func (o *Operation) OnResult(f func(output interface{}, err error)) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.onResult = append(o.onResult, f)
+}
+
+func (o *Operation) finish(output interface{}, err error) {
+	if err != nil {
+		output = nil
+	}
+	o.mu.Lock()
+	onResult := o.onResult
+	o.mu.Unlock()
+	for _, f := range onResult {
+		f(output, err)
+	}
+}
+
+// baseRequestHandler performs the base http request
+type baseDoer struct{}
+
+func (d baseDoer) Do(c *http.Client, r *http.Request) (*http.Response, error) {
+	return c.Do(r)
+}
+
+// retryHandler retries 50X http requests
+type retryDoer struct {
+	d           Doer
+	retryPolicy RetryPolicy
+}
+
+// DefaultMaxRetryAfter is the longest a retry waits for when a response's Retry-After header asks
+// it to wait longer than its backoff. Policies can set their own maximum with a
+// `MaxRetryAfter() time.Duration` method.
+const DefaultMaxRetryAfter = 30 * time.Second
+
+// RetryPolicy defines a retry policy.
+type RetryPolicy interface {
+	// Backoffs returns the number and timing of retry attempts.
+	Backoffs() []time.Duration
+	// Retry receives the http request, as well as the result of
+	// net/http.Client's `Do` method.
+	Retry(*http.Request, *http.Response, error) bool
+}
+
+// SingleRetryPolicy defines a retry that retries a request once
+type SingleRetryPolicy struct{}
+
+// Backoffs returns that you should retry the request 1second after it fails.
+func (SingleRetryPolicy) Backoffs() []time.Duration {
+	return []time.Duration{1 * time.Second}
+}
+
+// Retry will retry requests that 429 with a Retry-After header, and non-POST, non-PATCH requests
+// that 429, 5XX or fail with a connection reset or timeout.
+func (SingleRetryPolicy) Retry(req *http.Request, resp *http.Response, err error) bool {
+	return defaultRetry(req, resp, err)
+}
+
+// ExponentialRetryPolicy defines an exponential retry policy
+type ExponentialRetryPolicy struct{}
+
+// Backoffs returns five backoffs with exponentially increasing wait times
+// between requests: 100, 200, 400, 800, and 1600 milliseconds +/- up to 5% jitter.
+func (ExponentialRetryPolicy) Backoffs() []time.Duration {
+	ret := make([]time.Duration, 5)
+	next := 100 * time.Millisecond
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	e := 0.05 // +/- 5 percent jitter
+	for i := range ret {
+		ret[i] = next + time.Duration(((rnd.Float64()*2)-1)*e*float64(next))
+		next *= 2
+	}
+	return ret
+}
+
+// Retry will retry requests that 429 with a Retry-After header, and non-POST, non-PATCH requests
+// that 429, 5XX or fail with a connection reset or timeout.
+func (ExponentialRetryPolicy) Retry(req *http.Request, resp *http.Response, err error) bool {
+	return defaultRetry(req, resp, err)
+}
+
+// defaultRetry is the Retry of the built-in retry policies. A 429 doesn't always mean the request
+// wasn't processed, e.g. a proxy can send one after the server has, so POSTs and PATCHes are only
+// retried after a 429 when it has a Retry-After header saying when to try again.
+func defaultRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Method == "POST" || req.Method == "PATCH" {
+		_, ok := retryAfter(resp, time.Now())
+		return err == nil && resp.StatusCode == http.StatusTooManyRequests && ok
+	}
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if err != nil {
+		return retryableError(req, err)
+	}
+	return resp.StatusCode >= 500
+}
+
+// retryableError reports whether an error returned by net/http.Client's `Do` is a connection reset
+// or timeout. Errors from the request's context being done aren't retryable.
+func retryableError(req *http.Request, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// NoRetryPolicy defines a policy of never retrying a request.
+type NoRetryPolicy struct{}
+
+// Backoffs returns an empty slice.
+func (NoRetryPolicy) Backoffs() []time.Duration {
+	return []time.Duration{}
+}
+
+// Retry always returns false.
+func (NoRetryPolicy) Retry(*http.Request, *http.Response, error) bool {
+	return false
+}
+
+type retryContext struct{}
+
+// WithRetryPolicy returns a new context that overrides the client object's
+// retry policy.
+func WithRetryPolicy(ctx context.Context, retryPolicy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryContext{}, retryPolicy)
+}
+
+func (d *retryDoer) Do(c *http.Client, r *http.Request) (*http.Response, error) {
+	retryPolicy, ok := r.Context().Value(retryContext{}).(RetryPolicy)
+	if !ok {
+		retryPolicy = d.retryPolicy
+	}
+	backoffs := retryPolicy.Backoffs()
+	var resp *http.Response
+	var err error
+
+	// Save the request body in case we have to retry. Otherwise we will have already read
+	// the buffer on retry and the request will fail. See
+	// http://stackoverflow.com/questions/23070876/reading-body-of-http-request-without-modifying-request-state
+	var buf []byte
+	if r.Body != nil {
+		var err error
+		buf, err = ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for retries := 0; true; retries++ {
+		if r.Body != nil {
+			rdr := ioutil.NopCloser(bytes.NewBuffer(buf))
+			r.Body = rdr
+		}
+		resp, err = d.d.Do(c, r)
+		if retries == len(backoffs) || !retryPolicy.Retry(r, resp, err) {
+			break
+		}
+		backoff := backoffs[retries]
+		if wait, ok := retryAfter(resp, time.Now()); ok && wait > backoff {
+			// Return the response rather than wait longer than the policy allows
+			if wait > maxRetryAfter(retryPolicy) {
+				break
+			}
+			backoff = wait
+		}
+		// Don't retry if the context's deadline would pass before the retry is made
+		if deadline, ok := r.Context().Deadline(); ok && time.Now().Add(backoff).After(deadline) {
+			break
+		}
+		// Close the response body if response is not nil
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err := sleep(r.Context(), backoff); err != nil {
+			return nil, err
+		}
+	}
+	return resp, err
+}
+
+// maxRetryAfter returns the longest a retry policy waits for a Retry-After header.
+func maxRetryAfter(retryPolicy RetryPolicy) time.Duration {
+	if p, ok := retryPolicy.(interface{ MaxRetryAfter() time.Duration }); ok {
+		return p.MaxRetryAfter()
+	}
+	return DefaultMaxRetryAfter
+}
+
+// retryAfter returns how long the Retry-After header of a response says to wait, if it has one. It
+// can be a number of seconds or an HTTP date.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sleep waits for d, or returns the context's error if it's done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets requests through and counts their failures.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails requests with ErrCircuitOpen without making them.
+	CircuitOpen
+	// CircuitHalfOpen lets a few probe requests through to decide whether to close the circuit
+	// again.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// ErrCircuitOpen is returned for requests that aren't made because their circuit breaker is open.
+type ErrCircuitOpen struct {
+	// Circuit is the name of the circuit: the operation's name if it has its own circuit breaker
+	// options, and the service's name otherwise.
+	Circuit string
+}
+
+func (e ErrCircuitOpen) Error() string {
+	return fmt.Sprintf("circuit breaker '%s' is open", e.Circuit)
+}
+
+// CircuitBreakerOptions configures a circuit breaker. Zero fields use the defaults.
+type CircuitBreakerOptions struct {
+	// ForceClosed turns the circuit breaker off.
+	ForceClosed bool
+	// ErrorPercentThreshold is the percentage of failed requests in the window at which the
+	// circuit opens. Requests fail if they return an error or a 5XX response. Default: 50.
+	ErrorPercentThreshold int
+	// RequestVolumeThreshold is the minimum number of requests in the window before the circuit can
+	// open. Default: 20.
+	RequestVolumeThreshold int
+	// Window is how far back the circuit breaker counts requests. Default: 10 seconds.
+	Window time.Duration
+	// SleepWindow is how long the circuit stays open before it lets probe requests through.
+	// Default: 5 seconds.
+	SleepWindow time.Duration
+	// HalfOpenRequests is the number of probe requests that must succeed to close the circuit. The
+	// circuit opens again as soon as one of them fails. Default: 1.
+	HalfOpenRequests int
+	// OnStateChange, if set, is called when the circuit changes state, in addition to the change
+	// being logged with the client's logger.
+	OnStateChange func(circuit string, from, to CircuitState)
+}
+
+// DefaultCircuitBreakerOptions are the circuit breaker options that zero fields default to.
+var DefaultCircuitBreakerOptions = CircuitBreakerOptions{
+	ErrorPercentThreshold:  50,
+	RequestVolumeThreshold: 20,
+	Window:                 10 * time.Second,
+	SleepWindow:            5 * time.Second,
+	HalfOpenRequests:       1,
+}
+
+func (o CircuitBreakerOptions) withDefaults() CircuitBreakerOptions {
+	if o.ErrorPercentThreshold <= 0 {
+		o.ErrorPercentThreshold = DefaultCircuitBreakerOptions.ErrorPercentThreshold
+	}
+	if o.RequestVolumeThreshold <= 0 {
+		o.RequestVolumeThreshold = DefaultCircuitBreakerOptions.RequestVolumeThreshold
+	}
+	if o.Window <= 0 {
+		o.Window = DefaultCircuitBreakerOptions.Window
+	}
+	if o.SleepWindow <= 0 {
+		o.SleepWindow = DefaultCircuitBreakerOptions.SleepWindow
+	}
+	if o.HalfOpenRequests <= 0 {
+		o.HalfOpenRequests = DefaultCircuitBreakerOptions.HalfOpenRequests
+	}
+	return o
+}
+
+// circuitBuckets is the number of buckets the window of a circuit is split into.
+const circuitBuckets = 10
+
+type circuitBucket struct {
+	start    int64
+	requests int
+	failures int
+}
+
+// circuit is the state of one circuit breaker.
+type circuit struct {
+	name    string
+	options CircuitBreakerOptions
+
+	mu       sync.Mutex
+	state    CircuitState
+	openedAt time.Time
+	// probes is the number of probe requests let through since the circuit became half-open, and
+	// successes the number of them that succeeded.
+	probes    int
+	successes int
+	buckets   [circuitBuckets]circuitBucket
+}
+
+func newCircuit(name string, options CircuitBreakerOptions) *circuit {
+	return &circuit{name: name, options: options.withDefaults()}
+}
+
+// allow reports whether a request can be made, the state the circuit was in when it was allowed,
+// which is passed to record, and the change in the state of the circuit, if any.
+func (c *circuit) allow(now time.Time) (bool, CircuitState, *circuitChange) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var change *circuitChange
+	if c.state == CircuitOpen {
+		if now.Sub(c.openedAt) < c.options.SleepWindow {
+			return false, c.state, nil
+		}
+		change = c.setState(CircuitHalfOpen, now)
+	}
+	if c.state == CircuitHalfOpen {
+		if c.probes >= c.options.HalfOpenRequests {
+			return false, c.state, change
+		}
+		c.probes++
+	}
+	return true, c.state, change
+}
+
+// record records the result of a request that was allowed in the given state. Requests that finish
+// after the circuit has changed state are ignored.
+func (c *circuit) record(now time.Time, allowedIn CircuitState, failed bool) *circuitChange {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if allowedIn != c.state {
+		return nil
+	}
+	switch c.state {
+	case CircuitHalfOpen:
+		if failed {
+			return c.setState(CircuitOpen, now)
+		}
+		c.successes++
+		if c.successes >= c.options.HalfOpenRequests {
+			return c.setState(CircuitClosed, now)
+		}
+	case CircuitClosed:
+		size := int64(c.options.Window) / circuitBuckets
+		if size <= 0 {
+			size = 1
+		}
+		start := now.UnixNano() / size
+		bucket := &c.buckets[start%circuitBuckets]
+		if bucket.start != start {
+			*bucket = circuitBucket{start: start}
+		}
+		bucket.requests++
+		if failed {
+			bucket.failures++
+		}
+
+		requests, failures := 0, 0
+		for _, b := range c.buckets {
+			if start-b.start < circuitBuckets {
+				requests += b.requests
+				failures += b.failures
+			}
+		}
+		if requests >= c.options.RequestVolumeThreshold &&
+			failures*100 >= c.options.ErrorPercentThreshold*requests {
+			return c.setState(CircuitOpen, now)
+		}
+	}
+	return nil
+}
+
+// release gives back the probe slot taken by a request that was allowed in the given state without
+// recording a result for it.
+func (c *circuit) release(allowedIn CircuitState) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if allowedIn == CircuitHalfOpen && c.state == CircuitHalfOpen && c.probes > 0 {
+		c.probes--
+	}
+}
+
+// circuitChange is a change in the state of a circuit.
+type circuitChange struct {
+	from, to CircuitState
+}
+
+// setState changes the state of the circuit.
+func (c *circuit) setState(state CircuitState, now time.Time) *circuitChange {
+	from := c.state
+	c.state = state
+	c.probes = 0
+	c.successes = 0
+	switch state {
+	case CircuitOpen:
+		c.openedAt = now
+	case CircuitClosed:
+		c.buckets = [circuitBuckets]circuitBucket{}
+	}
+	return &circuitChange{from: from, to: state}
+}
+
+// circuitBreakerDoer fails requests without making them while their circuit is open. Operations
+// share the service's circuit unless they have their own options.
+type circuitBreakerDoer struct {
+	d       Doer
+	service string
+	logger  wcl.WagClientLogger
+
+	mu         sync.Mutex
+	circuit    *circuit
+	operations map[string]*circuit
+}
+
+// setOptions sets the options of the service's circuit, or of an operation's own circuit if
+// operation isn't "". It resets the state of the circuit.
+func (d *circuitBreakerDoer) setOptions(operation string, options CircuitBreakerOptions) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if operation == "" {
+		d.circuit = newCircuit(d.service, options)
+		return
+	}
+	if d.operations == nil {
+		d.operations = map[string]*circuit{}
+	}
+	d.operations[operation] = newCircuit(operation, options)
+}
+
+func (d *circuitBreakerDoer) circuitFor(operation string) *circuit {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if c, ok := d.operations[operation]; ok {
+		return c
+	}
+	return d.circuit
+}
+
+func (d *circuitBreakerDoer) Do(c *http.Client, r *http.Request) (*http.Response, error) {
+	operation, _ := r.Context().Value(opNameCtx{}).(string)
+	circuit := d.circuitFor(operation)
+	if circuit == nil || circuit.options.ForceClosed {
+		return d.d.Do(c, r)
+	}
+
+	allowed, state, change := circuit.allow(time.Now())
+	d.stateChanged(circuit, change)
+	if !allowed {
+		return nil, ErrCircuitOpen{Circuit: circuit.name}
+	}
+	resp, err := d.d.Do(c, r)
+	// Requests the caller canceled say nothing about the health of the service
+	if errors.Is(err, context.Canceled) {
+		circuit.release(state)
+		return resp, err
+	}
+	failed := err != nil || resp.StatusCode >= 500
+	d.stateChanged(circuit, circuit.record(time.Now(), state, failed))
+	return resp, err
+}
+
+func (d *circuitBreakerDoer) stateChanged(circuit *circuit, change *circuitChange) {
+	if change == nil {
+		return
+	}
+	level := wcl.Info
+	if change.to == CircuitOpen {
+		level = wcl.Error
+	}
+	d.mu.Lock()
+	logger := d.logger
+	d.mu.Unlock()
+	if logger != nil {
+		logger.Log(level, "client-circuit-state-changed", map[string]interface{}{
+			"backend": d.service,
+			"circuit": circuit.name,
+			"from":    change.from.String(),
+			"to":      change.to.String(),
+		})
+	}
+	if circuit.options.OnStateChange != nil {
+		circuit.options.OnStateChange(circuit.name, change.from, change.to)
+	}
+}
+
+func (d *circuitBreakerDoer) setLogger(l wcl.WagClientLogger) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.logger = l
+}
@@ -0,0 +1,21 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-arrays/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_client.go -package client --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-arrays/models/v9
+
+// Client defines the methods available to clients of the arrays-test service.
+type Client interface {
+
+	// GetBooks makes a GET request to /books
+	//
+	// 200: *models.BookQuery
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetBooks(ctx context.Context, i *models.GetBooksInput) (*models.BookQuery, error)
+}
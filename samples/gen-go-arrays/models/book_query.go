@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BookQuery book query
+//
+// swagger:model BookQuery
+type BookQuery struct {
+
+	// authors
+	Authors []string `json:"authors"`
+
+	// flags
+	Flags []bool `json:"flags"`
+
+	// ids
+	Ids []int64 `json:"ids"`
+
+	// ratings
+	Ratings []float32 `json:"ratings"`
+
+	// tags
+	Tags []string `json:"tags"`
+
+	// years
+	Years []int32 `json:"years"`
+}
+
+// Validate validates this book query
+func (m *BookQuery) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BookQuery) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BookQuery) UnmarshalBinary(b []byte) error {
+	var res BookQuery
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
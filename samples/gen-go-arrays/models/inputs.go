@@ -0,0 +1,82 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// These imports may not be used depending on the input parameters
+var _ = json.Marshal
+var _ = fmt.Sprintf
+var _ = url.QueryEscape
+var _ = strconv.FormatInt
+var _ = strings.Replace
+var _ = validate.Maximum
+var _ = strfmt.NewFormats
+
+// GetBooksInput holds the input parameters for a getBooks operation.
+type GetBooksInput struct {
+	Ids     []int64
+	Authors []string
+	Ratings []float32
+	Flags   []bool
+	Years   []int32
+	Tags    []string
+}
+
+// Validate returns an error if any of the GetBooksInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetBooksInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetBooksInput) Path() (string, error) {
+	path := "/v1/books"
+	urlVals := url.Values{}
+
+	if len(i.Ids) > 0 {
+		var strs []string
+		for _, v := range i.Ids {
+			strs = append(strs, strconv.FormatInt(v, 10))
+		}
+		urlVals.Add("ids", strings.Join(strs, ","))
+	}
+
+	if len(i.Authors) > 0 {
+		urlVals.Add("authors", strings.Join(i.Authors, "|"))
+	}
+
+	if len(i.Ratings) > 0 {
+		var strs []string
+		for _, v := range i.Ratings {
+			strs = append(strs, strconv.FormatFloat(float64(v), 'E', -1, 32))
+		}
+		urlVals.Add("ratings", strings.Join(strs, " "))
+	}
+
+	if len(i.Flags) > 0 {
+		var strs []string
+		for _, v := range i.Flags {
+			strs = append(strs, strconv.FormatBool(v))
+		}
+		urlVals.Add("flags", strings.Join(strs, "\t"))
+	}
+
+	for _, v := range i.Years {
+		urlVals.Add("years", strconv.FormatInt(int64(v), 10))
+	}
+
+	for _, v := range i.Tags {
+		urlVals.Add("tags", v)
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
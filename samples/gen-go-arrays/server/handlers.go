@@ -0,0 +1,212 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/Clever/kayvee-go/v7/logger"
+	"github.com/Clever/wag/samples/gen-go-arrays/models/v9"
+	"github.com/go-errors/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"golang.org/x/xerrors"
+)
+
+var _ = strconv.ParseInt
+var _ = strfmt.Default
+var _ = swag.ConvertInt32
+var _ = errors.New
+var _ = mux.Vars
+var _ = bytes.Compare
+var _ = ioutil.ReadAll
+
+var formats = strfmt.Default
+var _ = formats
+
+// convertBase64 takes in a string and returns a strfmt.Base64 if the input
+// is valid base64 and an error otherwise.
+func convertBase64(input string) (strfmt.Base64, error) {
+	temp, err := formats.Parse("byte", input)
+	if err != nil {
+		return strfmt.Base64{}, err
+	}
+	return *temp.(*strfmt.Base64), nil
+}
+
+// convertDateTime takes in a string and returns a strfmt.DateTime if the input
+// is a valid DateTime and an error otherwise.
+func convertDateTime(input string) (strfmt.DateTime, error) {
+	temp, err := formats.Parse("date-time", input)
+	if err != nil {
+		return strfmt.DateTime{}, err
+	}
+	return *temp.(*strfmt.DateTime), nil
+}
+
+// convertDate takes in a string and returns a strfmt.Date if the input
+// is a valid Date and an error otherwise.
+func convertDate(input string) (strfmt.Date, error) {
+	temp, err := formats.Parse("date", input)
+	if err != nil {
+		return strfmt.Date{}, err
+	}
+	return *temp.(*strfmt.Date), nil
+}
+
+func jsonMarshalNoError(i interface{}) string {
+	bytes, err := json.Marshal(i)
+	if err != nil {
+		// This should never happen
+		return ""
+	}
+	return string(bytes)
+}
+
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+
+// statusCodeForGetBooks returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetBooks(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.BookQuery:
+		return 200
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.BookQuery:
+		return 200
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetBooksHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetBooksInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	resp, err := h.GetBooks(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForGetBooks(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "getBooks", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetBooks(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetBooksInput takes in an http.Request an returns the input struct.
+func newGetBooksInput(r *http.Request) (*models.GetBooksInput, error) {
+	var input models.GetBooksInput
+
+	var err error
+	_ = err
+
+	if idsStrs, ok := r.URL.Query()["ids"]; ok {
+		idsStrs = swag.SplitByFormat(idsStrs[0], "csv")
+		for _, idsStr := range idsStrs {
+			idsItem, err := swag.ConvertInt64(idsStr)
+			if err != nil {
+				return nil, err
+			}
+			input.Ids = append(input.Ids, idsItem)
+		}
+	}
+
+	if authorsStrs, ok := r.URL.Query()["authors"]; ok {
+		authorsStrs = swag.SplitByFormat(authorsStrs[0], "pipes")
+		input.Authors = authorsStrs
+	}
+
+	if ratingsStrs, ok := r.URL.Query()["ratings"]; ok {
+		ratingsStrs = swag.SplitByFormat(ratingsStrs[0], "ssv")
+		for _, ratingsStr := range ratingsStrs {
+			ratingsItem, err := swag.ConvertFloat32(ratingsStr)
+			if err != nil {
+				return nil, err
+			}
+			input.Ratings = append(input.Ratings, ratingsItem)
+		}
+	}
+
+	if flagsStrs, ok := r.URL.Query()["flags"]; ok {
+		flagsStrs = swag.SplitByFormat(flagsStrs[0], "tsv")
+		for _, flagsStr := range flagsStrs {
+			flagsItem, err := strconv.ParseBool(flagsStr)
+			if err != nil {
+				return nil, err
+			}
+			input.Flags = append(input.Flags, flagsItem)
+		}
+	}
+
+	if yearsStrs, ok := r.URL.Query()["years"]; ok {
+		for _, yearsStr := range yearsStrs {
+			yearsItem, err := swag.ConvertInt32(yearsStr)
+			if err != nil {
+				return nil, err
+			}
+			input.Years = append(input.Years, yearsItem)
+		}
+	}
+	if tags, ok := r.URL.Query()["tags"]; ok {
+		input.Tags = tags
+	}
+
+	return &input, nil
+}
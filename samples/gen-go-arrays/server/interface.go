@@ -0,0 +1,21 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-arrays/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_controller.go -package server --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-arrays/models/v9
+
+// Controller defines the interface for the arrays-test service.
+type Controller interface {
+
+	// GetBooks handles GET requests to /books
+	//
+	// 200: *models.BookQuery
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetBooks(ctx context.Context, i *models.GetBooksInput) (*models.BookQuery, error)
+}
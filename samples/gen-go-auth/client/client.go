@@ -0,0 +1,623 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Clever/wag/samples/gen-go-auth/models/v9"
+
+	discovery "github.com/Clever/discovery-go"
+	wcl "github.com/Clever/wag/logging/wagclientlogger"
+)
+
+var _ = json.Marshal
+var _ = strings.Replace
+var _ = strconv.FormatInt
+var _ = bytes.Compare
+
+// Version of the client.
+const Version = "9.0.0"
+
+// VersionHeader is sent with every request.
+const VersionHeader = "X-Client-Version"
+
+// WagClient is used to make requests to the auth-test service.
+type WagClient struct {
+	basePath    string
+	requestDoer Doer
+	client      *http.Client
+	// Keep the retry doer around so that we can set the number of retries
+	retryDoer  *retryDoer
+	middleware []Middleware
+	// Keep the circuit breaker doer around so that we can set its options
+	circuitBreaker *circuitBreakerDoer
+	defaultTimeout time.Duration
+	logger         wcl.WagClientLogger
+	credentials    CredentialsProvider
+}
+
+var _ Client = (*WagClient)(nil)
+
+// New creates a new client. The base path, logger, and http transport are configurable.
+// The logger provided should be specifically created for this wag client. If tracing is required,
+// provide an instrumented transport using the wag clientconfig module. If no tracing is required, pass nil to use
+// the default transport.
+func New(basePath string, logger wcl.WagClientLogger, transport *http.RoundTripper) *WagClient {
+
+	t := http.DefaultTransport
+	if transport != nil {
+		t = *transport
+	}
+
+	basePath = strings.TrimSuffix(basePath, "/")
+	base := baseDoer{}
+	// The circuit breaker is off until its options are set
+	circuit := &circuitBreakerDoer{d: base, service: "auth-test", logger: logger}
+
+	// Don't use the default retry policy since its 5 retries can 5X the traffic
+	retry := retryDoer{d: circuit, retryPolicy: SingleRetryPolicy{}}
+
+	client := &WagClient{
+		basePath:    basePath,
+		requestDoer: &retry,
+		client: &http.Client{
+			Transport: t,
+		},
+		retryDoer:      &retry,
+		circuitBreaker: circuit,
+		defaultTimeout: 5 * time.Second,
+		logger:         logger,
+	}
+	return client
+}
+
+// NewFromDiscovery creates a client from the discovery environment variables. This method requires
+// the three env vars: SERVICE_AUTH_TEST_HTTP_(HOST/PORT/PROTO) to be set. Otherwise it returns an error.
+// The logger provided should be specifically created for this wag client. If tracing is required,
+// provide an instrumented transport using the wag clientconfig module. If no tracing is required, pass nil to use
+// the default transport.
+func NewFromDiscovery(logger wcl.WagClientLogger, transport *http.RoundTripper) (*WagClient, error) {
+	url, err := discovery.URL("auth-test", "default")
+	if err != nil {
+		url, err = discovery.URL("auth-test", "http") // Added fallback to maintain reverse compatibility
+		if err != nil {
+			return nil, err
+		}
+	}
+	return New(url, logger, transport), nil
+}
+
+// SetRetryPolicy sets a the given retry policy for all requests.
+func (c *WagClient) SetRetryPolicy(retryPolicy RetryPolicy) {
+	c.retryDoer.retryPolicy = retryPolicy
+}
+
+// SetCircuitBreaker turns on a circuit breaker with the given options for all the operations that
+// don't have their own. Each attempt at a request counts, so retries of failed requests stop when
+// the circuit opens. Requests fail with ErrCircuitOpen while it's open. Setting the options resets
+// the circuit.
+func (c *WagClient) SetCircuitBreaker(options CircuitBreakerOptions) {
+	c.circuitBreaker.setOptions("", options)
+}
+
+// SetOperationCircuitBreaker gives an operation, e.g. "getBookByID", its own circuit breaker with
+// the given options. Use CircuitBreakerOptions{ForceClosed: true} to turn the circuit breaker off
+// for the operation.
+func (c *WagClient) SetOperationCircuitBreaker(operation string, options CircuitBreakerOptions) {
+	c.circuitBreaker.setOptions(operation, options)
+}
+
+// AddMiddleware adds middleware that wraps the requests of all operations. Middleware is called in
+// the order it's added, before the client retries requests, so it's called once per call to an
+// operation, or per page for operations with paging.
+func (c *WagClient) AddMiddleware(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+	var d Doer = c.retryDoer
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		d = c.middleware[i](d)
+	}
+	c.requestDoer = d
+}
+
+// SetLogger allows for setting a custom logger
+func (c *WagClient) SetLogger(l wcl.WagClientLogger) {
+	c.logger = l
+	c.circuitBreaker.setLogger(l)
+}
+
+// SetTimeout sets a timeout on all operations for the client. To make a single request with a shorter timeout
+// than the default on the client, use context.WithTimeout as described here: https://godoc.org/golang.org/x/net/context#WithTimeout.
+func (c *WagClient) SetTimeout(timeout time.Duration) {
+	c.defaultTimeout = timeout
+}
+
+// HealthCheck makes a GET request to /health
+//
+// 200: nil
+// 400: *models.BadRequest
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) HealthCheck(ctx context.Context) error {
+	headers := make(map[string]string)
+
+	var body []byte
+	path := c.basePath + "/v1/health"
+
+	req, err := http.NewRequestWithContext(ctx, "GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return err
+	}
+
+	return c.doHealthCheckRequest(ctx, req, headers, nil)
+}
+
+func (c *WagClient) doHealthCheckRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (err error) {
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Canonical-Resource", "healthCheck")
+	req.Header.Set(VersionHeader, Version)
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "healthCheck")
+	operation := &Operation{Name: "healthCheck", Input: input}
+	defer func() {
+		operation.finish(nil, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := c.requestDoer.Do(c.client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := map[string]interface{}{
+		"backend":     "auth-test",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 && retCode < 500 {
+		logData["message"] = resp.Status
+		c.logger.Log(wcl.Warning, "client-request-finished", logData)
+	}
+	if err == nil && retCode > 499 {
+		logData["message"] = resp.Status
+		c.logger.Log(wcl.Error, "client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.Log(wcl.Error, "client-request-finished", logData)
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		return nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	default:
+		bs, _ := ioutil.ReadAll(resp.Body)
+		return models.UnknownResponse{StatusCode: int64(resp.StatusCode), Body: string(bs)}
+	}
+}
+
+// GetWidgets makes a GET request to /widgets
+//
+// 200: []models.Widget
+// 400: *models.BadRequest
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWidgets(ctx context.Context) ([]models.Widget, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path := c.basePath + "/v1/widgets"
+
+	req, err := http.NewRequestWithContext(ctx, "GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doGetWidgetsRequest(ctx, req, headers, nil)
+}
+
+// securityForGetWidgets are the security requirements of getWidgets.
+var securityForGetWidgets = [][]SecurityScheme{
+	{SecuritySchemeAPIKey},
+	{SecuritySchemeOauth},
+}
+
+func (c *WagClient) doGetWidgetsRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (output []models.Widget, err error) {
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Canonical-Resource", "getWidgets")
+	req.Header.Set(VersionHeader, Version)
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	if err := c.applyCredentials(ctx, req, securityForGetWidgets); err != nil {
+		return nil, err
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWidgets")
+	operation := &Operation{Name: "getWidgets", Input: input}
+	defer func() {
+		operation.finish(output, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := c.requestDoer.Do(c.client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := map[string]interface{}{
+		"backend":     "auth-test",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 && retCode < 500 {
+		logData["message"] = resp.Status
+		c.logger.Log(wcl.Warning, "client-request-finished", logData)
+	}
+	if err == nil && retCode > 499 {
+		logData["message"] = resp.Status
+		c.logger.Log(wcl.Error, "client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.Log(wcl.Error, "client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output []models.Widget
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		bs, _ := ioutil.ReadAll(resp.Body)
+		return nil, models.UnknownResponse{StatusCode: int64(resp.StatusCode), Body: string(bs)}
+	}
+}
+
+// CreateWidget makes a POST request to /widgets
+//
+// 200: *models.Widget
+// 400: *models.BadRequest
+// 403: *models.Forbidden
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) CreateWidget(ctx context.Context, i *models.Widget) (*models.Widget, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path := c.basePath + "/v1/widgets"
+
+	if i != nil {
+
+		var err error
+		body, err = json.Marshal(i)
+
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doCreateWidgetRequest(ctx, req, headers, i)
+}
+
+// securityForCreateWidget are the security requirements of createWidget.
+var securityForCreateWidget = [][]SecurityScheme{
+	{SecuritySchemeOauth},
+	{SecuritySchemeBasic, SecuritySchemeQueryKey},
+}
+
+func (c *WagClient) doCreateWidgetRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (output *models.Widget, err error) {
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Canonical-Resource", "createWidget")
+	req.Header.Set(VersionHeader, Version)
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	if err := c.applyCredentials(ctx, req, securityForCreateWidget); err != nil {
+		return nil, err
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "createWidget")
+	operation := &Operation{Name: "createWidget", Input: input}
+	defer func() {
+		operation.finish(output, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := c.requestDoer.Do(c.client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := map[string]interface{}{
+		"backend":     "auth-test",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 && retCode < 500 {
+		logData["message"] = resp.Status
+		c.logger.Log(wcl.Warning, "client-request-finished", logData)
+	}
+	if err == nil && retCode > 499 {
+		logData["message"] = resp.Status
+		c.logger.Log(wcl.Error, "client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.Log(wcl.Error, "client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output models.Widget
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 403:
+
+		var output models.Forbidden
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		bs, _ := ioutil.ReadAll(resp.Body)
+		return nil, models.UnknownResponse{StatusCode: int64(resp.StatusCode), Body: string(bs)}
+	}
+}
+
+// GetWidget makes a GET request to /widgets/{name}
+//
+// 200: *models.Widget
+// 400: *models.BadRequest
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWidget(ctx context.Context, name string) (*models.Widget, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := models.GetWidgetInputPath(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequestWithContext(ctx, "GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doGetWidgetRequest(ctx, req, headers, name)
+}
+
+// securityForGetWidget are the security requirements of getWidget.
+var securityForGetWidget = [][]SecurityScheme{
+	{},
+	{SecuritySchemeAPIKey},
+}
+
+func (c *WagClient) doGetWidgetRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (output *models.Widget, err error) {
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Canonical-Resource", "getWidget")
+	req.Header.Set(VersionHeader, Version)
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	if err := c.applyCredentials(ctx, req, securityForGetWidget); err != nil {
+		return nil, err
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWidget")
+	operation := &Operation{Name: "getWidget", Input: input}
+	defer func() {
+		operation.finish(output, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := c.requestDoer.Do(c.client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := map[string]interface{}{
+		"backend":     "auth-test",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 && retCode < 500 {
+		logData["message"] = resp.Status
+		c.logger.Log(wcl.Warning, "client-request-finished", logData)
+	}
+	if err == nil && retCode > 499 {
+		logData["message"] = resp.Status
+		c.logger.Log(wcl.Error, "client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.Log(wcl.Error, "client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output models.Widget
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		bs, _ := ioutil.ReadAll(resp.Body)
+		return nil, models.UnknownResponse{StatusCode: int64(resp.StatusCode), Body: string(bs)}
+	}
+}
+
+func shortHash(s string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(s)))[0:6]
+}
@@ -0,0 +1,255 @@
+// Package clientfake has an in-memory implementation of the auth-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-auth/client/v9"
+	"github.com/Clever/wag/samples/gen-go-auth/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	healthCheckStub  func(ctx context.Context) error
+	healthCheckQueue []healthCheckResult
+	healthCheckCalls []HealthCheckCall
+
+	getWidgetsStub  func(ctx context.Context) ([]models.Widget, error)
+	getWidgetsQueue []getWidgetsResult
+	getWidgetsCalls []GetWidgetsCall
+
+	createWidgetStub  func(ctx context.Context, i *models.Widget) (*models.Widget, error)
+	createWidgetQueue []createWidgetResult
+	createWidgetCalls []CreateWidgetCall
+
+	getWidgetStub  func(ctx context.Context, name string) (*models.Widget, error)
+	getWidgetQueue []getWidgetResult
+	getWidgetCalls []GetWidgetCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// HealthCheckCall records a call to HealthCheck.
+type HealthCheckCall struct {
+	Ctx context.Context
+}
+
+type healthCheckResult struct {
+	err error
+}
+
+// StubHealthCheck sets the function that answers calls to HealthCheck once its queued responses
+// are used up.
+func (f *Fake) StubHealthCheck(stub func(ctx context.Context) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.healthCheckStub = stub
+}
+
+// QueueHealthCheck adds a response for a call to HealthCheck.
+func (f *Fake) QueueHealthCheck(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.healthCheckQueue = append(f.healthCheckQueue, healthCheckResult{err: err})
+}
+
+// HealthCheckCalls returns the calls made to HealthCheck.
+func (f *Fake) HealthCheckCalls() []HealthCheckCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]HealthCheckCall{}, f.healthCheckCalls...)
+}
+
+// HealthCheck returns the next queued response or calls the stub.
+func (f *Fake) HealthCheck(ctx context.Context) error {
+	f.mu.Lock()
+	f.healthCheckCalls = append(f.healthCheckCalls, HealthCheckCall{Ctx: ctx})
+	if len(f.healthCheckQueue) > 0 {
+		result := f.healthCheckQueue[0]
+		f.healthCheckQueue = f.healthCheckQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.healthCheckStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	return notStubbed("HealthCheck")
+}
+
+// GetWidgetsCall records a call to GetWidgets.
+type GetWidgetsCall struct {
+	Ctx context.Context
+}
+
+type getWidgetsResult struct {
+	resp []models.Widget
+	err  error
+}
+
+// StubGetWidgets sets the function that answers calls to GetWidgets once its queued responses
+// are used up.
+func (f *Fake) StubGetWidgets(stub func(ctx context.Context) ([]models.Widget, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getWidgetsStub = stub
+}
+
+// QueueGetWidgets adds a response for a call to GetWidgets.
+func (f *Fake) QueueGetWidgets(resp []models.Widget, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getWidgetsQueue = append(f.getWidgetsQueue, getWidgetsResult{resp: resp, err: err})
+}
+
+// GetWidgetsCalls returns the calls made to GetWidgets.
+func (f *Fake) GetWidgetsCalls() []GetWidgetsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetWidgetsCall{}, f.getWidgetsCalls...)
+}
+
+// GetWidgets returns the next queued response or calls the stub.
+func (f *Fake) GetWidgets(ctx context.Context) ([]models.Widget, error) {
+	f.mu.Lock()
+	f.getWidgetsCalls = append(f.getWidgetsCalls, GetWidgetsCall{Ctx: ctx})
+	if len(f.getWidgetsQueue) > 0 {
+		result := f.getWidgetsQueue[0]
+		f.getWidgetsQueue = f.getWidgetsQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getWidgetsStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	var resp []models.Widget
+	return resp, notStubbed("GetWidgets")
+}
+
+// CreateWidgetCall records a call to CreateWidget.
+type CreateWidgetCall struct {
+	Ctx   context.Context
+	Input *models.Widget
+}
+
+type createWidgetResult struct {
+	resp *models.Widget
+	err  error
+}
+
+// StubCreateWidget sets the function that answers calls to CreateWidget once its queued responses
+// are used up.
+func (f *Fake) StubCreateWidget(stub func(ctx context.Context, i *models.Widget) (*models.Widget, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createWidgetStub = stub
+}
+
+// QueueCreateWidget adds a response for a call to CreateWidget.
+func (f *Fake) QueueCreateWidget(resp *models.Widget, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createWidgetQueue = append(f.createWidgetQueue, createWidgetResult{resp: resp, err: err})
+}
+
+// CreateWidgetCalls returns the calls made to CreateWidget.
+func (f *Fake) CreateWidgetCalls() []CreateWidgetCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]CreateWidgetCall{}, f.createWidgetCalls...)
+}
+
+// CreateWidget returns the next queued response or calls the stub.
+func (f *Fake) CreateWidget(ctx context.Context, i *models.Widget) (*models.Widget, error) {
+	f.mu.Lock()
+	f.createWidgetCalls = append(f.createWidgetCalls, CreateWidgetCall{Ctx: ctx, Input: i})
+	if len(f.createWidgetQueue) > 0 {
+		result := f.createWidgetQueue[0]
+		f.createWidgetQueue = f.createWidgetQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.createWidgetStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.Widget
+	return resp, notStubbed("CreateWidget")
+}
+
+// GetWidgetCall records a call to GetWidget.
+type GetWidgetCall struct {
+	Ctx   context.Context
+	Input string
+}
+
+type getWidgetResult struct {
+	resp *models.Widget
+	err  error
+}
+
+// StubGetWidget sets the function that answers calls to GetWidget once its queued responses
+// are used up.
+func (f *Fake) StubGetWidget(stub func(ctx context.Context, name string) (*models.Widget, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getWidgetStub = stub
+}
+
+// QueueGetWidget adds a response for a call to GetWidget.
+func (f *Fake) QueueGetWidget(resp *models.Widget, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getWidgetQueue = append(f.getWidgetQueue, getWidgetResult{resp: resp, err: err})
+}
+
+// GetWidgetCalls returns the calls made to GetWidget.
+func (f *Fake) GetWidgetCalls() []GetWidgetCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetWidgetCall{}, f.getWidgetCalls...)
+}
+
+// GetWidget returns the next queued response or calls the stub.
+func (f *Fake) GetWidget(ctx context.Context, name string) (*models.Widget, error) {
+	f.mu.Lock()
+	f.getWidgetCalls = append(f.getWidgetCalls, GetWidgetCall{Ctx: ctx, Input: name})
+	if len(f.getWidgetQueue) > 0 {
+		result := f.getWidgetQueue[0]
+		f.getWidgetQueue = f.getWidgetQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getWidgetStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, name)
+	}
+	var resp *models.Widget
+	return resp, notStubbed("GetWidget")
+}
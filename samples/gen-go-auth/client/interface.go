@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-auth/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_client.go -package client --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-auth/models/v9
+
+// Client defines the methods available to clients of the auth-test service.
+type Client interface {
+
+	// HealthCheck makes a GET request to /health
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	HealthCheck(ctx context.Context) error
+
+	// GetWidgets makes a GET request to /widgets
+	//
+	// 200: []models.Widget
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWidgets(ctx context.Context) ([]models.Widget, error)
+
+	// CreateWidget makes a POST request to /widgets
+	//
+	// 200: *models.Widget
+	// 400: *models.BadRequest
+	// 403: *models.Forbidden
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateWidget(ctx context.Context, i *models.Widget) (*models.Widget, error)
+
+	// GetWidget makes a GET request to /widgets/{name}
+	//
+	// 200: *models.Widget
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWidget(ctx context.Context, name string) (*models.Widget, error)
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// These imports may not be used depending on the input parameters
+var _ = json.Marshal
+var _ = fmt.Sprintf
+var _ = url.QueryEscape
+var _ = strconv.FormatInt
+var _ = strings.Replace
+var _ = validate.Maximum
+var _ = strfmt.NewFormats
+
+// HealthCheckInput holds the input parameters for a healthCheck operation.
+type HealthCheckInput struct {
+}
+
+// Validate returns an error if any of the HealthCheckInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i HealthCheckInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i HealthCheckInput) Path() (string, error) {
+	path := "/v1/health"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWidgetsInput holds the input parameters for a getWidgets operation.
+type GetWidgetsInput struct {
+}
+
+// Validate returns an error if any of the GetWidgetsInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWidgetsInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWidgetsInput) Path() (string, error) {
+	path := "/v1/widgets"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWidgetInput holds the input parameters for a getWidget operation.
+type GetWidgetInput struct {
+	Name string
+}
+
+// ValidateGetWidgetInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetWidgetInput(name string) error {
+
+	return nil
+}
+
+// GetWidgetInputPath returns the URI path for the input.
+func GetWidgetInputPath(name string) (string, error) {
+	path := "/v1/widgets/{name}"
+	urlVals := url.Values{}
+
+	pathname := name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
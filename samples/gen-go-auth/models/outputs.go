@@ -0,0 +1,19 @@
+package models
+
+import "fmt"
+
+func (o BadRequest) Error() string {
+	return o.Message
+}
+
+func (o Forbidden) Error() string {
+	return o.Message
+}
+
+func (o InternalError) Error() string {
+	return o.Message
+}
+
+func (u UnknownResponse) Error() string {
+	return fmt.Sprintf("unknown response with status: %d body: %s", u.StatusCode, u.Body)
+}
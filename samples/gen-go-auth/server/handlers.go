@@ -0,0 +1,464 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/Clever/kayvee-go/v7/logger"
+	"github.com/Clever/wag/samples/gen-go-auth/models/v9"
+	"github.com/go-errors/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"golang.org/x/xerrors"
+)
+
+var _ = strconv.ParseInt
+var _ = strfmt.Default
+var _ = swag.ConvertInt32
+var _ = errors.New
+var _ = mux.Vars
+var _ = bytes.Compare
+var _ = ioutil.ReadAll
+
+var formats = strfmt.Default
+var _ = formats
+
+// convertBase64 takes in a string and returns a strfmt.Base64 if the input
+// is valid base64 and an error otherwise.
+func convertBase64(input string) (strfmt.Base64, error) {
+	temp, err := formats.Parse("byte", input)
+	if err != nil {
+		return strfmt.Base64{}, err
+	}
+	return *temp.(*strfmt.Base64), nil
+}
+
+// convertDateTime takes in a string and returns a strfmt.DateTime if the input
+// is a valid DateTime and an error otherwise.
+func convertDateTime(input string) (strfmt.DateTime, error) {
+	temp, err := formats.Parse("date-time", input)
+	if err != nil {
+		return strfmt.DateTime{}, err
+	}
+	return *temp.(*strfmt.DateTime), nil
+}
+
+// convertDate takes in a string and returns a strfmt.Date if the input
+// is a valid Date and an error otherwise.
+func convertDate(input string) (strfmt.Date, error) {
+	temp, err := formats.Parse("date", input)
+	if err != nil {
+		return strfmt.Date{}, err
+	}
+	return *temp.(*strfmt.Date), nil
+}
+
+func jsonMarshalNoError(i interface{}) string {
+	bytes, err := json.Marshal(i)
+	if err != nil {
+		// This should never happen
+		return ""
+	}
+	return string(bytes)
+}
+
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+
+// statusCodeForHealthCheck returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForHealthCheck(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) HealthCheckHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	err := h.HealthCheck(ctx)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForHealthCheck(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newHealthCheckInput takes in an http.Request an returns the input struct.
+func newHealthCheckInput(r *http.Request) (*models.HealthCheckInput, error) {
+	var input models.HealthCheckInput
+
+	var err error
+	_ = err
+
+	return &input, nil
+}
+
+// statusCodeForGetWidgets returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWidgets(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.Widget:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case []models.Widget:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+// securityForGetWidgets are the security requirements of getWidgets.
+var securityForGetWidgets = [][]securityRequirement{
+	{{scheme: SecuritySchemeAPIKey, scopes: nil}},
+	{{scheme: SecuritySchemeOauth, scopes: []string{"read:widgets"}}},
+}
+
+func (h handler) GetWidgetsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	ctx, authErr := authenticate(ctx, h.Controller, r, "getWidgets", securityForGetWidgets)
+	if authErr != nil {
+		logger.FromContext(ctx).AddContext("error", authErr.Error())
+		statusCode := statusCodeForGetWidgets(authErr)
+		if statusCode == -1 {
+			writeError(w, r, http.StatusUnauthorized, unauthorized{Message: authErr.Error()})
+			return
+		}
+		writeError(w, r, statusCode, authErr)
+		return
+	}
+
+	resp, err := h.GetWidgets(ctx)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.Widget{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForGetWidgets(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "getWidgets", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWidgets(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWidgetsInput takes in an http.Request an returns the input struct.
+func newGetWidgetsInput(r *http.Request) (*models.GetWidgetsInput, error) {
+	var input models.GetWidgetsInput
+
+	var err error
+	_ = err
+
+	return &input, nil
+}
+
+// statusCodeForCreateWidget returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForCreateWidget(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.Forbidden:
+		return 403
+
+	case *models.InternalError:
+		return 500
+
+	case *models.Widget:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.Forbidden:
+		return 403
+
+	case models.InternalError:
+		return 500
+
+	case models.Widget:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+// securityForCreateWidget are the security requirements of createWidget.
+var securityForCreateWidget = [][]securityRequirement{
+	{{scheme: SecuritySchemeOauth, scopes: []string{"write:widgets"}}},
+	{{scheme: SecuritySchemeBasic, scopes: nil}, {scheme: SecuritySchemeQueryKey, scopes: nil}},
+}
+
+func (h handler) CreateWidgetHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	ctx, authErr := authenticate(ctx, h.Controller, r, "createWidget", securityForCreateWidget)
+	if authErr != nil {
+		logger.FromContext(ctx).AddContext("error", authErr.Error())
+		statusCode := statusCodeForCreateWidget(authErr)
+		if statusCode == -1 {
+			writeError(w, r, http.StatusUnauthorized, unauthorized{Message: authErr.Error()})
+			return
+		}
+		writeError(w, r, statusCode, authErr)
+		return
+	}
+
+	input, err := newCreateWidgetInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	if input != nil {
+		err = input.Validate(nil)
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	resp, err := h.CreateWidget(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForCreateWidget(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "createWidget", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForCreateWidget(resp))
+	w.Write(respBytes)
+
+}
+
+// newCreateWidgetInput takes in an http.Request an returns the input struct.
+func newCreateWidgetInput(r *http.Request) (*models.Widget, error) {
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+	if len(data) > 0 {
+		var input models.Widget
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+		return &input, nil
+	}
+
+	return nil, nil
+}
+
+// statusCodeForGetWidget returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWidget(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.Widget:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.Widget:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+// securityForGetWidget are the security requirements of getWidget.
+var securityForGetWidget = [][]securityRequirement{
+	{},
+	{{scheme: SecuritySchemeAPIKey, scopes: nil}},
+}
+
+func (h handler) GetWidgetHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	ctx, authErr := authenticate(ctx, h.Controller, r, "getWidget", securityForGetWidget)
+	if authErr != nil {
+		logger.FromContext(ctx).AddContext("error", authErr.Error())
+		statusCode := statusCodeForGetWidget(authErr)
+		if statusCode == -1 {
+			writeError(w, r, http.StatusUnauthorized, unauthorized{Message: authErr.Error()})
+			return
+		}
+		writeError(w, r, statusCode, authErr)
+		return
+	}
+
+	name, err := newGetWidgetInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	err = models.ValidateGetWidgetInput(name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	resp, err := h.GetWidget(ctx, name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForGetWidget(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "getWidget", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWidget(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWidgetInput takes in an http.Request an returns the name parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetWidgetInput(r *http.Request) (string, error) {
+	name := mux.Vars(r)["name"]
+	if len(name) == 0 {
+		return "", errors.New("Parameter name must be specified")
+	}
+	return name, nil
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-auth/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_controller.go -package server --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-auth/models/v9
+
+// Authenticator authenticates requests to operations with security requirements.
+type Authenticator interface {
+	// Authenticate checks the credentials a request presented for one of the operation's
+	// security schemes. scopes are the scopes the operation requires for that scheme. It's called
+	// before the controller method, once per scheme, and the returned context is passed on to the
+	// controller method, so it can carry the authenticated caller.
+	// Errors that match one of the operation's responses are returned with that status code,
+	// all other errors are returned as a 401.
+	Authenticate(ctx context.Context, op string, creds Credentials, scopes []string) (context.Context, error)
+}
+
+// Controller defines the interface for the auth-test service.
+type Controller interface {
+	Authenticator
+
+	// HealthCheck handles GET requests to /health
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	HealthCheck(ctx context.Context) error
+
+	// GetWidgets handles GET requests to /widgets
+	//
+	// 200: []models.Widget
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWidgets(ctx context.Context) ([]models.Widget, error)
+
+	// CreateWidget handles POST requests to /widgets
+	//
+	// 200: *models.Widget
+	// 400: *models.BadRequest
+	// 403: *models.Forbidden
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateWidget(ctx context.Context, i *models.Widget) (*models.Widget, error)
+
+	// GetWidget handles GET requests to /widgets/{name}
+	//
+	// 200: *models.Widget
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWidget(ctx context.Context, name string) (*models.Widget, error)
+}
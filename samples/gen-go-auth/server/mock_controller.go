@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+
+// Package server is a generated GoMock package.
+package server
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v9 "github.com/Clever/wag/samples/gen-go-auth/models/v9"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthenticator is a mock of Authenticator interface.
+type MockAuthenticator struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthenticatorMockRecorder
+}
+
+// MockAuthenticatorMockRecorder is the mock recorder for MockAuthenticator.
+type MockAuthenticatorMockRecorder struct {
+	mock *MockAuthenticator
+}
+
+// NewMockAuthenticator creates a new mock instance.
+func NewMockAuthenticator(ctrl *gomock.Controller) *MockAuthenticator {
+	mock := &MockAuthenticator{ctrl: ctrl}
+	mock.recorder = &MockAuthenticatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthenticator) EXPECT() *MockAuthenticatorMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAuthenticator) Authenticate(ctx context.Context, op string, creds Credentials, scopes []string) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, op, creds, scopes)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAuthenticatorMockRecorder) Authenticate(ctx, op, creds, scopes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAuthenticator)(nil).Authenticate), ctx, op, creds, scopes)
+}
+
+// MockController is a mock of Controller interface.
+type MockController struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerMockRecorder
+}
+
+// MockControllerMockRecorder is the mock recorder for MockController.
+type MockControllerMockRecorder struct {
+	mock *MockController
+}
+
+// NewMockController creates a new mock instance.
+func NewMockController(ctrl *gomock.Controller) *MockController {
+	mock := &MockController{ctrl: ctrl}
+	mock.recorder = &MockControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockController) EXPECT() *MockControllerMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockController) Authenticate(ctx context.Context, op string, creds Credentials, scopes []string) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, op, creds, scopes)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockControllerMockRecorder) Authenticate(ctx, op, creds, scopes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockController)(nil).Authenticate), ctx, op, creds, scopes)
+}
+
+// CreateWidget mocks base method.
+func (m *MockController) CreateWidget(ctx context.Context, i *v9.Widget) (*v9.Widget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWidget", ctx, i)
+	ret0, _ := ret[0].(*v9.Widget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWidget indicates an expected call of CreateWidget.
+func (mr *MockControllerMockRecorder) CreateWidget(ctx, i interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWidget", reflect.TypeOf((*MockController)(nil).CreateWidget), ctx, i)
+}
+
+// GetWidget mocks base method.
+func (m *MockController) GetWidget(ctx context.Context, name string) (*v9.Widget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWidget", ctx, name)
+	ret0, _ := ret[0].(*v9.Widget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWidget indicates an expected call of GetWidget.
+func (mr *MockControllerMockRecorder) GetWidget(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWidget", reflect.TypeOf((*MockController)(nil).GetWidget), ctx, name)
+}
+
+// GetWidgets mocks base method.
+func (m *MockController) GetWidgets(ctx context.Context) ([]v9.Widget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWidgets", ctx)
+	ret0, _ := ret[0].([]v9.Widget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWidgets indicates an expected call of GetWidgets.
+func (mr *MockControllerMockRecorder) GetWidgets(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWidgets", reflect.TypeOf((*MockController)(nil).GetWidgets), ctx)
+}
+
+// HealthCheck mocks base method.
+func (m *MockController) HealthCheck(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthCheck", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HealthCheck indicates an expected call of HealthCheck.
+func (mr *MockControllerMockRecorder) HealthCheck(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockController)(nil).HealthCheck), ctx)
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"iter"
 	"net/http"
 	"strconv"
 	"strings"
@@ -32,11 +33,15 @@ const VersionHeader = "X-Client-Version"
 // WagClient is used to make requests to the swagger-test service.
 type WagClient struct {
 	basePath    string
-	requestDoer doer
+	requestDoer Doer
 	client      *http.Client
 	// Keep the retry doer around so that we can set the number of retries
-	retryDoer      *retryDoer
+	retryDoer  *retryDoer
+	middleware []Middleware
+	// Keep the circuit breaker doer around so that we can set its options
+	circuitBreaker *circuitBreakerDoer
 	defaultTimeout time.Duration
+	pagePrefetch   int
 	logger         wcl.WagClientLogger
 }
 
@@ -55,9 +60,11 @@ func New(basePath string, logger wcl.WagClientLogger, transport *http.RoundTripp
 
 	basePath = strings.TrimSuffix(basePath, "/")
 	base := baseDoer{}
+	// The circuit breaker is off until its options are set
+	circuit := &circuitBreakerDoer{d: base, service: "swagger-test", logger: logger}
 
 	// Don't use the default retry policy since its 5 retries can 5X the traffic
-	retry := retryDoer{d: base, retryPolicy: SingleRetryPolicy{}}
+	retry := retryDoer{d: circuit, retryPolicy: SingleRetryPolicy{}}
 
 	client := &WagClient{
 		basePath:    basePath,
@@ -66,6 +73,7 @@ func New(basePath string, logger wcl.WagClientLogger, transport *http.RoundTripp
 			Transport: t,
 		},
 		retryDoer:      &retry,
+		circuitBreaker: circuit,
 		defaultTimeout: 5 * time.Second,
 		logger:         logger,
 	}
@@ -93,9 +101,46 @@ func (c *WagClient) SetRetryPolicy(retryPolicy RetryPolicy) {
 	c.retryDoer.retryPolicy = retryPolicy
 }
 
+// SetCircuitBreaker turns on a circuit breaker with the given options for all the operations that
+// don't have their own. Each attempt at a request counts, so retries of failed requests stop when
+// the circuit opens. Requests fail with ErrCircuitOpen while it's open. Setting the options resets
+// the circuit.
+func (c *WagClient) SetCircuitBreaker(options CircuitBreakerOptions) {
+	c.circuitBreaker.setOptions("", options)
+}
+
+// SetOperationCircuitBreaker gives an operation, e.g. "getBookByID", its own circuit breaker with
+// the given options. Use CircuitBreakerOptions{ForceClosed: true} to turn the circuit breaker off
+// for the operation.
+func (c *WagClient) SetOperationCircuitBreaker(operation string, options CircuitBreakerOptions) {
+	c.circuitBreaker.setOptions(operation, options)
+}
+
+// AddMiddleware adds middleware that wraps the requests of all operations. Middleware is called in
+// the order it's added, before the client retries requests, so it's called once per call to an
+// operation, or per page for operations with paging.
+func (c *WagClient) AddMiddleware(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+	var d Doer = c.retryDoer
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		d = c.middleware[i](d)
+	}
+	c.requestDoer = d
+}
+
 // SetLogger allows for setting a custom logger
 func (c *WagClient) SetLogger(l wcl.WagClientLogger) {
 	c.logger = l
+	c.circuitBreaker.setLogger(l)
+}
+
+// SetPagePrefetch sets the number of pages that the iterators and sequences of paged operations
+// fetch ahead of the page being iterated, concurrently. The default, 0, fetches each page when it's
+// needed. It applies to iterators constructed after it's set. Iterators that prefetch keep fetching
+// pages until the last one, until their context is done or until they're closed, so Close an
+// iterator that isn't iterated to the end.
+func (c *WagClient) SetPagePrefetch(depth int) {
+	c.pagePrefetch = depth
 }
 
 // SetTimeout sets a timeout on all operations for the client. To make a single request with a shorter timeout
@@ -128,7 +173,7 @@ func (c *WagClient) GetAuthors(ctx context.Context, i *models.GetAuthorsInput) (
 		return nil, err
 	}
 
-	resp, _, err := c.doGetAuthorsRequest(ctx, req, headers)
+	resp, _, err := c.doGetAuthorsRequest(ctx, req, headers, i)
 	return resp, err
 }
 
@@ -141,11 +186,47 @@ type getAuthorsIterImpl struct {
 	nextURL      string
 	headers      map[string]string
 	body         []byte
+	input        interface{}
+	// prefetch is the number of pages to fetch ahead. The pages are sent on pages, and cancel
+	// stops fetching them.
+	prefetch int
+	pages    chan getAuthorsPage
+	cancel   context.CancelFunc
+}
+
+// getAuthorsPage is a page of getAuthors and the URL of the page after it.
+type getAuthorsPage struct {
+	resources []*models.Author
+	nextURL   string
+	err       error
 }
 
 // NewgetAuthorsIter constructs an iterator that makes calls to getAuthors for
 // each page.
 func (c *WagClient) NewGetAuthorsIter(ctx context.Context, i *models.GetAuthorsInput) (GetAuthorsIter, error) {
+	it, err := c.newGetAuthorsIter(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// NewGetAuthorsIterFromCursor constructs an iterator that resumes iterating the pages of
+// getAuthors from the Cursor of an iterator constructed with the same input. The iterator has no
+// pages if the cursor is empty.
+func (c *WagClient) NewGetAuthorsIterFromCursor(ctx context.Context, i *models.GetAuthorsInput, cursor string) (GetAuthorsIter, error) {
+	it, err := c.newGetAuthorsIter(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+	it.nextURL = ""
+	if cursor != "" {
+		it.nextURL = c.basePath + cursor
+	}
+	return it, nil
+}
+
+func (c *WagClient) newGetAuthorsIter(ctx context.Context, i *models.GetAuthorsInput) (*getAuthorsIterImpl, error) {
 	path, err := i.Path()
 
 	if err != nil {
@@ -165,33 +246,96 @@ func (c *WagClient) NewGetAuthorsIter(ctx context.Context, i *models.GetAuthorsI
 		nextURL:      path,
 		headers:      headers,
 		body:         body,
+		input:        i,
+		prefetch:     c.pagePrefetch,
 	}, nil
 }
 
-func (i *getAuthorsIterImpl) refresh() error {
-	req, err := http.NewRequestWithContext(i.ctx, "GET", i.nextURL, bytes.NewBuffer(i.body))
+// fetch fetches the page at url.
+func (i *getAuthorsIterImpl) fetch(ctx context.Context, url string) getAuthorsPage {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, bytes.NewBuffer(i.body))
 
 	if err != nil {
-		i.err = err
-		return err
+		return getAuthorsPage{err: err}
 	}
 
-	resp, nextPage, err := i.c.doGetAuthorsRequest(i.ctx, req, i.headers)
+	resp, nextPage, err := i.c.doGetAuthorsRequest(ctx, req, i.headers, i.input)
 	if err != nil {
-		i.err = err
-		return err
+		return getAuthorsPage{err: err}
 	}
 
-	i.lastResponse = resp.AuthorSet.Results
-	i.index = 0
+	page := getAuthorsPage{resources: resp.AuthorSet.Results}
 	if nextPage != "" {
-		i.nextURL = i.c.basePath + nextPage
+		page.nextURL = i.c.basePath + nextPage
+	}
+	return page
+}
+
+// startPrefetch starts fetching the pages after the current one ahead of the caller.
+func (i *getAuthorsIterImpl) startPrefetch() {
+	ctx, cancel := context.WithCancel(i.ctx)
+	i.cancel = cancel
+	// The page being fetched counts towards the depth
+	i.pages = make(chan getAuthorsPage, i.prefetch-1)
+	go func(url string) {
+		defer close(i.pages)
+		for url != "" {
+			page := i.fetch(ctx, url)
+			select {
+			case i.pages <- page:
+			case <-ctx.Done():
+				return
+			}
+			if page.err != nil {
+				return
+			}
+			url = page.nextURL
+		}
+	}(i.nextURL)
+}
+
+// stop stops fetching pages ahead of the caller.
+func (i *getAuthorsIterImpl) stop() {
+	if i.cancel != nil {
+		i.cancel()
+	}
+}
+
+func (i *getAuthorsIterImpl) refresh() error {
+	var page getAuthorsPage
+	if i.prefetch > 0 {
+		if i.pages == nil {
+			i.startPrefetch()
+		}
+		var ok bool
+		if page, ok = <-i.pages; !ok {
+			page.err = i.ctx.Err()
+			if page.err == nil {
+				page.err = context.Canceled
+			}
+		}
 	} else {
-		i.nextURL = ""
+		page = i.fetch(i.ctx, i.nextURL)
+	}
+	if page.err != nil {
+		i.err = page.err
+		return page.err
 	}
+
+	i.lastResponse = page.resources
+	i.index = 0
+	i.nextURL = page.nextURL
 	return nil
 }
 
+// Cursor returns the path of the next page the iterator fetches, relative to the client's base
+// path, i.e. the X-Next-Page-Path of the current page, or "" if there are no more pages. An
+// iterator from NewGetAuthorsIterFromCursor with the cursor resumes after the current page, so
+// save it after iterating all the resources of a page.
+func (i *getAuthorsIterImpl) Cursor() string {
+	return strings.TrimPrefix(i.nextURL, i.c.basePath)
+}
+
 // Next retrieves the next resource from the iterator and assigns it to the
 // provided pointer, fetching a new page if necessary. Returns true if it
 // successfully retrieves a new resource.
@@ -217,7 +361,55 @@ func (i *getAuthorsIterImpl) Err() error {
 	return i.err
 }
 
-func (c *WagClient) doGetAuthorsRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.AuthorsResponse, string, error) {
+// Close stops the iterator fetching pages ahead of the caller. Call it when an iterator isn't
+// iterated to the end.
+func (i *getAuthorsIterImpl) Close() {
+	i.stop()
+}
+
+// GetAuthorsSeq returns a sequence of the resources of all the pages of getAuthors, for use with
+// range. Pages are fetched as the sequence is iterated, so breaking out of the loop stops fetching
+// them. The sequence ends with the first error, which it yields with a nil resource.
+func (c *WagClient) GetAuthorsSeq(ctx context.Context, i *models.GetAuthorsInput) iter.Seq2[*models.Author, error] {
+	return func(yield func(*models.Author, error) bool) {
+		for page, err := range c.GetAuthorsPages(ctx, i) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for j := range page {
+				if !yield(page[j], nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// GetAuthorsPages returns a sequence of the pages of getAuthors, for use with range. Pages are
+// fetched as the sequence is iterated. The sequence ends with the first error, which it yields with
+// a nil page.
+func (c *WagClient) GetAuthorsPages(ctx context.Context, i *models.GetAuthorsInput) iter.Seq2[[]*models.Author, error] {
+	return func(yield func([]*models.Author, error) bool) {
+		it, err := c.newGetAuthorsIter(ctx, i)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer it.stop()
+		for it.nextURL != "" {
+			if err := it.refresh(); err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(it.lastResponse, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (c *WagClient) doGetAuthorsRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (output *models.AuthorsResponse, nextPage string, err error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Canonical-Resource", "getAuthors")
 	req.Header.Set(VersionHeader, Version)
@@ -228,6 +420,11 @@ func (c *WagClient) doGetAuthorsRequest(ctx context.Context, req *http.Request,
 
 	// Add the opname for doers like tracing
 	ctx = context.WithValue(ctx, opNameCtx{}, "getAuthors")
+	operation := &Operation{Name: "getAuthors", Input: input}
+	defer func() {
+		operation.finish(output, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -333,7 +530,7 @@ func (c *WagClient) GetAuthorsWithPut(ctx context.Context, i *models.GetAuthorsW
 		return nil, err
 	}
 
-	resp, _, err := c.doGetAuthorsWithPutRequest(ctx, req, headers)
+	resp, _, err := c.doGetAuthorsWithPutRequest(ctx, req, headers, i)
 	return resp, err
 }
 
@@ -346,11 +543,47 @@ type getAuthorsWithPutIterImpl struct {
 	nextURL      string
 	headers      map[string]string
 	body         []byte
+	input        interface{}
+	// prefetch is the number of pages to fetch ahead. The pages are sent on pages, and cancel
+	// stops fetching them.
+	prefetch int
+	pages    chan getAuthorsWithPutPage
+	cancel   context.CancelFunc
+}
+
+// getAuthorsWithPutPage is a page of getAuthorsWithPut and the URL of the page after it.
+type getAuthorsWithPutPage struct {
+	resources []*models.Author
+	nextURL   string
+	err       error
 }
 
 // NewgetAuthorsWithPutIter constructs an iterator that makes calls to getAuthorsWithPut for
 // each page.
 func (c *WagClient) NewGetAuthorsWithPutIter(ctx context.Context, i *models.GetAuthorsWithPutInput) (GetAuthorsWithPutIter, error) {
+	it, err := c.newGetAuthorsWithPutIter(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// NewGetAuthorsWithPutIterFromCursor constructs an iterator that resumes iterating the pages of
+// getAuthorsWithPut from the Cursor of an iterator constructed with the same input. The iterator has no
+// pages if the cursor is empty.
+func (c *WagClient) NewGetAuthorsWithPutIterFromCursor(ctx context.Context, i *models.GetAuthorsWithPutInput, cursor string) (GetAuthorsWithPutIter, error) {
+	it, err := c.newGetAuthorsWithPutIter(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+	it.nextURL = ""
+	if cursor != "" {
+		it.nextURL = c.basePath + cursor
+	}
+	return it, nil
+}
+
+func (c *WagClient) newGetAuthorsWithPutIter(ctx context.Context, i *models.GetAuthorsWithPutInput) (*getAuthorsWithPutIterImpl, error) {
 	path, err := i.Path()
 
 	if err != nil {
@@ -381,33 +614,96 @@ func (c *WagClient) NewGetAuthorsWithPutIter(ctx context.Context, i *models.GetA
 		nextURL:      path,
 		headers:      headers,
 		body:         body,
+		input:        i,
+		prefetch:     c.pagePrefetch,
 	}, nil
 }
 
-func (i *getAuthorsWithPutIterImpl) refresh() error {
-	req, err := http.NewRequestWithContext(i.ctx, "PUT", i.nextURL, bytes.NewBuffer(i.body))
+// fetch fetches the page at url.
+func (i *getAuthorsWithPutIterImpl) fetch(ctx context.Context, url string) getAuthorsWithPutPage {
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(i.body))
 
 	if err != nil {
-		i.err = err
-		return err
+		return getAuthorsWithPutPage{err: err}
 	}
 
-	resp, nextPage, err := i.c.doGetAuthorsWithPutRequest(i.ctx, req, i.headers)
+	resp, nextPage, err := i.c.doGetAuthorsWithPutRequest(ctx, req, i.headers, i.input)
 	if err != nil {
-		i.err = err
-		return err
+		return getAuthorsWithPutPage{err: err}
 	}
 
-	i.lastResponse = resp.AuthorSet.Results
-	i.index = 0
+	page := getAuthorsWithPutPage{resources: resp.AuthorSet.Results}
 	if nextPage != "" {
-		i.nextURL = i.c.basePath + nextPage
+		page.nextURL = i.c.basePath + nextPage
+	}
+	return page
+}
+
+// startPrefetch starts fetching the pages after the current one ahead of the caller.
+func (i *getAuthorsWithPutIterImpl) startPrefetch() {
+	ctx, cancel := context.WithCancel(i.ctx)
+	i.cancel = cancel
+	// The page being fetched counts towards the depth
+	i.pages = make(chan getAuthorsWithPutPage, i.prefetch-1)
+	go func(url string) {
+		defer close(i.pages)
+		for url != "" {
+			page := i.fetch(ctx, url)
+			select {
+			case i.pages <- page:
+			case <-ctx.Done():
+				return
+			}
+			if page.err != nil {
+				return
+			}
+			url = page.nextURL
+		}
+	}(i.nextURL)
+}
+
+// stop stops fetching pages ahead of the caller.
+func (i *getAuthorsWithPutIterImpl) stop() {
+	if i.cancel != nil {
+		i.cancel()
+	}
+}
+
+func (i *getAuthorsWithPutIterImpl) refresh() error {
+	var page getAuthorsWithPutPage
+	if i.prefetch > 0 {
+		if i.pages == nil {
+			i.startPrefetch()
+		}
+		var ok bool
+		if page, ok = <-i.pages; !ok {
+			page.err = i.ctx.Err()
+			if page.err == nil {
+				page.err = context.Canceled
+			}
+		}
 	} else {
-		i.nextURL = ""
+		page = i.fetch(i.ctx, i.nextURL)
+	}
+	if page.err != nil {
+		i.err = page.err
+		return page.err
 	}
+
+	i.lastResponse = page.resources
+	i.index = 0
+	i.nextURL = page.nextURL
 	return nil
 }
 
+// Cursor returns the path of the next page the iterator fetches, relative to the client's base
+// path, i.e. the X-Next-Page-Path of the current page, or "" if there are no more pages. An
+// iterator from NewGetAuthorsWithPutIterFromCursor with the cursor resumes after the current page, so
+// save it after iterating all the resources of a page.
+func (i *getAuthorsWithPutIterImpl) Cursor() string {
+	return strings.TrimPrefix(i.nextURL, i.c.basePath)
+}
+
 // Next retrieves the next resource from the iterator and assigns it to the
 // provided pointer, fetching a new page if necessary. Returns true if it
 // successfully retrieves a new resource.
@@ -433,7 +729,55 @@ func (i *getAuthorsWithPutIterImpl) Err() error {
 	return i.err
 }
 
-func (c *WagClient) doGetAuthorsWithPutRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.AuthorsResponse, string, error) {
+// Close stops the iterator fetching pages ahead of the caller. Call it when an iterator isn't
+// iterated to the end.
+func (i *getAuthorsWithPutIterImpl) Close() {
+	i.stop()
+}
+
+// GetAuthorsWithPutSeq returns a sequence of the resources of all the pages of getAuthorsWithPut, for use with
+// range. Pages are fetched as the sequence is iterated, so breaking out of the loop stops fetching
+// them. The sequence ends with the first error, which it yields with a nil resource.
+func (c *WagClient) GetAuthorsWithPutSeq(ctx context.Context, i *models.GetAuthorsWithPutInput) iter.Seq2[*models.Author, error] {
+	return func(yield func(*models.Author, error) bool) {
+		for page, err := range c.GetAuthorsWithPutPages(ctx, i) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for j := range page {
+				if !yield(page[j], nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// GetAuthorsWithPutPages returns a sequence of the pages of getAuthorsWithPut, for use with range. Pages are
+// fetched as the sequence is iterated. The sequence ends with the first error, which it yields with
+// a nil page.
+func (c *WagClient) GetAuthorsWithPutPages(ctx context.Context, i *models.GetAuthorsWithPutInput) iter.Seq2[[]*models.Author, error] {
+	return func(yield func([]*models.Author, error) bool) {
+		it, err := c.newGetAuthorsWithPutIter(ctx, i)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer it.stop()
+		for it.nextURL != "" {
+			if err := it.refresh(); err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(it.lastResponse, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (c *WagClient) doGetAuthorsWithPutRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (output *models.AuthorsResponse, nextPage string, err error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Canonical-Resource", "getAuthorsWithPut")
 	req.Header.Set(VersionHeader, Version)
@@ -444,6 +788,11 @@ func (c *WagClient) doGetAuthorsWithPutRequest(ctx context.Context, req *http.Re
 
 	// Add the opname for doers like tracing
 	ctx = context.WithValue(ctx, opNameCtx{}, "getAuthorsWithPut")
+	operation := &Operation{Name: "getAuthorsWithPut", Input: input}
+	defer func() {
+		operation.finish(output, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -540,7 +889,7 @@ func (c *WagClient) GetBooks(ctx context.Context, i *models.GetBooksInput) ([]mo
 		return nil, err
 	}
 
-	resp, _, err := c.doGetBooksRequest(ctx, req, headers)
+	resp, _, err := c.doGetBooksRequest(ctx, req, headers, i)
 	return resp, err
 }
 
@@ -553,11 +902,47 @@ type getBooksIterImpl struct {
 	nextURL      string
 	headers      map[string]string
 	body         []byte
+	input        interface{}
+	// prefetch is the number of pages to fetch ahead. The pages are sent on pages, and cancel
+	// stops fetching them.
+	prefetch int
+	pages    chan getBooksPage
+	cancel   context.CancelFunc
+}
+
+// getBooksPage is a page of getBooks and the URL of the page after it.
+type getBooksPage struct {
+	resources []models.Book
+	nextURL   string
+	err       error
 }
 
 // NewgetBooksIter constructs an iterator that makes calls to getBooks for
 // each page.
 func (c *WagClient) NewGetBooksIter(ctx context.Context, i *models.GetBooksInput) (GetBooksIter, error) {
+	it, err := c.newGetBooksIter(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// NewGetBooksIterFromCursor constructs an iterator that resumes iterating the pages of
+// getBooks from the Cursor of an iterator constructed with the same input. The iterator has no
+// pages if the cursor is empty.
+func (c *WagClient) NewGetBooksIterFromCursor(ctx context.Context, i *models.GetBooksInput, cursor string) (GetBooksIter, error) {
+	it, err := c.newGetBooksIter(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+	it.nextURL = ""
+	if cursor != "" {
+		it.nextURL = c.basePath + cursor
+	}
+	return it, nil
+}
+
+func (c *WagClient) newGetBooksIter(ctx context.Context, i *models.GetBooksInput) (*getBooksIterImpl, error) {
 	path, err := i.Path()
 
 	if err != nil {
@@ -579,33 +964,96 @@ func (c *WagClient) NewGetBooksIter(ctx context.Context, i *models.GetBooksInput
 		nextURL:      path,
 		headers:      headers,
 		body:         body,
+		input:        i,
+		prefetch:     c.pagePrefetch,
 	}, nil
 }
 
-func (i *getBooksIterImpl) refresh() error {
-	req, err := http.NewRequestWithContext(i.ctx, "GET", i.nextURL, bytes.NewBuffer(i.body))
+// fetch fetches the page at url.
+func (i *getBooksIterImpl) fetch(ctx context.Context, url string) getBooksPage {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, bytes.NewBuffer(i.body))
 
 	if err != nil {
-		i.err = err
-		return err
+		return getBooksPage{err: err}
 	}
 
-	resp, nextPage, err := i.c.doGetBooksRequest(i.ctx, req, i.headers)
+	resp, nextPage, err := i.c.doGetBooksRequest(ctx, req, i.headers, i.input)
 	if err != nil {
-		i.err = err
-		return err
+		return getBooksPage{err: err}
 	}
 
-	i.lastResponse = resp
-	i.index = 0
+	page := getBooksPage{resources: resp}
 	if nextPage != "" {
-		i.nextURL = i.c.basePath + nextPage
+		page.nextURL = i.c.basePath + nextPage
+	}
+	return page
+}
+
+// startPrefetch starts fetching the pages after the current one ahead of the caller.
+func (i *getBooksIterImpl) startPrefetch() {
+	ctx, cancel := context.WithCancel(i.ctx)
+	i.cancel = cancel
+	// The page being fetched counts towards the depth
+	i.pages = make(chan getBooksPage, i.prefetch-1)
+	go func(url string) {
+		defer close(i.pages)
+		for url != "" {
+			page := i.fetch(ctx, url)
+			select {
+			case i.pages <- page:
+			case <-ctx.Done():
+				return
+			}
+			if page.err != nil {
+				return
+			}
+			url = page.nextURL
+		}
+	}(i.nextURL)
+}
+
+// stop stops fetching pages ahead of the caller.
+func (i *getBooksIterImpl) stop() {
+	if i.cancel != nil {
+		i.cancel()
+	}
+}
+
+func (i *getBooksIterImpl) refresh() error {
+	var page getBooksPage
+	if i.prefetch > 0 {
+		if i.pages == nil {
+			i.startPrefetch()
+		}
+		var ok bool
+		if page, ok = <-i.pages; !ok {
+			page.err = i.ctx.Err()
+			if page.err == nil {
+				page.err = context.Canceled
+			}
+		}
 	} else {
-		i.nextURL = ""
+		page = i.fetch(i.ctx, i.nextURL)
 	}
+	if page.err != nil {
+		i.err = page.err
+		return page.err
+	}
+
+	i.lastResponse = page.resources
+	i.index = 0
+	i.nextURL = page.nextURL
 	return nil
 }
 
+// Cursor returns the path of the next page the iterator fetches, relative to the client's base
+// path, i.e. the X-Next-Page-Path of the current page, or "" if there are no more pages. An
+// iterator from NewGetBooksIterFromCursor with the cursor resumes after the current page, so
+// save it after iterating all the resources of a page.
+func (i *getBooksIterImpl) Cursor() string {
+	return strings.TrimPrefix(i.nextURL, i.c.basePath)
+}
+
 // Next retrieves the next resource from the iterator and assigns it to the
 // provided pointer, fetching a new page if necessary. Returns true if it
 // successfully retrieves a new resource.
@@ -631,7 +1079,55 @@ func (i *getBooksIterImpl) Err() error {
 	return i.err
 }
 
-func (c *WagClient) doGetBooksRequest(ctx context.Context, req *http.Request, headers map[string]string) ([]models.Book, string, error) {
+// Close stops the iterator fetching pages ahead of the caller. Call it when an iterator isn't
+// iterated to the end.
+func (i *getBooksIterImpl) Close() {
+	i.stop()
+}
+
+// GetBooksSeq returns a sequence of the resources of all the pages of getBooks, for use with
+// range. Pages are fetched as the sequence is iterated, so breaking out of the loop stops fetching
+// them. The sequence ends with the first error, which it yields with a nil resource.
+func (c *WagClient) GetBooksSeq(ctx context.Context, i *models.GetBooksInput) iter.Seq2[*models.Book, error] {
+	return func(yield func(*models.Book, error) bool) {
+		for page, err := range c.GetBooksPages(ctx, i) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for j := range page {
+				if !yield(&page[j], nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// GetBooksPages returns a sequence of the pages of getBooks, for use with range. Pages are
+// fetched as the sequence is iterated. The sequence ends with the first error, which it yields with
+// a nil page.
+func (c *WagClient) GetBooksPages(ctx context.Context, i *models.GetBooksInput) iter.Seq2[[]models.Book, error] {
+	return func(yield func([]models.Book, error) bool) {
+		it, err := c.newGetBooksIter(ctx, i)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer it.stop()
+		for it.nextURL != "" {
+			if err := it.refresh(); err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(it.lastResponse, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (c *WagClient) doGetBooksRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (output []models.Book, nextPage string, err error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Canonical-Resource", "getBooks")
 	req.Header.Set(VersionHeader, Version)
@@ -642,6 +1138,11 @@ func (c *WagClient) doGetBooksRequest(ctx context.Context, req *http.Request, he
 
 	// Add the opname for doers like tracing
 	ctx = context.WithValue(ctx, opNameCtx{}, "getBooks")
+	operation := &Operation{Name: "getBooks", Input: input}
+	defer func() {
+		operation.finish(output, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -741,10 +1242,10 @@ func (c *WagClient) CreateBook(ctx context.Context, i *models.Book) (*models.Boo
 		return nil, err
 	}
 
-	return c.doCreateBookRequest(ctx, req, headers)
+	return c.doCreateBookRequest(ctx, req, headers, i)
 }
 
-func (c *WagClient) doCreateBookRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.Book, error) {
+func (c *WagClient) doCreateBookRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (output *models.Book, err error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Canonical-Resource", "createBook")
 	req.Header.Set(VersionHeader, Version)
@@ -755,6 +1256,11 @@ func (c *WagClient) doCreateBookRequest(ctx context.Context, req *http.Request,
 
 	// Add the opname for doers like tracing
 	ctx = context.WithValue(ctx, opNameCtx{}, "createBook")
+	operation := &Operation{Name: "createBook", Input: input}
+	defer func() {
+		operation.finish(output, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -854,10 +1360,10 @@ func (c *WagClient) PutBook(ctx context.Context, i *models.Book) (*models.Book,
 		return nil, err
 	}
 
-	return c.doPutBookRequest(ctx, req, headers)
+	return c.doPutBookRequest(ctx, req, headers, i)
 }
 
-func (c *WagClient) doPutBookRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.Book, error) {
+func (c *WagClient) doPutBookRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (output *models.Book, err error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Canonical-Resource", "putBook")
 	req.Header.Set(VersionHeader, Version)
@@ -868,6 +1374,11 @@ func (c *WagClient) doPutBookRequest(ctx context.Context, req *http.Request, hea
 
 	// Add the opname for doers like tracing
 	ctx = context.WithValue(ctx, opNameCtx{}, "putBook")
+	operation := &Operation{Name: "putBook", Input: input}
+	defer func() {
+		operation.finish(output, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -968,10 +1479,10 @@ func (c *WagClient) GetBookByID(ctx context.Context, i *models.GetBookByIDInput)
 		return nil, err
 	}
 
-	return c.doGetBookByIDRequest(ctx, req, headers)
+	return c.doGetBookByIDRequest(ctx, req, headers, i)
 }
 
-func (c *WagClient) doGetBookByIDRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.Book, error) {
+func (c *WagClient) doGetBookByIDRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (output *models.Book, err error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Canonical-Resource", "getBookByID")
 	req.Header.Set(VersionHeader, Version)
@@ -982,6 +1493,11 @@ func (c *WagClient) doGetBookByIDRequest(ctx context.Context, req *http.Request,
 
 	// Add the opname for doers like tracing
 	ctx = context.WithValue(ctx, opNameCtx{}, "getBookByID")
+	operation := &Operation{Name: "getBookByID", Input: input}
+	defer func() {
+		operation.finish(output, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1093,10 +1609,10 @@ func (c *WagClient) GetBookByID2(ctx context.Context, id string) (*models.Book,
 		return nil, err
 	}
 
-	return c.doGetBookByID2Request(ctx, req, headers)
+	return c.doGetBookByID2Request(ctx, req, headers, id)
 }
 
-func (c *WagClient) doGetBookByID2Request(ctx context.Context, req *http.Request, headers map[string]string) (*models.Book, error) {
+func (c *WagClient) doGetBookByID2Request(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (output *models.Book, err error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Canonical-Resource", "getBookByID2")
 	req.Header.Set(VersionHeader, Version)
@@ -1107,6 +1623,11 @@ func (c *WagClient) doGetBookByID2Request(ctx context.Context, req *http.Request
 
 	// Add the opname for doers like tracing
 	ctx = context.WithValue(ctx, opNameCtx{}, "getBookByID2")
+	operation := &Operation{Name: "getBookByID2", Input: input}
+	defer func() {
+		operation.finish(output, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1203,10 +1724,10 @@ func (c *WagClient) HealthCheck(ctx context.Context) error {
 		return err
 	}
 
-	return c.doHealthCheckRequest(ctx, req, headers)
+	return c.doHealthCheckRequest(ctx, req, headers, nil)
 }
 
-func (c *WagClient) doHealthCheckRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
+func (c *WagClient) doHealthCheckRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (err error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Canonical-Resource", "healthCheck")
 	req.Header.Set(VersionHeader, Version)
@@ -1217,6 +1738,11 @@ func (c *WagClient) doHealthCheckRequest(ctx context.Context, req *http.Request,
 
 	// Add the opname for doers like tracing
 	ctx = context.WithValue(ctx, opNameCtx{}, "healthCheck")
+	operation := &Operation{Name: "healthCheck", Input: input}
+	defer func() {
+		operation.finish(nil, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1317,10 +1843,10 @@ func (c *WagClient) LowercaseModelsTest(ctx context.Context, i *models.Lowercase
 		return err
 	}
 
-	return c.doLowercaseModelsTestRequest(ctx, req, headers)
+	return c.doLowercaseModelsTestRequest(ctx, req, headers, i)
 }
 
-func (c *WagClient) doLowercaseModelsTestRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
+func (c *WagClient) doLowercaseModelsTestRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (err error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Canonical-Resource", "lowercaseModelsTest")
 	req.Header.Set(VersionHeader, Version)
@@ -1331,6 +1857,11 @@ func (c *WagClient) doLowercaseModelsTestRequest(ctx context.Context, req *http.
 
 	// Add the opname for doers like tracing
 	ctx = context.WithValue(ctx, opNameCtx{}, "lowercaseModelsTest")
+	operation := &Operation{Name: "lowercaseModelsTest", Input: input}
+	defer func() {
+		operation.finish(nil, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1399,3 +1930,27 @@ func (c *WagClient) doLowercaseModelsTestRequest(ctx context.Context, req *http.
 func shortHash(s string) string {
 	return fmt.Sprintf("%x", md5.Sum([]byte(s)))[0:6]
 }
+
+// All returns all the resources in a sequence of the resources of a paged operation, e.g. from
+// GetThingsSeq, or the resources before the first error and the error.
+func All[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	return Collect(seq, 0)
+}
+
+// Collect returns the first limit resources in a sequence of the resources of a paged operation,
+// e.g. from GetThingsSeq, or all of them if limit isn't positive. It stops iterating the sequence,
+// and so fetching pages, once it has limit resources. If the sequence yields an error, it returns
+// the resources before it and the error.
+func Collect[T any](seq iter.Seq2[T, error], limit int) ([]T, error) {
+	var resources []T
+	for resource, err := range seq {
+		if err != nil {
+			return resources, err
+		}
+		resources = append(resources, resource)
+		if limit > 0 && len(resources) >= limit {
+			break
+		}
+	}
+	return resources, nil
+}
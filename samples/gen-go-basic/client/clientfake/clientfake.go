@@ -0,0 +1,988 @@
+// Package clientfake has an in-memory implementation of the swagger-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-basic/client/v9"
+	"github.com/Clever/wag/samples/gen-go-basic/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	getAuthorsStub     func(ctx context.Context, i *models.GetAuthorsInput) (*models.AuthorsResponse, error)
+	getAuthorsPageStub func(ctx context.Context, i *models.GetAuthorsInput, page int) (*models.AuthorsResponse, bool, error)
+	getAuthorsQueue    []getAuthorsResult
+	getAuthorsCalls    []GetAuthorsCall
+
+	getAuthorsWithPutStub     func(ctx context.Context, i *models.GetAuthorsWithPutInput) (*models.AuthorsResponse, error)
+	getAuthorsWithPutPageStub func(ctx context.Context, i *models.GetAuthorsWithPutInput, page int) (*models.AuthorsResponse, bool, error)
+	getAuthorsWithPutQueue    []getAuthorsWithPutResult
+	getAuthorsWithPutCalls    []GetAuthorsWithPutCall
+
+	getBooksStub     func(ctx context.Context, i *models.GetBooksInput) ([]models.Book, error)
+	getBooksPageStub func(ctx context.Context, i *models.GetBooksInput, page int) ([]models.Book, bool, error)
+	getBooksQueue    []getBooksResult
+	getBooksCalls    []GetBooksCall
+
+	createBookStub  func(ctx context.Context, i *models.Book) (*models.Book, error)
+	createBookQueue []createBookResult
+	createBookCalls []CreateBookCall
+
+	putBookStub  func(ctx context.Context, i *models.Book) (*models.Book, error)
+	putBookQueue []putBookResult
+	putBookCalls []PutBookCall
+
+	getBookByIDStub  func(ctx context.Context, i *models.GetBookByIDInput) (*models.Book, error)
+	getBookByIDQueue []getBookByIDResult
+	getBookByIDCalls []GetBookByIDCall
+
+	getBookByID2Stub  func(ctx context.Context, id string) (*models.Book, error)
+	getBookByID2Queue []getBookByID2Result
+	getBookByID2Calls []GetBookByID2Call
+
+	healthCheckStub  func(ctx context.Context) error
+	healthCheckQueue []healthCheckResult
+	healthCheckCalls []HealthCheckCall
+
+	lowercaseModelsTestStub  func(ctx context.Context, i *models.LowercaseModelsTestInput) error
+	lowercaseModelsTestQueue []lowercaseModelsTestResult
+	lowercaseModelsTestCalls []LowercaseModelsTestCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// GetAuthorsCall records a call to GetAuthors.
+type GetAuthorsCall struct {
+	Ctx   context.Context
+	Input *models.GetAuthorsInput
+}
+
+type getAuthorsResult struct {
+	resp *models.AuthorsResponse
+	err  error
+}
+
+// StubGetAuthors sets the function that answers calls to GetAuthors once its queued responses
+// are used up.
+func (f *Fake) StubGetAuthors(stub func(ctx context.Context, i *models.GetAuthorsInput) (*models.AuthorsResponse, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAuthorsStub = stub
+}
+
+// QueueGetAuthors adds a response for a call to GetAuthors.
+func (f *Fake) QueueGetAuthors(resp *models.AuthorsResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAuthorsQueue = append(f.getAuthorsQueue, getAuthorsResult{resp: resp, err: err})
+}
+
+// GetAuthorsCalls returns the calls made to GetAuthors.
+func (f *Fake) GetAuthorsCalls() []GetAuthorsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetAuthorsCall{}, f.getAuthorsCalls...)
+}
+
+// GetAuthors returns the next queued response or calls the stub.
+func (f *Fake) GetAuthors(ctx context.Context, i *models.GetAuthorsInput) (*models.AuthorsResponse, error) {
+	f.mu.Lock()
+	f.getAuthorsCalls = append(f.getAuthorsCalls, GetAuthorsCall{Ctx: ctx, Input: i})
+	if len(f.getAuthorsQueue) > 0 {
+		result := f.getAuthorsQueue[0]
+		f.getAuthorsQueue = f.getAuthorsQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getAuthorsStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.AuthorsResponse
+	return resp, notStubbed("GetAuthors")
+}
+
+// StubGetAuthorsPage sets the function that answers the calls iterators make for the pages of
+// GetAuthors once its queued responses are used up. It's passed the number of the page, starting
+// at 1, and returns whether there are pages after it. It takes precedence over the stub set with
+// StubGetAuthors for iterators.
+func (f *Fake) StubGetAuthorsPage(stub func(ctx context.Context, i *models.GetAuthorsInput, page int) (*models.AuthorsResponse, bool, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAuthorsPageStub = stub
+}
+
+// hasGetAuthorsResponse returns true if a call to GetAuthors has a queued response or a stub.
+func (f *Fake) hasGetAuthorsResponse() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAuthorsQueue) > 0 || f.getAuthorsStub != nil || f.getAuthorsPageStub != nil
+}
+
+// getAuthorsPage returns a page of GetAuthors for an iterator and whether there may be pages after
+// it. Queued responses and the stub set with StubGetAuthors don't know about pages, so pages
+// are assumed to follow them.
+func (f *Fake) getAuthorsPage(ctx context.Context, i *models.GetAuthorsInput, page int) (*models.AuthorsResponse, bool, error) {
+	f.mu.Lock()
+	stub := f.getAuthorsPageStub
+	if len(f.getAuthorsQueue) > 0 || stub == nil {
+		f.mu.Unlock()
+		resp, err := f.GetAuthors(ctx, i)
+		return resp, true, err
+	}
+	f.getAuthorsCalls = append(f.getAuthorsCalls, GetAuthorsCall{Ctx: ctx, Input: i})
+	f.mu.Unlock()
+	return stub(ctx, i, page)
+}
+
+// NewGetAuthorsIter returns an iterator that calls GetAuthors for each page, so pages are queued
+// or stubbed like responses of GetAuthors, or with StubGetAuthorsPage. The iterator stops after
+// an empty page, a page the page stub says is the last, or the last queued page if there's no stub.
+func (f *Fake) NewGetAuthorsIter(ctx context.Context, i *models.GetAuthorsInput) (client.GetAuthorsIter, error) {
+	return &getAuthorsIter{f: f, ctx: ctx, input: i}, nil
+}
+
+// NewGetAuthorsIterFromCursor returns an iterator like NewGetAuthorsIter that starts at the page
+// number in the cursor, or has no pages if the cursor is empty. The page number is passed to the
+// stub set with StubGetAuthorsPage; queued responses are returned in order whatever the cursor.
+func (f *Fake) NewGetAuthorsIterFromCursor(ctx context.Context, i *models.GetAuthorsInput, cursor string) (client.GetAuthorsIter, error) {
+	if cursor == "" {
+		return &getAuthorsIter{f: f, ctx: ctx, input: i, done: true}, nil
+	}
+	page, err := strconv.Atoi(cursor)
+	if err != nil || page < 1 {
+		return nil, fmt.Errorf("clientfake: invalid cursor %q for GetAuthors", cursor)
+	}
+	return &getAuthorsIter{f: f, ctx: ctx, input: i, pages: page - 1}, nil
+}
+
+type getAuthorsIter struct {
+	f     *Fake
+	ctx   context.Context
+	input *models.GetAuthorsInput
+	page  []*models.Author
+	index int
+	// pages is the number of the last page fetched.
+	pages   int
+	fetched bool
+	done    bool
+	err     error
+}
+
+// nextPage fetches the next page. Returns false if there are no more pages or there was an error.
+func (i *getAuthorsIter) nextPage() bool {
+	if i.done || i.err != nil || (i.fetched && !i.f.hasGetAuthorsResponse()) {
+		return false
+	}
+	resp, more, err := i.f.getAuthorsPage(i.ctx, i.input, i.pages+1)
+	if err != nil {
+		i.err = err
+		return false
+	}
+	i.page = resp.AuthorSet.Results
+	i.index = 0
+	i.pages++
+	i.fetched = true
+	i.done = len(i.page) == 0 || !more
+	return true
+}
+
+// Next assigns the next resource to v, fetching a new page if necessary. Returns true if there
+// was a resource.
+func (i *getAuthorsIter) Next(v *models.Author) bool {
+	for i.index >= len(i.page) {
+		if !i.nextPage() {
+			return false
+		}
+	}
+	*v = *i.page[i.index]
+	i.index++
+	return true
+}
+
+// Err returns the error returned for a page, if any.
+func (i *getAuthorsIter) Err() error {
+	return i.err
+}
+
+// Cursor returns "" if the iterator has no more pages, or else the number of the next page.
+func (i *getAuthorsIter) Cursor() string {
+	if i.done || (i.fetched && !i.f.hasGetAuthorsResponse()) {
+		return ""
+	}
+	return fmt.Sprint(i.pages + 1)
+}
+
+// Close does nothing, since the fake doesn't fetch pages ahead of the caller.
+func (i *getAuthorsIter) Close() {}
+
+// GetAuthorsSeq returns a sequence of the resources of the pages of GetAuthors, which are
+// fetched like the pages of NewGetAuthorsIter.
+func (f *Fake) GetAuthorsSeq(ctx context.Context, i *models.GetAuthorsInput) iter.Seq2[*models.Author, error] {
+	return func(yield func(*models.Author, error) bool) {
+		for page, err := range f.GetAuthorsPages(ctx, i) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for j := range page {
+				if !yield(page[j], nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// GetAuthorsPages returns a sequence of the pages of GetAuthors, which are fetched like the
+// pages of NewGetAuthorsIter.
+func (f *Fake) GetAuthorsPages(ctx context.Context, i *models.GetAuthorsInput) iter.Seq2[[]*models.Author, error] {
+	return func(yield func([]*models.Author, error) bool) {
+		it := &getAuthorsIter{f: f, ctx: ctx, input: i}
+		for it.nextPage() {
+			if !yield(it.page, nil) {
+				return
+			}
+		}
+		if it.err != nil {
+			yield(nil, it.err)
+		}
+	}
+}
+
+// GetAuthorsWithPutCall records a call to GetAuthorsWithPut.
+type GetAuthorsWithPutCall struct {
+	Ctx   context.Context
+	Input *models.GetAuthorsWithPutInput
+}
+
+type getAuthorsWithPutResult struct {
+	resp *models.AuthorsResponse
+	err  error
+}
+
+// StubGetAuthorsWithPut sets the function that answers calls to GetAuthorsWithPut once its queued responses
+// are used up.
+func (f *Fake) StubGetAuthorsWithPut(stub func(ctx context.Context, i *models.GetAuthorsWithPutInput) (*models.AuthorsResponse, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAuthorsWithPutStub = stub
+}
+
+// QueueGetAuthorsWithPut adds a response for a call to GetAuthorsWithPut.
+func (f *Fake) QueueGetAuthorsWithPut(resp *models.AuthorsResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAuthorsWithPutQueue = append(f.getAuthorsWithPutQueue, getAuthorsWithPutResult{resp: resp, err: err})
+}
+
+// GetAuthorsWithPutCalls returns the calls made to GetAuthorsWithPut.
+func (f *Fake) GetAuthorsWithPutCalls() []GetAuthorsWithPutCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetAuthorsWithPutCall{}, f.getAuthorsWithPutCalls...)
+}
+
+// GetAuthorsWithPut returns the next queued response or calls the stub.
+func (f *Fake) GetAuthorsWithPut(ctx context.Context, i *models.GetAuthorsWithPutInput) (*models.AuthorsResponse, error) {
+	f.mu.Lock()
+	f.getAuthorsWithPutCalls = append(f.getAuthorsWithPutCalls, GetAuthorsWithPutCall{Ctx: ctx, Input: i})
+	if len(f.getAuthorsWithPutQueue) > 0 {
+		result := f.getAuthorsWithPutQueue[0]
+		f.getAuthorsWithPutQueue = f.getAuthorsWithPutQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getAuthorsWithPutStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.AuthorsResponse
+	return resp, notStubbed("GetAuthorsWithPut")
+}
+
+// StubGetAuthorsWithPutPage sets the function that answers the calls iterators make for the pages of
+// GetAuthorsWithPut once its queued responses are used up. It's passed the number of the page, starting
+// at 1, and returns whether there are pages after it. It takes precedence over the stub set with
+// StubGetAuthorsWithPut for iterators.
+func (f *Fake) StubGetAuthorsWithPutPage(stub func(ctx context.Context, i *models.GetAuthorsWithPutInput, page int) (*models.AuthorsResponse, bool, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getAuthorsWithPutPageStub = stub
+}
+
+// hasGetAuthorsWithPutResponse returns true if a call to GetAuthorsWithPut has a queued response or a stub.
+func (f *Fake) hasGetAuthorsWithPutResponse() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAuthorsWithPutQueue) > 0 || f.getAuthorsWithPutStub != nil || f.getAuthorsWithPutPageStub != nil
+}
+
+// getAuthorsWithPutPage returns a page of GetAuthorsWithPut for an iterator and whether there may be pages after
+// it. Queued responses and the stub set with StubGetAuthorsWithPut don't know about pages, so pages
+// are assumed to follow them.
+func (f *Fake) getAuthorsWithPutPage(ctx context.Context, i *models.GetAuthorsWithPutInput, page int) (*models.AuthorsResponse, bool, error) {
+	f.mu.Lock()
+	stub := f.getAuthorsWithPutPageStub
+	if len(f.getAuthorsWithPutQueue) > 0 || stub == nil {
+		f.mu.Unlock()
+		resp, err := f.GetAuthorsWithPut(ctx, i)
+		return resp, true, err
+	}
+	f.getAuthorsWithPutCalls = append(f.getAuthorsWithPutCalls, GetAuthorsWithPutCall{Ctx: ctx, Input: i})
+	f.mu.Unlock()
+	return stub(ctx, i, page)
+}
+
+// NewGetAuthorsWithPutIter returns an iterator that calls GetAuthorsWithPut for each page, so pages are queued
+// or stubbed like responses of GetAuthorsWithPut, or with StubGetAuthorsWithPutPage. The iterator stops after
+// an empty page, a page the page stub says is the last, or the last queued page if there's no stub.
+func (f *Fake) NewGetAuthorsWithPutIter(ctx context.Context, i *models.GetAuthorsWithPutInput) (client.GetAuthorsWithPutIter, error) {
+	return &getAuthorsWithPutIter{f: f, ctx: ctx, input: i}, nil
+}
+
+// NewGetAuthorsWithPutIterFromCursor returns an iterator like NewGetAuthorsWithPutIter that starts at the page
+// number in the cursor, or has no pages if the cursor is empty. The page number is passed to the
+// stub set with StubGetAuthorsWithPutPage; queued responses are returned in order whatever the cursor.
+func (f *Fake) NewGetAuthorsWithPutIterFromCursor(ctx context.Context, i *models.GetAuthorsWithPutInput, cursor string) (client.GetAuthorsWithPutIter, error) {
+	if cursor == "" {
+		return &getAuthorsWithPutIter{f: f, ctx: ctx, input: i, done: true}, nil
+	}
+	page, err := strconv.Atoi(cursor)
+	if err != nil || page < 1 {
+		return nil, fmt.Errorf("clientfake: invalid cursor %q for GetAuthorsWithPut", cursor)
+	}
+	return &getAuthorsWithPutIter{f: f, ctx: ctx, input: i, pages: page - 1}, nil
+}
+
+type getAuthorsWithPutIter struct {
+	f     *Fake
+	ctx   context.Context
+	input *models.GetAuthorsWithPutInput
+	page  []*models.Author
+	index int
+	// pages is the number of the last page fetched.
+	pages   int
+	fetched bool
+	done    bool
+	err     error
+}
+
+// nextPage fetches the next page. Returns false if there are no more pages or there was an error.
+func (i *getAuthorsWithPutIter) nextPage() bool {
+	if i.done || i.err != nil || (i.fetched && !i.f.hasGetAuthorsWithPutResponse()) {
+		return false
+	}
+	resp, more, err := i.f.getAuthorsWithPutPage(i.ctx, i.input, i.pages+1)
+	if err != nil {
+		i.err = err
+		return false
+	}
+	i.page = resp.AuthorSet.Results
+	i.index = 0
+	i.pages++
+	i.fetched = true
+	i.done = len(i.page) == 0 || !more
+	return true
+}
+
+// Next assigns the next resource to v, fetching a new page if necessary. Returns true if there
+// was a resource.
+func (i *getAuthorsWithPutIter) Next(v *models.Author) bool {
+	for i.index >= len(i.page) {
+		if !i.nextPage() {
+			return false
+		}
+	}
+	*v = *i.page[i.index]
+	i.index++
+	return true
+}
+
+// Err returns the error returned for a page, if any.
+func (i *getAuthorsWithPutIter) Err() error {
+	return i.err
+}
+
+// Cursor returns "" if the iterator has no more pages, or else the number of the next page.
+func (i *getAuthorsWithPutIter) Cursor() string {
+	if i.done || (i.fetched && !i.f.hasGetAuthorsWithPutResponse()) {
+		return ""
+	}
+	return fmt.Sprint(i.pages + 1)
+}
+
+// Close does nothing, since the fake doesn't fetch pages ahead of the caller.
+func (i *getAuthorsWithPutIter) Close() {}
+
+// GetAuthorsWithPutSeq returns a sequence of the resources of the pages of GetAuthorsWithPut, which are
+// fetched like the pages of NewGetAuthorsWithPutIter.
+func (f *Fake) GetAuthorsWithPutSeq(ctx context.Context, i *models.GetAuthorsWithPutInput) iter.Seq2[*models.Author, error] {
+	return func(yield func(*models.Author, error) bool) {
+		for page, err := range f.GetAuthorsWithPutPages(ctx, i) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for j := range page {
+				if !yield(page[j], nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// GetAuthorsWithPutPages returns a sequence of the pages of GetAuthorsWithPut, which are fetched like the
+// pages of NewGetAuthorsWithPutIter.
+func (f *Fake) GetAuthorsWithPutPages(ctx context.Context, i *models.GetAuthorsWithPutInput) iter.Seq2[[]*models.Author, error] {
+	return func(yield func([]*models.Author, error) bool) {
+		it := &getAuthorsWithPutIter{f: f, ctx: ctx, input: i}
+		for it.nextPage() {
+			if !yield(it.page, nil) {
+				return
+			}
+		}
+		if it.err != nil {
+			yield(nil, it.err)
+		}
+	}
+}
+
+// GetBooksCall records a call to GetBooks.
+type GetBooksCall struct {
+	Ctx   context.Context
+	Input *models.GetBooksInput
+}
+
+type getBooksResult struct {
+	resp []models.Book
+	err  error
+}
+
+// StubGetBooks sets the function that answers calls to GetBooks once its queued responses
+// are used up.
+func (f *Fake) StubGetBooks(stub func(ctx context.Context, i *models.GetBooksInput) ([]models.Book, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBooksStub = stub
+}
+
+// QueueGetBooks adds a response for a call to GetBooks.
+func (f *Fake) QueueGetBooks(resp []models.Book, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBooksQueue = append(f.getBooksQueue, getBooksResult{resp: resp, err: err})
+}
+
+// GetBooksCalls returns the calls made to GetBooks.
+func (f *Fake) GetBooksCalls() []GetBooksCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetBooksCall{}, f.getBooksCalls...)
+}
+
+// GetBooks returns the next queued response or calls the stub.
+func (f *Fake) GetBooks(ctx context.Context, i *models.GetBooksInput) ([]models.Book, error) {
+	f.mu.Lock()
+	f.getBooksCalls = append(f.getBooksCalls, GetBooksCall{Ctx: ctx, Input: i})
+	if len(f.getBooksQueue) > 0 {
+		result := f.getBooksQueue[0]
+		f.getBooksQueue = f.getBooksQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getBooksStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp []models.Book
+	return resp, notStubbed("GetBooks")
+}
+
+// StubGetBooksPage sets the function that answers the calls iterators make for the pages of
+// GetBooks once its queued responses are used up. It's passed the number of the page, starting
+// at 1, and returns whether there are pages after it. It takes precedence over the stub set with
+// StubGetBooks for iterators.
+func (f *Fake) StubGetBooksPage(stub func(ctx context.Context, i *models.GetBooksInput, page int) ([]models.Book, bool, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBooksPageStub = stub
+}
+
+// hasGetBooksResponse returns true if a call to GetBooks has a queued response or a stub.
+func (f *Fake) hasGetBooksResponse() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getBooksQueue) > 0 || f.getBooksStub != nil || f.getBooksPageStub != nil
+}
+
+// getBooksPage returns a page of GetBooks for an iterator and whether there may be pages after
+// it. Queued responses and the stub set with StubGetBooks don't know about pages, so pages
+// are assumed to follow them.
+func (f *Fake) getBooksPage(ctx context.Context, i *models.GetBooksInput, page int) ([]models.Book, bool, error) {
+	f.mu.Lock()
+	stub := f.getBooksPageStub
+	if len(f.getBooksQueue) > 0 || stub == nil {
+		f.mu.Unlock()
+		resp, err := f.GetBooks(ctx, i)
+		return resp, true, err
+	}
+	f.getBooksCalls = append(f.getBooksCalls, GetBooksCall{Ctx: ctx, Input: i})
+	f.mu.Unlock()
+	return stub(ctx, i, page)
+}
+
+// NewGetBooksIter returns an iterator that calls GetBooks for each page, so pages are queued
+// or stubbed like responses of GetBooks, or with StubGetBooksPage. The iterator stops after
+// an empty page, a page the page stub says is the last, or the last queued page if there's no stub.
+func (f *Fake) NewGetBooksIter(ctx context.Context, i *models.GetBooksInput) (client.GetBooksIter, error) {
+	return &getBooksIter{f: f, ctx: ctx, input: i}, nil
+}
+
+// NewGetBooksIterFromCursor returns an iterator like NewGetBooksIter that starts at the page
+// number in the cursor, or has no pages if the cursor is empty. The page number is passed to the
+// stub set with StubGetBooksPage; queued responses are returned in order whatever the cursor.
+func (f *Fake) NewGetBooksIterFromCursor(ctx context.Context, i *models.GetBooksInput, cursor string) (client.GetBooksIter, error) {
+	if cursor == "" {
+		return &getBooksIter{f: f, ctx: ctx, input: i, done: true}, nil
+	}
+	page, err := strconv.Atoi(cursor)
+	if err != nil || page < 1 {
+		return nil, fmt.Errorf("clientfake: invalid cursor %q for GetBooks", cursor)
+	}
+	return &getBooksIter{f: f, ctx: ctx, input: i, pages: page - 1}, nil
+}
+
+type getBooksIter struct {
+	f     *Fake
+	ctx   context.Context
+	input *models.GetBooksInput
+	page  []models.Book
+	index int
+	// pages is the number of the last page fetched.
+	pages   int
+	fetched bool
+	done    bool
+	err     error
+}
+
+// nextPage fetches the next page. Returns false if there are no more pages or there was an error.
+func (i *getBooksIter) nextPage() bool {
+	if i.done || i.err != nil || (i.fetched && !i.f.hasGetBooksResponse()) {
+		return false
+	}
+	resp, more, err := i.f.getBooksPage(i.ctx, i.input, i.pages+1)
+	if err != nil {
+		i.err = err
+		return false
+	}
+	i.page = resp
+	i.index = 0
+	i.pages++
+	i.fetched = true
+	i.done = len(i.page) == 0 || !more
+	return true
+}
+
+// Next assigns the next resource to v, fetching a new page if necessary. Returns true if there
+// was a resource.
+func (i *getBooksIter) Next(v *models.Book) bool {
+	for i.index >= len(i.page) {
+		if !i.nextPage() {
+			return false
+		}
+	}
+	*v = i.page[i.index]
+	i.index++
+	return true
+}
+
+// Err returns the error returned for a page, if any.
+func (i *getBooksIter) Err() error {
+	return i.err
+}
+
+// Cursor returns "" if the iterator has no more pages, or else the number of the next page.
+func (i *getBooksIter) Cursor() string {
+	if i.done || (i.fetched && !i.f.hasGetBooksResponse()) {
+		return ""
+	}
+	return fmt.Sprint(i.pages + 1)
+}
+
+// Close does nothing, since the fake doesn't fetch pages ahead of the caller.
+func (i *getBooksIter) Close() {}
+
+// GetBooksSeq returns a sequence of the resources of the pages of GetBooks, which are
+// fetched like the pages of NewGetBooksIter.
+func (f *Fake) GetBooksSeq(ctx context.Context, i *models.GetBooksInput) iter.Seq2[*models.Book, error] {
+	return func(yield func(*models.Book, error) bool) {
+		for page, err := range f.GetBooksPages(ctx, i) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for j := range page {
+				if !yield(&page[j], nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// GetBooksPages returns a sequence of the pages of GetBooks, which are fetched like the
+// pages of NewGetBooksIter.
+func (f *Fake) GetBooksPages(ctx context.Context, i *models.GetBooksInput) iter.Seq2[[]models.Book, error] {
+	return func(yield func([]models.Book, error) bool) {
+		it := &getBooksIter{f: f, ctx: ctx, input: i}
+		for it.nextPage() {
+			if !yield(it.page, nil) {
+				return
+			}
+		}
+		if it.err != nil {
+			yield(nil, it.err)
+		}
+	}
+}
+
+// CreateBookCall records a call to CreateBook.
+type CreateBookCall struct {
+	Ctx   context.Context
+	Input *models.Book
+}
+
+type createBookResult struct {
+	resp *models.Book
+	err  error
+}
+
+// StubCreateBook sets the function that answers calls to CreateBook once its queued responses
+// are used up.
+func (f *Fake) StubCreateBook(stub func(ctx context.Context, i *models.Book) (*models.Book, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createBookStub = stub
+}
+
+// QueueCreateBook adds a response for a call to CreateBook.
+func (f *Fake) QueueCreateBook(resp *models.Book, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createBookQueue = append(f.createBookQueue, createBookResult{resp: resp, err: err})
+}
+
+// CreateBookCalls returns the calls made to CreateBook.
+func (f *Fake) CreateBookCalls() []CreateBookCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]CreateBookCall{}, f.createBookCalls...)
+}
+
+// CreateBook returns the next queued response or calls the stub.
+func (f *Fake) CreateBook(ctx context.Context, i *models.Book) (*models.Book, error) {
+	f.mu.Lock()
+	f.createBookCalls = append(f.createBookCalls, CreateBookCall{Ctx: ctx, Input: i})
+	if len(f.createBookQueue) > 0 {
+		result := f.createBookQueue[0]
+		f.createBookQueue = f.createBookQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.createBookStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.Book
+	return resp, notStubbed("CreateBook")
+}
+
+// PutBookCall records a call to PutBook.
+type PutBookCall struct {
+	Ctx   context.Context
+	Input *models.Book
+}
+
+type putBookResult struct {
+	resp *models.Book
+	err  error
+}
+
+// StubPutBook sets the function that answers calls to PutBook once its queued responses
+// are used up.
+func (f *Fake) StubPutBook(stub func(ctx context.Context, i *models.Book) (*models.Book, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.putBookStub = stub
+}
+
+// QueuePutBook adds a response for a call to PutBook.
+func (f *Fake) QueuePutBook(resp *models.Book, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.putBookQueue = append(f.putBookQueue, putBookResult{resp: resp, err: err})
+}
+
+// PutBookCalls returns the calls made to PutBook.
+func (f *Fake) PutBookCalls() []PutBookCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]PutBookCall{}, f.putBookCalls...)
+}
+
+// PutBook returns the next queued response or calls the stub.
+func (f *Fake) PutBook(ctx context.Context, i *models.Book) (*models.Book, error) {
+	f.mu.Lock()
+	f.putBookCalls = append(f.putBookCalls, PutBookCall{Ctx: ctx, Input: i})
+	if len(f.putBookQueue) > 0 {
+		result := f.putBookQueue[0]
+		f.putBookQueue = f.putBookQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.putBookStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.Book
+	return resp, notStubbed("PutBook")
+}
+
+// GetBookByIDCall records a call to GetBookByID.
+type GetBookByIDCall struct {
+	Ctx   context.Context
+	Input *models.GetBookByIDInput
+}
+
+type getBookByIDResult struct {
+	resp *models.Book
+	err  error
+}
+
+// StubGetBookByID sets the function that answers calls to GetBookByID once its queued responses
+// are used up.
+func (f *Fake) StubGetBookByID(stub func(ctx context.Context, i *models.GetBookByIDInput) (*models.Book, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBookByIDStub = stub
+}
+
+// QueueGetBookByID adds a response for a call to GetBookByID.
+func (f *Fake) QueueGetBookByID(resp *models.Book, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBookByIDQueue = append(f.getBookByIDQueue, getBookByIDResult{resp: resp, err: err})
+}
+
+// GetBookByIDCalls returns the calls made to GetBookByID.
+func (f *Fake) GetBookByIDCalls() []GetBookByIDCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetBookByIDCall{}, f.getBookByIDCalls...)
+}
+
+// GetBookByID returns the next queued response or calls the stub.
+func (f *Fake) GetBookByID(ctx context.Context, i *models.GetBookByIDInput) (*models.Book, error) {
+	f.mu.Lock()
+	f.getBookByIDCalls = append(f.getBookByIDCalls, GetBookByIDCall{Ctx: ctx, Input: i})
+	if len(f.getBookByIDQueue) > 0 {
+		result := f.getBookByIDQueue[0]
+		f.getBookByIDQueue = f.getBookByIDQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getBookByIDStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.Book
+	return resp, notStubbed("GetBookByID")
+}
+
+// GetBookByID2Call records a call to GetBookByID2.
+type GetBookByID2Call struct {
+	Ctx   context.Context
+	Input string
+}
+
+type getBookByID2Result struct {
+	resp *models.Book
+	err  error
+}
+
+// StubGetBookByID2 sets the function that answers calls to GetBookByID2 once its queued responses
+// are used up.
+func (f *Fake) StubGetBookByID2(stub func(ctx context.Context, id string) (*models.Book, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBookByID2Stub = stub
+}
+
+// QueueGetBookByID2 adds a response for a call to GetBookByID2.
+func (f *Fake) QueueGetBookByID2(resp *models.Book, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBookByID2Queue = append(f.getBookByID2Queue, getBookByID2Result{resp: resp, err: err})
+}
+
+// GetBookByID2Calls returns the calls made to GetBookByID2.
+func (f *Fake) GetBookByID2Calls() []GetBookByID2Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetBookByID2Call{}, f.getBookByID2Calls...)
+}
+
+// GetBookByID2 returns the next queued response or calls the stub.
+func (f *Fake) GetBookByID2(ctx context.Context, id string) (*models.Book, error) {
+	f.mu.Lock()
+	f.getBookByID2Calls = append(f.getBookByID2Calls, GetBookByID2Call{Ctx: ctx, Input: id})
+	if len(f.getBookByID2Queue) > 0 {
+		result := f.getBookByID2Queue[0]
+		f.getBookByID2Queue = f.getBookByID2Queue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getBookByID2Stub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	var resp *models.Book
+	return resp, notStubbed("GetBookByID2")
+}
+
+// HealthCheckCall records a call to HealthCheck.
+type HealthCheckCall struct {
+	Ctx context.Context
+}
+
+type healthCheckResult struct {
+	err error
+}
+
+// StubHealthCheck sets the function that answers calls to HealthCheck once its queued responses
+// are used up.
+func (f *Fake) StubHealthCheck(stub func(ctx context.Context) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.healthCheckStub = stub
+}
+
+// QueueHealthCheck adds a response for a call to HealthCheck.
+func (f *Fake) QueueHealthCheck(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.healthCheckQueue = append(f.healthCheckQueue, healthCheckResult{err: err})
+}
+
+// HealthCheckCalls returns the calls made to HealthCheck.
+func (f *Fake) HealthCheckCalls() []HealthCheckCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]HealthCheckCall{}, f.healthCheckCalls...)
+}
+
+// HealthCheck returns the next queued response or calls the stub.
+func (f *Fake) HealthCheck(ctx context.Context) error {
+	f.mu.Lock()
+	f.healthCheckCalls = append(f.healthCheckCalls, HealthCheckCall{Ctx: ctx})
+	if len(f.healthCheckQueue) > 0 {
+		result := f.healthCheckQueue[0]
+		f.healthCheckQueue = f.healthCheckQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.healthCheckStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	return notStubbed("HealthCheck")
+}
+
+// LowercaseModelsTestCall records a call to LowercaseModelsTest.
+type LowercaseModelsTestCall struct {
+	Ctx   context.Context
+	Input *models.LowercaseModelsTestInput
+}
+
+type lowercaseModelsTestResult struct {
+	err error
+}
+
+// StubLowercaseModelsTest sets the function that answers calls to LowercaseModelsTest once its queued responses
+// are used up.
+func (f *Fake) StubLowercaseModelsTest(stub func(ctx context.Context, i *models.LowercaseModelsTestInput) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lowercaseModelsTestStub = stub
+}
+
+// QueueLowercaseModelsTest adds a response for a call to LowercaseModelsTest.
+func (f *Fake) QueueLowercaseModelsTest(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lowercaseModelsTestQueue = append(f.lowercaseModelsTestQueue, lowercaseModelsTestResult{err: err})
+}
+
+// LowercaseModelsTestCalls returns the calls made to LowercaseModelsTest.
+func (f *Fake) LowercaseModelsTestCalls() []LowercaseModelsTestCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]LowercaseModelsTestCall{}, f.lowercaseModelsTestCalls...)
+}
+
+// LowercaseModelsTest returns the next queued response or calls the stub.
+func (f *Fake) LowercaseModelsTest(ctx context.Context, i *models.LowercaseModelsTestInput) error {
+	f.mu.Lock()
+	f.lowercaseModelsTestCalls = append(f.lowercaseModelsTestCalls, LowercaseModelsTestCall{Ctx: ctx, Input: i})
+	if len(f.lowercaseModelsTestQueue) > 0 {
+		result := f.lowercaseModelsTestQueue[0]
+		f.lowercaseModelsTestQueue = f.lowercaseModelsTestQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.lowercaseModelsTestStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	return notStubbed("LowercaseModelsTest")
+}
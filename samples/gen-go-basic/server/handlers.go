@@ -12,6 +12,7 @@ import (
 	"github.com/Clever/kayvee-go/v7/logger"
 	"github.com/Clever/wag/samples/gen-go-basic/models/v9"
 	"github.com/go-errors/errors"
+	openapierrors "github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/gorilla/mux"
@@ -68,6 +69,47 @@ func jsonMarshalNoError(i interface{}) string {
 	return string(bytes)
 }
 
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+
+// invalidParamError is an error reading a parameter from a request.
+type invalidParamError struct {
+	name string
+	code int32
+	err  error
+}
+
+func (e invalidParamError) Error() string {
+	return e.err.Error()
+}
+
+// validationError is a parameter or field of a request that couldn't be read or failed
+// validation. code is one of the codes of github.com/go-openapi/errors.
+type validationError struct {
+	path    string
+	code    int32
+	message string
+}
+
+// validationErrors returns the parameters and fields of a request that couldn't be read or
+// failed validation from the error reading or validating its input.
+func validationErrors(err error) []validationError {
+	var errs []validationError
+	switch err := err.(type) {
+	case invalidParamError:
+		errs = append(errs, validationError{path: err.name, code: err.code, message: err.Error()})
+	case *openapierrors.Validation:
+		errs = append(errs, validationError{path: err.Name, code: err.Code(), message: err.Error()})
+	case *openapierrors.CompositeError:
+		for _, err := range err.Errors {
+			errs = append(errs, validationErrors(err)...)
+		}
+	}
+	return errs
+}
+
 // statusCodeForGetAuthors returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
 func statusCodeForGetAuthors(obj interface{}) int {
@@ -102,7 +144,11 @@ func (h handler) GetAuthorsHandler(ctx context.Context, w http.ResponseWriter, r
 	input, err := newGetAuthorsInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -110,7 +156,11 @@ func (h handler) GetAuthorsHandler(ctx context.Context, w http.ResponseWriter, r
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -128,14 +178,14 @@ func (h handler) GetAuthorsHandler(ctx context.Context, w http.ResponseWriter, r
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
-	respBytes, err := json.Marshal(resp)
+	respBytes, err := marshalResponse(ctx, "getAuthors", resp)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 		return
 	}
 
@@ -144,7 +194,7 @@ func (h handler) GetAuthorsHandler(ctx context.Context, w http.ResponseWriter, r
 		path, err := input.Path()
 		if err != nil {
 			logger.FromContext(ctx).AddContext("error", err.Error())
-			http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+			writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 			return
 		}
 		w.Header().Set("X-Next-Page-Path", path)
@@ -170,7 +220,7 @@ func newGetAuthorsInput(r *http.Request) (*models.GetAuthorsInput, error) {
 		nameStr := nameStrs[0]
 		nameTmp, err = nameStr, error(nil)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "name", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.Name = &nameTmp
 	}
@@ -182,7 +232,7 @@ func newGetAuthorsInput(r *http.Request) (*models.GetAuthorsInput, error) {
 		startingAfterStr := startingAfterStrs[0]
 		startingAfterTmp, err = startingAfterStr, error(nil)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "startingAfter", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.StartingAfter = &startingAfterTmp
 	}
@@ -224,7 +274,16 @@ func (h handler) GetAuthorsWithPutHandler(ctx context.Context, w http.ResponseWr
 	input, err := newGetAuthorsWithPutInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -232,7 +291,11 @@ func (h handler) GetAuthorsWithPutHandler(ctx context.Context, w http.ResponseWr
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -250,14 +313,14 @@ func (h handler) GetAuthorsWithPutHandler(ctx context.Context, w http.ResponseWr
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
-	respBytes, err := json.Marshal(resp)
+	respBytes, err := marshalResponse(ctx, "getAuthorsWithPut", resp)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 		return
 	}
 
@@ -266,7 +329,7 @@ func (h handler) GetAuthorsWithPutHandler(ctx context.Context, w http.ResponseWr
 		path, err := input.Path()
 		if err != nil {
 			logger.FromContext(ctx).AddContext("error", err.Error())
-			http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+			writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 			return
 		}
 		w.Header().Set("X-Next-Page-Path", path)
@@ -292,7 +355,7 @@ func newGetAuthorsWithPutInput(r *http.Request) (*models.GetAuthorsWithPutInput,
 		nameStr := nameStrs[0]
 		nameTmp, err = nameStr, error(nil)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "name", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.Name = &nameTmp
 	}
@@ -304,12 +367,15 @@ func newGetAuthorsWithPutInput(r *http.Request) (*models.GetAuthorsWithPutInput,
 		startingAfterStr := startingAfterStrs[0]
 		startingAfterTmp, err = startingAfterStr, error(nil)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "startingAfter", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.StartingAfter = &startingAfterTmp
 	}
 
 	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
 
 	if len(data) > 0 {
 		input.FavoriteBooks = new(models.Book)
@@ -355,7 +421,11 @@ func (h handler) GetBooksHandler(ctx context.Context, w http.ResponseWriter, r *
 	input, err := newGetBooksInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -363,7 +433,11 @@ func (h handler) GetBooksHandler(ctx context.Context, w http.ResponseWriter, r *
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -387,14 +461,14 @@ func (h handler) GetBooksHandler(ctx context.Context, w http.ResponseWriter, r *
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
-	respBytes, err := json.Marshal(resp)
+	respBytes, err := marshalResponse(ctx, "getBooks", resp)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 		return
 	}
 
@@ -403,7 +477,7 @@ func (h handler) GetBooksHandler(ctx context.Context, w http.ResponseWriter, r *
 		path, err := input.Path()
 		if err != nil {
 			logger.FromContext(ctx).AddContext("error", err.Error())
-			http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+			writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 			return
 		}
 		w.Header().Set("X-Next-Page-Path", path)
@@ -435,7 +509,7 @@ func newGetBooksInput(r *http.Request) (*models.GetBooksInput, error) {
 		availableStr := availableStrs[0]
 		availableTmp, err = strconv.ParseBool(availableStr)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "available", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.Available = &availableTmp
 	}
@@ -450,7 +524,7 @@ func newGetBooksInput(r *http.Request) (*models.GetBooksInput, error) {
 		stateStr := stateStrs[0]
 		stateTmp, err = stateStr, error(nil)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "state", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.State = &stateTmp
 	}
@@ -462,7 +536,7 @@ func newGetBooksInput(r *http.Request) (*models.GetBooksInput, error) {
 		publishedStr := publishedStrs[0]
 		publishedTmp, err = convertDate(publishedStr)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "published", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.Published = &publishedTmp
 	}
@@ -474,7 +548,7 @@ func newGetBooksInput(r *http.Request) (*models.GetBooksInput, error) {
 		snakeCaseStr := snakeCaseStrs[0]
 		snakeCaseTmp, err = snakeCaseStr, error(nil)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "snake_case", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.SnakeCase = &snakeCaseTmp
 	}
@@ -486,7 +560,7 @@ func newGetBooksInput(r *http.Request) (*models.GetBooksInput, error) {
 		completedStr := completedStrs[0]
 		completedTmp, err = convertDateTime(completedStr)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "completed", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.Completed = &completedTmp
 	}
@@ -501,7 +575,7 @@ func newGetBooksInput(r *http.Request) (*models.GetBooksInput, error) {
 		maxPagesStr := maxPagesStrs[0]
 		maxPagesTmp, err = swag.ConvertFloat64(maxPagesStr)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "maxPages", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.MaxPages = &maxPagesTmp
 	}
@@ -516,7 +590,7 @@ func newGetBooksInput(r *http.Request) (*models.GetBooksInput, error) {
 		minPagesStr := minPagesStrs[0]
 		minPagesTmp, err = swag.ConvertInt32(minPagesStr)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "min_pages", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.MinPages = &minPagesTmp
 	}
@@ -528,7 +602,7 @@ func newGetBooksInput(r *http.Request) (*models.GetBooksInput, error) {
 		pagesToTimeStr := pagesToTimeStrs[0]
 		pagesToTimeTmp, err = swag.ConvertFloat32(pagesToTimeStr)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "pagesToTime", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.PagesToTime = &pagesToTimeTmp
 	}
@@ -548,7 +622,7 @@ func newGetBooksInput(r *http.Request) (*models.GetBooksInput, error) {
 		startingAfterStr := startingAfterStrs[0]
 		startingAfterTmp, err = swag.ConvertInt64(startingAfterStr)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "startingAfter", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.StartingAfter = &startingAfterTmp
 	}
@@ -590,7 +664,16 @@ func (h handler) CreateBookHandler(ctx context.Context, w http.ResponseWriter, r
 	input, err := newCreateBookInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -600,7 +683,11 @@ func (h handler) CreateBookHandler(ctx context.Context, w http.ResponseWriter, r
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -618,14 +705,14 @@ func (h handler) CreateBookHandler(ctx context.Context, w http.ResponseWriter, r
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
-	respBytes, err := json.Marshal(resp)
+	respBytes, err := marshalResponse(ctx, "createBook", resp)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 		return
 	}
 
@@ -641,6 +728,9 @@ func newCreateBookInput(r *http.Request) (*models.Book, error) {
 	_ = err
 
 	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
 	if len(data) == 0 {
 		return nil, errors.New("request body is required, but was empty")
 	}
@@ -689,7 +779,16 @@ func (h handler) PutBookHandler(ctx context.Context, w http.ResponseWriter, r *h
 	input, err := newPutBookInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -699,7 +798,11 @@ func (h handler) PutBookHandler(ctx context.Context, w http.ResponseWriter, r *h
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -717,14 +820,14 @@ func (h handler) PutBookHandler(ctx context.Context, w http.ResponseWriter, r *h
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
-	respBytes, err := json.Marshal(resp)
+	respBytes, err := marshalResponse(ctx, "putBook", resp)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 		return
 	}
 
@@ -740,6 +843,9 @@ func newPutBookInput(r *http.Request) (*models.Book, error) {
 	_ = err
 
 	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
 
 	if len(data) > 0 {
 		var input models.Book
@@ -798,7 +904,11 @@ func (h handler) GetBookByIDHandler(ctx context.Context, w http.ResponseWriter,
 	input, err := newGetBookByIDInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -806,7 +916,11 @@ func (h handler) GetBookByIDHandler(ctx context.Context, w http.ResponseWriter,
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -824,14 +938,14 @@ func (h handler) GetBookByIDHandler(ctx context.Context, w http.ResponseWriter,
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
-	respBytes, err := json.Marshal(resp)
+	respBytes, err := marshalResponse(ctx, "getBookByID", resp)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 		return
 	}
 
@@ -850,7 +964,7 @@ func newGetBookByIDInput(r *http.Request) (*models.GetBookByIDInput, error) {
 
 	bookIDStr := mux.Vars(r)["book_id"]
 	if len(bookIDStr) == 0 {
-		return nil, errors.New("path parameter 'book_id' must be specified")
+		return nil, invalidParamError{name: "book_id", code: openapierrors.RequiredFailCode, err: errors.New("path parameter 'book_id' must be specified")}
 	}
 	bookIDStrs := []string{bookIDStr}
 
@@ -859,7 +973,7 @@ func newGetBookByIDInput(r *http.Request) (*models.GetBookByIDInput, error) {
 		bookIDStr := bookIDStrs[0]
 		bookIDTmp, err = swag.ConvertInt64(bookIDStr)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "book_id", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.BookID = bookIDTmp
 	}
@@ -871,7 +985,7 @@ func newGetBookByIDInput(r *http.Request) (*models.GetBookByIDInput, error) {
 		authorIDStr := authorIDStrs[0]
 		authorIDTmp, err = authorIDStr, error(nil)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "authorID", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.AuthorID = &authorIDTmp
 	}
@@ -899,7 +1013,7 @@ func newGetBookByIDInput(r *http.Request) (*models.GetBookByIDInput, error) {
 		randomBytesStr := randomBytesStrs[0]
 		randomBytesTmp, err = convertBase64(randomBytesStr)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "randomBytes", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.RandomBytes = &randomBytesTmp
 	}
@@ -947,7 +1061,11 @@ func (h handler) GetBookByID2Handler(ctx context.Context, w http.ResponseWriter,
 	id, err := newGetBookByID2Input(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -955,7 +1073,11 @@ func (h handler) GetBookByID2Handler(ctx context.Context, w http.ResponseWriter,
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -973,14 +1095,14 @@ func (h handler) GetBookByID2Handler(ctx context.Context, w http.ResponseWriter,
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
-	respBytes, err := json.Marshal(resp)
+	respBytes, err := marshalResponse(ctx, "getBookByID2", resp)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 		return
 	}
 
@@ -1039,7 +1161,7 @@ func (h handler) HealthCheckHandler(ctx context.Context, w http.ResponseWriter,
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
@@ -1086,7 +1208,16 @@ func (h handler) LowercaseModelsTestHandler(ctx context.Context, w http.Response
 	input, err := newLowercaseModelsTestInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -1094,7 +1225,11 @@ func (h handler) LowercaseModelsTestHandler(ctx context.Context, w http.Response
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		badRequest := models.BadRequest{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &models.FieldError{Path: e.path, Code: int32(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest)
 		return
 	}
 
@@ -1112,7 +1247,7 @@ func (h handler) LowercaseModelsTestHandler(ctx context.Context, w http.Response
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
@@ -1129,6 +1264,9 @@ func newLowercaseModelsTestInput(r *http.Request) (*models.LowercaseModelsTestIn
 	_ = err
 
 	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
 	if len(data) == 0 {
 		return nil, errors.New("request body is required, but was empty")
 	}
@@ -1141,7 +1279,7 @@ func newLowercaseModelsTestInput(r *http.Request) (*models.LowercaseModelsTestIn
 
 	pathParamStr := mux.Vars(r)["pathParam"]
 	if len(pathParamStr) == 0 {
-		return nil, errors.New("path parameter 'pathParam' must be specified")
+		return nil, invalidParamError{name: "pathParam", code: openapierrors.RequiredFailCode, err: errors.New("path parameter 'pathParam' must be specified")}
 	}
 	pathParamStrs := []string{pathParamStr}
 
@@ -1150,7 +1288,7 @@ func newLowercaseModelsTestInput(r *http.Request) (*models.LowercaseModelsTestIn
 		pathParamStr := pathParamStrs[0]
 		pathParamTmp, err = pathParamStr, error(nil)
 		if err != nil {
-			return nil, err
+			return nil, invalidParamError{name: "pathParam", code: openapierrors.InvalidTypeCode, err: err}
 		}
 		input.PathParam = pathParamTmp
 	}
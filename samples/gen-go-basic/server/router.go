@@ -3,14 +3,22 @@ package server
 // Code auto-generated. Do not edit.
 
 import (
+	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
 	"log"
+	"net"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
 	"path"
+	"reflect"
+	"runtime/debug"
+	"sync"
 	"syscall"
 	"time"
 
@@ -18,6 +26,7 @@ import (
 	"github.com/Clever/kayvee-go/v7/logger"
 	kvMiddleware "github.com/Clever/kayvee-go/v7/middleware"
 	"github.com/Clever/wag/samples/v9/gen-go-basic/servertracing"
+	"github.com/go-openapi/strfmt"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/kardianos/osext"
@@ -32,8 +41,24 @@ type Server struct {
 	config  serverConfig
 }
 
+// Option configures a Server. It's the type of the options of New, NewWithMiddleware, and
+// AttachMiddleware, e.g. ServeSpec().
+type Option = func(*serverConfig)
+
 type serverConfig struct {
-	compressionLevel int
+	compressionLevel   int
+	serveSpec          bool
+	responseValidation ResponseValidation
+	limits             requestLimits
+	listener           net.Listener
+	certFile           string
+	keyFile            string
+	tlsConfig          *tls.Config
+	h2c                bool
+	pprofAddr          string
+	shutdownTimeout    time.Duration
+	shutdownSignals    []os.Signal
+	onShutdown         func()
 }
 
 func CompressionLevel(level int) func(*serverConfig) {
@@ -42,6 +67,119 @@ func CompressionLevel(level int) func(*serverConfig) {
 	}
 }
 
+// ServeSpec serves the spec of the service, with the references to other files resolved, at
+// /v1/swagger.json and /v1/swagger.yml, and HTML docs for it at /v1/docs. The spec and
+// the docs are embedded in the server when it's generated. Operations with the same paths take
+// precedence.
+func ServeSpec() func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.serveSpec = true
+	}
+}
+
+// ResponseValidation is what the server does with successful responses from the controller that
+// don't match their definitions, e.g. because they're missing required fields.
+type ResponseValidation int
+
+const (
+	// ResponseValidationOff doesn't validate responses.
+	ResponseValidationOff ResponseValidation = iota
+	// LogInvalidResponses logs an error for invalid responses, and writes them anyway.
+	LogInvalidResponses
+	// RejectInvalidResponses logs an error for invalid responses, and responds with a 500 instead.
+	RejectInvalidResponses
+)
+
+// ValidateResponses sets what the server does with responses that don't match their definitions.
+// Validating responses costs as much as validating inputs, so it's meant for development: it
+// defaults to RejectInvalidResponses when _IS_LOCAL=true, and to ResponseValidationOff otherwise.
+func ValidateResponses(v ResponseValidation) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.responseValidation = v
+	}
+}
+
+// Timeout sets the timeout for operations that don't set x-timeout. The contexts of their handlers
+// have a deadline, and requests that don't finish before it get a 503, or the operation's 504 or
+// 503 response if it defines one. There's no timeout by default.
+func Timeout(timeout time.Duration) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.limits.timeout = timeout
+	}
+}
+
+// MaxBodyBytes sets the maximum size of the request bodies for operations that don't set
+// x-max-body-bytes. Requests with larger bodies get a 413. There's no maximum by default.
+func MaxBodyBytes(n int64) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.limits.maxBodyBytes = n
+	}
+}
+
+// Listener makes Serve accept connections on a listener instead of listening on the server's
+// address, e.g. a unix socket or a socket from systemd socket activation.
+func Listener(l net.Listener) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.listener = l
+	}
+}
+
+// TLS makes Serve serve HTTPS with a certificate and its private key.
+func TLS(certFile, keyFile string) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.certFile = certFile
+		c.keyFile = keyFile
+	}
+}
+
+// TLSConfig makes Serve serve HTTPS with a TLS config. The config needs to have the certificates,
+// e.g. in Certificates or GetCertificate, unless it's combined with the TLS option.
+func TLSConfig(config *tls.Config) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.tlsConfig = config
+	}
+}
+
+// H2C makes Serve accept HTTP/2 without TLS (h2c) as well as HTTP/1, e.g. behind a load balancer
+// that uses HTTP/2 to reach its targets. HTTPS servers use HTTP/2 with or without this option.
+func H2C() func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.h2c = true
+	}
+}
+
+// PprofAddr sets the address of the pprof server Serve starts, which defaults to localhost:6060.
+// An empty address turns it off, e.g. for all but one of the servers in a process.
+func PprofAddr(addr string) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.pprofAddr = addr
+	}
+}
+
+// ShutdownTimeout sets how long Serve waits for requests to finish after it receives a shutdown
+// signal, which defaults to 30 seconds.
+func ShutdownTimeout(timeout time.Duration) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.shutdownTimeout = timeout
+	}
+}
+
+// ShutdownSignals sets the signals that shut down the server, which default to SIGINT and SIGTERM.
+// With no signals, the server runs until the process exits.
+func ShutdownSignals(signals ...os.Signal) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.shutdownSignals = signals
+	}
+}
+
+// OnShutdown sets a function Serve calls when it receives a shutdown signal, before it stops
+// accepting connections and waits for requests to finish, e.g. to fail health checks.
+func OnShutdown(hook func()) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.onShutdown = hook
+	}
+}
+
 // Serve starts the server. It will return if an error occurs.
 func (s *Server) Serve() error {
 	isLocal := os.Getenv("_IS_LOCAL") == "true"
@@ -49,10 +187,12 @@ func (s *Server) Serve() error {
 		go startLoggingProcessMetrics()
 	}
 
-	go func() {
-		// This should never return. Listen on the pprof port
-		log.Printf("PProf server crashed: %s", http.ListenAndServe("localhost:6060", nil))
-	}()
+	if s.config.pprofAddr != "" {
+		go func() {
+			// This should never return. Listen on the pprof port
+			log.Printf("PProf server crashed: %s", http.ListenAndServe(s.config.pprofAddr, nil))
+		}()
+	}
 
 	dir, err := osext.ExecutableFolder()
 	if err != nil {
@@ -64,30 +204,42 @@ func (s *Server) Serve() error {
 
 	s.l.Counter("server-started")
 
-	// Give the sever 30 seconds to shut down
 	server := &http.Server{
 		Addr:        s.addr,
 		Handler:     s.Handler,
 		IdleTimeout: 3 * time.Minute,
+		TLSConfig:   s.config.tlsConfig,
+	}
+	if s.config.h2c {
+		server.Protocols = new(http.Protocols)
+		server.Protocols.SetHTTP1(true)
+		server.Protocols.SetHTTP2(true)
+		server.Protocols.SetUnencryptedHTTP2(true)
 	}
 	server.SetKeepAlivesEnabled(true)
 
-	// Give the server 30 seconds to shut down gracefully after it receives a signal
+	// Give the server time to shut down gracefully after it receives a signal
 	shutdown := make(chan struct{})
-	go func() {
-		c := make(chan os.Signal, 1)
-		signal.Notify(c, os.Interrupt, os.Signal(syscall.SIGTERM))
-		sig := <-c
-		s.l.InfoD("shutdown-initiated", logger.M{"signal": sig.String()})
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-		defer close(shutdown)
-		if err := server.Shutdown(ctx); err != nil {
-			s.l.CriticalD("error-during-shutdown", logger.M{"error": err.Error()})
-		}
-	}()
+	if len(s.config.shutdownSignals) > 0 {
+		go func() {
+			c := make(chan os.Signal, 1)
+			signal.Notify(c, s.config.shutdownSignals...)
+			sig := <-c
+			signal.Stop(c)
+			s.l.InfoD("shutdown-initiated", logger.M{"signal": sig.String()})
+			if s.config.onShutdown != nil {
+				s.config.onShutdown()
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), s.config.shutdownTimeout)
+			defer cancel()
+			defer close(shutdown)
+			if err := server.Shutdown(ctx); err != nil {
+				s.l.CriticalD("error-during-shutdown", logger.M{"error": err.Error()})
+			}
+		}()
+	}
 
-	if err := server.ListenAndServe(); err != http.ErrServerClosed {
+	if err := s.listenAndServe(server); err != http.ErrServerClosed {
 		return err
 	}
 	// ensure we wait for graceful shutdown
@@ -96,6 +248,21 @@ func (s *Server) Serve() error {
 	return nil
 }
 
+// listenAndServe serves HTTP or HTTPS on the listener or the address of the server.
+func (s *Server) listenAndServe(server *http.Server) error {
+	useTLS := s.config.tlsConfig != nil || s.config.certFile != ""
+	switch {
+	case s.config.listener != nil && useTLS:
+		return server.ServeTLS(s.config.listener, s.config.certFile, s.config.keyFile)
+	case s.config.listener != nil:
+		return server.Serve(s.config.listener)
+	case useTLS:
+		return server.ListenAndServeTLS(s.config.certFile, s.config.keyFile)
+	default:
+		return server.ListenAndServe()
+	}
+}
+
 type handler struct {
 	Controller
 }
@@ -107,6 +274,13 @@ func startLoggingProcessMetrics() {
 func withMiddleware(serviceName string, router http.Handler, m []func(http.Handler) http.Handler, config serverConfig) http.Handler {
 	handler := router
 
+	if config.responseValidation != ResponseValidationOff {
+		handler = withResponseValidation(handler, config.responseValidation)
+	}
+	if config.limits != (requestLimits{}) {
+		handler = withRequestLimits(handler, config.limits)
+	}
+
 	// compress everything
 	handler = handlers.CompressHandlerLevel(handler, config.compressionLevel)
 
@@ -141,47 +315,47 @@ func newRouter(c Controller) *mux.Router {
 
 	router.Methods("GET").Path("/v1/authors").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getAuthors")
-		h.GetAuthorsHandler(r.Context(), w, r)
+		serveWithLimits(w, r, h.GetAuthorsHandler, requestLimits{}, http.StatusServiceUnavailable, requestTimedOut)
 	})
 
 	router.Methods("PUT").Path("/v1/authors").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getAuthorsWithPut")
-		h.GetAuthorsWithPutHandler(r.Context(), w, r)
+		serveWithLimits(w, r, h.GetAuthorsWithPutHandler, requestLimits{}, http.StatusServiceUnavailable, requestTimedOut)
 	})
 
 	router.Methods("GET").Path("/v1/books").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getBooks")
-		h.GetBooksHandler(r.Context(), w, r)
+		serveWithLimits(w, r, h.GetBooksHandler, requestLimits{}, http.StatusServiceUnavailable, requestTimedOut)
 	})
 
 	router.Methods("POST").Path("/v1/books").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "createBook")
-		h.CreateBookHandler(r.Context(), w, r)
+		serveWithLimits(w, r, h.CreateBookHandler, requestLimits{}, http.StatusServiceUnavailable, requestTimedOut)
 	})
 
 	router.Methods("PUT").Path("/v1/books").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "putBook")
-		h.PutBookHandler(r.Context(), w, r)
+		serveWithLimits(w, r, h.PutBookHandler, requestLimits{}, http.StatusServiceUnavailable, requestTimedOut)
 	})
 
 	router.Methods("GET").Path("/v1/books/{book_id}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getBookByID")
-		h.GetBookByIDHandler(r.Context(), w, r)
+		serveWithLimits(w, r, h.GetBookByIDHandler, requestLimits{}, http.StatusServiceUnavailable, requestTimedOut)
 	})
 
 	router.Methods("GET").Path("/v1/books2/{id}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getBookByID2")
-		h.GetBookByID2Handler(r.Context(), w, r)
+		serveWithLimits(w, r, h.GetBookByID2Handler, requestLimits{}, http.StatusServiceUnavailable, requestTimedOut)
 	})
 
 	router.Methods("GET").Path("/v1/health/check").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "healthCheck")
-		h.HealthCheckHandler(r.Context(), w, r)
+		serveWithLimits(w, r, h.HealthCheckHandler, requestLimits{}, http.StatusServiceUnavailable, requestTimedOut)
 	})
 
 	router.Methods("POST").Path("/v1/lowercaseModelsTest/{pathParam}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "lowercaseModelsTest")
-		h.LowercaseModelsTestHandler(r.Context(), w, r)
+		serveWithLimits(w, r, h.LowercaseModelsTestHandler, requestLimits{}, http.StatusServiceUnavailable, requestTimedOut)
 	})
 
 	return router
@@ -206,13 +380,207 @@ func AttachMiddleware(router *mux.Router, addr string, m []func(http.Handler) ht
 	// AttachMiddleWare directly instead.
 	config := serverConfig{
 		compressionLevel: gzip.DefaultCompression,
+		pprofAddr:        "localhost:6060",
+		shutdownTimeout:  30 * time.Second,
+		shutdownSignals:  []os.Signal{os.Interrupt, syscall.SIGTERM},
+	}
+	if os.Getenv("_IS_LOCAL") == "true" {
+		config.responseValidation = RejectInvalidResponses
 	}
 	for _, option := range options {
 		option(&config)
 	}
+	if config.serveSpec {
+		handleSpec(router)
+	}
 
 	l := logger.New("swagger-test")
 
 	handler := withMiddleware("swagger-test", router, m, config)
 	return &Server{Handler: handler, addr: addr, l: l, config: config}
 }
+
+type responseValidationKey struct{}
+
+// withResponseValidation sets the response validation of the requests to a handler.
+func withResponseValidation(handler http.Handler, v ResponseValidation) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), responseValidationKey{}, v)))
+	})
+}
+
+// marshalResponse marshals the body of a successful response of an operation. If response
+// validation is on, it first validates the body against its definition.
+func marshalResponse(ctx context.Context, op string, body interface{}) ([]byte, error) {
+	v, _ := ctx.Value(responseValidationKey{}).(ResponseValidation)
+	if v != ResponseValidationOff {
+		if err := validateResponse(body); err != nil {
+			err = fmt.Errorf("%s returned an invalid response: %s", op, err)
+			logger.FromContext(ctx).ErrorD("invalid-response", logger.M{"op": op, "error": err.Error()})
+			if v == RejectInvalidResponses {
+				return nil, err
+			}
+		}
+	}
+	return json.Marshal(body)
+}
+
+// validateResponse validates a model, or each model in an array, with its Validate method.
+func validateResponse(body interface{}) error {
+	value := reflect.ValueOf(body)
+	if !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil()) {
+		return nil
+	}
+	if model, ok := body.(interface{ Validate(strfmt.Registry) error }); ok {
+		return model.Validate(strfmt.Default)
+	}
+	if value.Kind() != reflect.Slice {
+		return nil
+	}
+	for i := 0; i < value.Len(); i++ {
+		item := value.Index(i)
+		if item.Kind() == reflect.Struct {
+			item = item.Addr()
+		}
+		if err := validateResponse(item.Interface()); err != nil {
+			return fmt.Errorf("item %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// requestLimits are the timeout and the maximum body size of requests to an operation. Zero values
+// are unlimited.
+type requestLimits struct {
+	timeout      time.Duration
+	maxBodyBytes int64
+}
+
+type requestLimitsKey struct{}
+
+// withRequestLimits sets the server's default request limits for the requests to a handler.
+func withRequestLimits(handler http.Handler, limits requestLimits) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestLimitsKey{}, limits)))
+	})
+}
+
+// requestTimedOut is the response body for requests that time out, if the operation doesn't
+// define a response for them.
+var requestTimedOut = map[string]string{"message": "request timed out"}
+
+// serveWithLimits serves a request to an operation with its x-timeout and x-max-body-bytes, or the
+// server's defaults for them. If the request times out, the handler's response is discarded and
+// the response has the timeout status code and body instead. Panics in the handler are passed on
+// to PanicMiddleware, or logged if the request has already timed out.
+func serveWithLimits(w http.ResponseWriter, r *http.Request, serve func(context.Context, http.ResponseWriter, *http.Request), limits requestLimits, timeoutStatusCode int, timeoutBody interface{}) {
+	defaults, _ := r.Context().Value(requestLimitsKey{}).(requestLimits)
+	if limits.timeout == 0 {
+		limits.timeout = defaults.timeout
+	}
+	if limits.maxBodyBytes == 0 {
+		limits.maxBodyBytes = defaults.maxBodyBytes
+	}
+	if limits.maxBodyBytes > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, limits.maxBodyBytes)
+	}
+	if limits.timeout <= 0 {
+		serve(r.Context(), w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), limits.timeout)
+	defer cancel()
+	r = r.WithContext(ctx)
+	tw := &timeoutWriter{header: http.Header{}}
+	done := make(chan struct{})
+	panics := make(chan interface{}, 1)
+	go func() {
+		defer func() {
+			p := recover()
+			if p == nil {
+				return
+			}
+			tw.mu.Lock()
+			timedOut := tw.timedOut
+			if !timedOut {
+				panics <- p
+			}
+			tw.mu.Unlock()
+			if timedOut {
+				// The request has been responded to, so there's nothing to pass the panic on to
+				logger.FromContext(ctx).ErrorD("panic-after-timeout",
+					logger.M{"err": fmt.Sprint(p), "stacktrace": string(debug.Stack())})
+			}
+		}()
+		serve(ctx, tw, r)
+		close(done)
+	}()
+	select {
+	case p := <-panics:
+		// Panic in the goroutine of the request so PanicMiddleware handles it
+		panic(p)
+	case <-done:
+	case <-ctx.Done():
+	}
+
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	// The handler may have panicked as the deadline passed
+	select {
+	case p := <-panics:
+		panic(p)
+	default:
+	}
+	// Responses that finish after the deadline are discarded too, since they're usually errors
+	// from the context
+	if ctx.Err() != nil {
+		tw.timedOut = true
+		writeError(w, r, timeoutStatusCode, timeoutBody)
+		return
+	}
+	for key, values := range tw.header {
+		w.Header()[key] = values
+	}
+	if tw.statusCode == 0 {
+		tw.statusCode = http.StatusOK
+	}
+	w.WriteHeader(tw.statusCode)
+	w.Write(tw.buf.Bytes())
+}
+
+// timeoutWriter buffers the response of a handler with a timeout, so it can be discarded if the
+// handler doesn't finish in time. Handlers write whole JSON responses, so it doesn't implement
+// http.Flusher: responses of operations with a timeout can't be streamed.
+type timeoutWriter struct {
+	mu         sync.Mutex
+	header     http.Header
+	buf        bytes.Buffer
+	statusCode int
+	timedOut   bool
+}
+
+func (tw *timeoutWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if tw.statusCode == 0 {
+		tw.statusCode = http.StatusOK
+	}
+	return tw.buf.Write(b)
+}
+
+func (tw *timeoutWriter) WriteHeader(statusCode int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut || tw.statusCode != 0 {
+		return
+	}
+	tw.statusCode = statusCode
+}
@@ -0,0 +1,148 @@
+// Package servertest runs the swagger-test server in-process with a client for it, for
+// tests that cover the routing, parameter parsing and error handling between them.
+package servertest
+
+// Code auto-generated. Do not edit.
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	wcl "github.com/Clever/wag/logging/wagclientlogger"
+	"github.com/Clever/wag/samples/gen-go-basic/client/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-basic/server"
+)
+
+// Server is a server for a controller running on an httptest.Server, with a client for it.
+type Server struct {
+	// HTTPServer serves the controller.
+	HTTPServer *httptest.Server
+	// Client makes requests to HTTPServer. It doesn't retry requests or log.
+	Client *client.WagClient
+}
+
+type config struct {
+	middleware    []func(http.Handler) http.Handler
+	serverOptions []server.Option
+	onRequest     []func(*http.Request)
+	onResponse    []func(*http.Response)
+}
+
+// Option configures a Server.
+type Option func(*config)
+
+// WithMiddleware adds middleware to the server, as with server.NewWithMiddleware.
+func WithMiddleware(m ...func(http.Handler) http.Handler) Option {
+	return func(c *config) {
+		c.middleware = append(c.middleware, m...)
+	}
+}
+
+// WithServerOptions passes options to the server, e.g. server.ServeSpec() or server.Timeout(d).
+func WithServerOptions(options ...server.Option) Option {
+	return func(c *config) {
+		c.serverOptions = append(c.serverOptions, options...)
+	}
+}
+
+// OnRequest adds a function that's called with a copy of each request the client sends. The body
+// of the request can be read, and changes to the copy don't change the request that's sent.
+func OnRequest(f func(*http.Request)) Option {
+	return func(c *config) {
+		c.onRequest = append(c.onRequest, f)
+	}
+}
+
+// OnResponse adds a function that's called with each response the client receives, before the
+// client decodes it. The body of the response can be read.
+func OnResponse(f func(*http.Response)) Option {
+	return func(c *config) {
+		c.onResponse = append(c.onResponse, f)
+	}
+}
+
+// New starts a server for a controller and returns it with a client for it. The server is closed
+// when the test and its subtests complete.
+func New(t testing.TB, c server.Controller, options ...Option) *Server {
+	config := config{}
+	for _, option := range options {
+		option(&config)
+	}
+
+	httpServer := httptest.NewServer(server.NewWithMiddleware(c, "", config.middleware, config.serverOptions...).Handler)
+	t.Cleanup(httpServer.Close)
+
+	var transport http.RoundTripper = &hookTransport{
+		transport:  http.DefaultTransport,
+		onRequest:  config.onRequest,
+		onResponse: config.onResponse,
+	}
+	wagClient := client.New(httpServer.URL, noopLogger{}, &transport)
+	wagClient.SetRetryPolicy(client.NoRetryPolicy{})
+	return &Server{HTTPServer: httpServer, Client: wagClient}
+}
+
+// hookTransport calls functions with the requests and responses that pass through it.
+type hookTransport struct {
+	transport  http.RoundTripper
+	onRequest  []func(*http.Request)
+	onResponse []func(*http.Response)
+}
+
+func (t *hookTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(t.onRequest) > 0 {
+		// A RoundTripper mustn't modify the request, so the hooks get copies of it, and a copy
+		// with the body that was read is sent
+		var body io.ReadCloser = req.Body
+		rewind, err := rewindable(&body)
+		if err != nil {
+			return nil, err
+		}
+		clone := func() *http.Request {
+			rewind()
+			r := req.Clone(req.Context())
+			r.Body = body
+			return r
+		}
+		for _, f := range t.onRequest {
+			f(clone())
+		}
+		req = clone()
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || len(t.onResponse) == 0 {
+		return resp, err
+	}
+	rewind, err := rewindable(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range t.onResponse {
+		rewind()
+		f(resp)
+	}
+	rewind()
+	return resp, nil
+}
+
+// rewindable reads a request or response body, and returns a function that resets the body to
+// what was read, so it can be read more than once.
+func rewindable(body *io.ReadCloser) (func(), error) {
+	if *body == nil || *body == http.NoBody {
+		return func() {}, nil
+	}
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	return func() { *body = io.NopCloser(bytes.NewReader(data)) }, nil
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Log(level wcl.LogLevel, message string, pairs map[string]interface{}) {}
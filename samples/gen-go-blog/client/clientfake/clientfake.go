@@ -0,0 +1,200 @@
+// Package clientfake has an in-memory implementation of the blog client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-blog/client/v9"
+	"github.com/Clever/wag/samples/gen-go-blog/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	postGradeFileForStudentStub  func(ctx context.Context, i *models.PostGradeFileForStudentInput) error
+	postGradeFileForStudentQueue []postGradeFileForStudentResult
+	postGradeFileForStudentCalls []PostGradeFileForStudentCall
+
+	getSectionsForStudentStub  func(ctx context.Context, studentID string) ([]models.Section, error)
+	getSectionsForStudentQueue []getSectionsForStudentResult
+	getSectionsForStudentCalls []GetSectionsForStudentCall
+
+	postSectionsForStudentStub  func(ctx context.Context, i *models.PostSectionsForStudentInput) ([]models.Section, error)
+	postSectionsForStudentQueue []postSectionsForStudentResult
+	postSectionsForStudentCalls []PostSectionsForStudentCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// PostGradeFileForStudentCall records a call to PostGradeFileForStudent.
+type PostGradeFileForStudentCall struct {
+	Ctx   context.Context
+	Input *models.PostGradeFileForStudentInput
+}
+
+type postGradeFileForStudentResult struct {
+	err error
+}
+
+// StubPostGradeFileForStudent sets the function that answers calls to PostGradeFileForStudent once its queued responses
+// are used up.
+func (f *Fake) StubPostGradeFileForStudent(stub func(ctx context.Context, i *models.PostGradeFileForStudentInput) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.postGradeFileForStudentStub = stub
+}
+
+// QueuePostGradeFileForStudent adds a response for a call to PostGradeFileForStudent.
+func (f *Fake) QueuePostGradeFileForStudent(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.postGradeFileForStudentQueue = append(f.postGradeFileForStudentQueue, postGradeFileForStudentResult{err: err})
+}
+
+// PostGradeFileForStudentCalls returns the calls made to PostGradeFileForStudent.
+func (f *Fake) PostGradeFileForStudentCalls() []PostGradeFileForStudentCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]PostGradeFileForStudentCall{}, f.postGradeFileForStudentCalls...)
+}
+
+// PostGradeFileForStudent returns the next queued response or calls the stub.
+func (f *Fake) PostGradeFileForStudent(ctx context.Context, i *models.PostGradeFileForStudentInput) error {
+	f.mu.Lock()
+	f.postGradeFileForStudentCalls = append(f.postGradeFileForStudentCalls, PostGradeFileForStudentCall{Ctx: ctx, Input: i})
+	if len(f.postGradeFileForStudentQueue) > 0 {
+		result := f.postGradeFileForStudentQueue[0]
+		f.postGradeFileForStudentQueue = f.postGradeFileForStudentQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.postGradeFileForStudentStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	return notStubbed("PostGradeFileForStudent")
+}
+
+// GetSectionsForStudentCall records a call to GetSectionsForStudent.
+type GetSectionsForStudentCall struct {
+	Ctx   context.Context
+	Input string
+}
+
+type getSectionsForStudentResult struct {
+	resp []models.Section
+	err  error
+}
+
+// StubGetSectionsForStudent sets the function that answers calls to GetSectionsForStudent once its queued responses
+// are used up.
+func (f *Fake) StubGetSectionsForStudent(stub func(ctx context.Context, studentID string) ([]models.Section, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getSectionsForStudentStub = stub
+}
+
+// QueueGetSectionsForStudent adds a response for a call to GetSectionsForStudent.
+func (f *Fake) QueueGetSectionsForStudent(resp []models.Section, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getSectionsForStudentQueue = append(f.getSectionsForStudentQueue, getSectionsForStudentResult{resp: resp, err: err})
+}
+
+// GetSectionsForStudentCalls returns the calls made to GetSectionsForStudent.
+func (f *Fake) GetSectionsForStudentCalls() []GetSectionsForStudentCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetSectionsForStudentCall{}, f.getSectionsForStudentCalls...)
+}
+
+// GetSectionsForStudent returns the next queued response or calls the stub.
+func (f *Fake) GetSectionsForStudent(ctx context.Context, studentID string) ([]models.Section, error) {
+	f.mu.Lock()
+	f.getSectionsForStudentCalls = append(f.getSectionsForStudentCalls, GetSectionsForStudentCall{Ctx: ctx, Input: studentID})
+	if len(f.getSectionsForStudentQueue) > 0 {
+		result := f.getSectionsForStudentQueue[0]
+		f.getSectionsForStudentQueue = f.getSectionsForStudentQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getSectionsForStudentStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, studentID)
+	}
+	var resp []models.Section
+	return resp, notStubbed("GetSectionsForStudent")
+}
+
+// PostSectionsForStudentCall records a call to PostSectionsForStudent.
+type PostSectionsForStudentCall struct {
+	Ctx   context.Context
+	Input *models.PostSectionsForStudentInput
+}
+
+type postSectionsForStudentResult struct {
+	resp []models.Section
+	err  error
+}
+
+// StubPostSectionsForStudent sets the function that answers calls to PostSectionsForStudent once its queued responses
+// are used up.
+func (f *Fake) StubPostSectionsForStudent(stub func(ctx context.Context, i *models.PostSectionsForStudentInput) ([]models.Section, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.postSectionsForStudentStub = stub
+}
+
+// QueuePostSectionsForStudent adds a response for a call to PostSectionsForStudent.
+func (f *Fake) QueuePostSectionsForStudent(resp []models.Section, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.postSectionsForStudentQueue = append(f.postSectionsForStudentQueue, postSectionsForStudentResult{resp: resp, err: err})
+}
+
+// PostSectionsForStudentCalls returns the calls made to PostSectionsForStudent.
+func (f *Fake) PostSectionsForStudentCalls() []PostSectionsForStudentCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]PostSectionsForStudentCall{}, f.postSectionsForStudentCalls...)
+}
+
+// PostSectionsForStudent returns the next queued response or calls the stub.
+func (f *Fake) PostSectionsForStudent(ctx context.Context, i *models.PostSectionsForStudentInput) ([]models.Section, error) {
+	f.mu.Lock()
+	f.postSectionsForStudentCalls = append(f.postSectionsForStudentCalls, PostSectionsForStudentCall{Ctx: ctx, Input: i})
+	if len(f.postSectionsForStudentQueue) > 0 {
+		result := f.postSectionsForStudentQueue[0]
+		f.postSectionsForStudentQueue = f.postSectionsForStudentQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.postSectionsForStudentStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp []models.Section
+	return resp, notStubbed("PostSectionsForStudent")
+}
@@ -68,6 +68,11 @@ func jsonMarshalNoError(i interface{}) string {
 	return string(bytes)
 }
 
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+
 // statusCodeForPostGradeFileForStudent returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
 func statusCodeForPostGradeFileForStudent(obj interface{}) int {
@@ -96,7 +101,7 @@ func (h handler) PostGradeFileForStudentHandler(ctx context.Context, w http.Resp
 	input, err := newPostGradeFileForStudentInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
 		return
 	}
 
@@ -104,7 +109,7 @@ func (h handler) PostGradeFileForStudentHandler(ctx context.Context, w http.Resp
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
 		return
 	}
 
@@ -122,7 +127,7 @@ func (h handler) PostGradeFileForStudentHandler(ctx context.Context, w http.Resp
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
@@ -193,7 +198,7 @@ func (h handler) GetSectionsForStudentHandler(ctx context.Context, w http.Respon
 	studentID, err := newGetSectionsForStudentInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
 		return
 	}
 
@@ -201,7 +206,7 @@ func (h handler) GetSectionsForStudentHandler(ctx context.Context, w http.Respon
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
 		return
 	}
 
@@ -225,14 +230,14 @@ func (h handler) GetSectionsForStudentHandler(ctx context.Context, w http.Respon
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
-	respBytes, err := json.Marshal(resp)
+	respBytes, err := marshalResponse(ctx, "getSectionsForStudent", resp)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 		return
 	}
 
@@ -286,7 +291,7 @@ func (h handler) PostSectionsForStudentHandler(ctx context.Context, w http.Respo
 	input, err := newPostSectionsForStudentInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
 		return
 	}
 
@@ -294,7 +299,7 @@ func (h handler) PostSectionsForStudentHandler(ctx context.Context, w http.Respo
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
 		return
 	}
 
@@ -318,14 +323,14 @@ func (h handler) PostSectionsForStudentHandler(ctx context.Context, w http.Respo
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
-	respBytes, err := json.Marshal(resp)
+	respBytes, err := marshalResponse(ctx, "postSectionsForStudent", resp)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
 		return
 	}
 
@@ -0,0 +1,39 @@
+package server
+
+// Code auto-generated. Do not edit.
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// specJSON is the spec of the service, with the references to other files resolved.
+//
+//go:embed swagger.json
+var specJSON []byte
+
+// specYAML is specJSON as YAML.
+//
+//go:embed swagger.yml
+var specYAML []byte
+
+// docsHTML documents the operations and models of the service.
+//
+//go:embed docs.html
+var docsHTML []byte
+
+// handleSpec adds the routes that serve the spec and its docs to a router.
+func handleSpec(router *mux.Router) {
+	router.Methods("GET").Path("/swagger.json").HandlerFunc(serveEmbedded("application/json", specJSON))
+	router.Methods("GET").Path("/swagger.yml").HandlerFunc(serveEmbedded("application/yaml", specYAML))
+	router.Methods("GET").Path("/docs").HandlerFunc(serveEmbedded("text/html; charset=utf-8", docsHTML))
+}
+
+func serveEmbedded(contentType string, content []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Write(content)
+	}
+}
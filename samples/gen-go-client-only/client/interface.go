@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 
 	"github.com/Clever/wag/samples/gen-go-client-only/models/v9"
 )
@@ -21,6 +22,15 @@ type Client interface {
 
 	NewGetAuthorsIter(ctx context.Context, i *models.GetAuthorsInput) (GetAuthorsIter, error)
 
+	// NewGetAuthorsIterFromCursor resumes iterating the pages of getAuthors from the Cursor of an iterator.
+	NewGetAuthorsIterFromCursor(ctx context.Context, i *models.GetAuthorsInput, cursor string) (GetAuthorsIter, error)
+
+	// GetAuthorsSeq returns a sequence of the resources of all the pages of getAuthors.
+	GetAuthorsSeq(ctx context.Context, i *models.GetAuthorsInput) iter.Seq2[*models.Author, error]
+
+	// GetAuthorsPages returns a sequence of the pages of getAuthors.
+	GetAuthorsPages(ctx context.Context, i *models.GetAuthorsInput) iter.Seq2[[]*models.Author, error]
+
 	// GetAuthorsWithPut makes a PUT request to /authors
 	// Gets authors, but needs to use the body so it's a PUT
 	// 200: *models.AuthorsResponse
@@ -31,6 +41,15 @@ type Client interface {
 
 	NewGetAuthorsWithPutIter(ctx context.Context, i *models.GetAuthorsWithPutInput) (GetAuthorsWithPutIter, error)
 
+	// NewGetAuthorsWithPutIterFromCursor resumes iterating the pages of getAuthorsWithPut from the Cursor of an iterator.
+	NewGetAuthorsWithPutIterFromCursor(ctx context.Context, i *models.GetAuthorsWithPutInput, cursor string) (GetAuthorsWithPutIter, error)
+
+	// GetAuthorsWithPutSeq returns a sequence of the resources of all the pages of getAuthorsWithPut.
+	GetAuthorsWithPutSeq(ctx context.Context, i *models.GetAuthorsWithPutInput) iter.Seq2[*models.Author, error]
+
+	// GetAuthorsWithPutPages returns a sequence of the pages of getAuthorsWithPut.
+	GetAuthorsWithPutPages(ctx context.Context, i *models.GetAuthorsWithPutInput) iter.Seq2[[]*models.Author, error]
+
 	// GetBooks makes a GET request to /books
 	// Returns a list of books
 	// 200: []models.Book
@@ -41,6 +60,15 @@ type Client interface {
 
 	NewGetBooksIter(ctx context.Context, i *models.GetBooksInput) (GetBooksIter, error)
 
+	// NewGetBooksIterFromCursor resumes iterating the pages of getBooks from the Cursor of an iterator.
+	NewGetBooksIterFromCursor(ctx context.Context, i *models.GetBooksInput, cursor string) (GetBooksIter, error)
+
+	// GetBooksSeq returns a sequence of the resources of all the pages of getBooks.
+	GetBooksSeq(ctx context.Context, i *models.GetBooksInput) iter.Seq2[*models.Book, error]
+
+	// GetBooksPages returns a sequence of the pages of getBooks.
+	GetBooksPages(ctx context.Context, i *models.GetBooksInput) iter.Seq2[[]models.Book, error]
+
 	// CreateBook makes a POST request to /books
 	// Creates a book
 	// 200: *models.Book
@@ -97,16 +125,22 @@ type Client interface {
 type GetAuthorsIter interface {
 	Next(*models.Author) bool
 	Err() error
+	Cursor() string
+	Close()
 }
 
 // GetAuthorsWithPutIter defines the methods available on GetAuthorsWithPut iterators.
 type GetAuthorsWithPutIter interface {
 	Next(*models.Author) bool
 	Err() error
+	Cursor() string
+	Close()
 }
 
 // GetBooksIter defines the methods available on GetBooks iterators.
 type GetBooksIter interface {
 	Next(*models.Book) bool
 	Err() error
+	Cursor() string
+	Close()
 }
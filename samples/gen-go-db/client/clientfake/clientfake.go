@@ -0,0 +1,84 @@
+// Package clientfake has an in-memory implementation of the swagger-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-db/client/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	healthCheckStub  func(ctx context.Context) error
+	healthCheckQueue []healthCheckResult
+	healthCheckCalls []HealthCheckCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// HealthCheckCall records a call to HealthCheck.
+type HealthCheckCall struct {
+	Ctx context.Context
+}
+
+type healthCheckResult struct {
+	err error
+}
+
+// StubHealthCheck sets the function that answers calls to HealthCheck once its queued responses
+// are used up.
+func (f *Fake) StubHealthCheck(stub func(ctx context.Context) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.healthCheckStub = stub
+}
+
+// QueueHealthCheck adds a response for a call to HealthCheck.
+func (f *Fake) QueueHealthCheck(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.healthCheckQueue = append(f.healthCheckQueue, healthCheckResult{err: err})
+}
+
+// HealthCheckCalls returns the calls made to HealthCheck.
+func (f *Fake) HealthCheckCalls() []HealthCheckCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]HealthCheckCall{}, f.healthCheckCalls...)
+}
+
+// HealthCheck returns the next queued response or calls the stub.
+func (f *Fake) HealthCheck(ctx context.Context) error {
+	f.mu.Lock()
+	f.healthCheckCalls = append(f.healthCheckCalls, HealthCheckCall{Ctx: ctx})
+	if len(f.healthCheckQueue) > 0 {
+		result := f.healthCheckQueue[0]
+		f.healthCheckQueue = f.healthCheckQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.healthCheckStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	return notStubbed("HealthCheck")
+}
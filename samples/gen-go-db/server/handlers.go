@@ -68,6 +68,11 @@ func jsonMarshalNoError(i interface{}) string {
 	return string(bytes)
 }
 
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+
 // statusCodeForHealthCheck returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
 func statusCodeForHealthCheck(obj interface{}) int {
@@ -107,7 +112,7 @@ func (h handler) HealthCheckHandler(ctx context.Context, w http.ResponseWriter,
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
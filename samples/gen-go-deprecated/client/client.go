@@ -28,10 +28,13 @@ const VersionHeader = "X-Client-Version"
 // WagClient is used to make requests to the swagger-test service.
 type WagClient struct {
 	basePath    string
-	requestDoer doer
+	requestDoer Doer
 	client      *http.Client
 	// Keep the retry doer around so that we can set the number of retries
-	retryDoer      *retryDoer
+	retryDoer  *retryDoer
+	middleware []Middleware
+	// Keep the circuit breaker doer around so that we can set its options
+	circuitBreaker *circuitBreakerDoer
 	defaultTimeout time.Duration
 	logger         wcl.WagClientLogger
 }
@@ -51,9 +54,11 @@ func New(basePath string, logger wcl.WagClientLogger, transport *http.RoundTripp
 
 	basePath = strings.TrimSuffix(basePath, "/")
 	base := baseDoer{}
+	// The circuit breaker is off until its options are set
+	circuit := &circuitBreakerDoer{d: base, service: "swagger-test", logger: logger}
 
 	// Don't use the default retry policy since its 5 retries can 5X the traffic
-	retry := retryDoer{d: base, retryPolicy: SingleRetryPolicy{}}
+	retry := retryDoer{d: circuit, retryPolicy: SingleRetryPolicy{}}
 
 	client := &WagClient{
 		basePath:    basePath,
@@ -62,6 +67,7 @@ func New(basePath string, logger wcl.WagClientLogger, transport *http.RoundTripp
 			Transport: t,
 		},
 		retryDoer:      &retry,
+		circuitBreaker: circuit,
 		defaultTimeout: 5 * time.Second,
 		logger:         logger,
 	}
@@ -89,9 +95,37 @@ func (c *WagClient) SetRetryPolicy(retryPolicy RetryPolicy) {
 	c.retryDoer.retryPolicy = retryPolicy
 }
 
+// SetCircuitBreaker turns on a circuit breaker with the given options for all the operations that
+// don't have their own. Each attempt at a request counts, so retries of failed requests stop when
+// the circuit opens. Requests fail with ErrCircuitOpen while it's open. Setting the options resets
+// the circuit.
+func (c *WagClient) SetCircuitBreaker(options CircuitBreakerOptions) {
+	c.circuitBreaker.setOptions("", options)
+}
+
+// SetOperationCircuitBreaker gives an operation, e.g. "getBookByID", its own circuit breaker with
+// the given options. Use CircuitBreakerOptions{ForceClosed: true} to turn the circuit breaker off
+// for the operation.
+func (c *WagClient) SetOperationCircuitBreaker(operation string, options CircuitBreakerOptions) {
+	c.circuitBreaker.setOptions(operation, options)
+}
+
+// AddMiddleware adds middleware that wraps the requests of all operations. Middleware is called in
+// the order it's added, before the client retries requests, so it's called once per call to an
+// operation, or per page for operations with paging.
+func (c *WagClient) AddMiddleware(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+	var d Doer = c.retryDoer
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		d = c.middleware[i](d)
+	}
+	c.requestDoer = d
+}
+
 // SetLogger allows for setting a custom logger
 func (c *WagClient) SetLogger(l wcl.WagClientLogger) {
 	c.logger = l
+	c.circuitBreaker.setLogger(l)
 }
 
 // SetTimeout sets a timeout on all operations for the client. To make a single request with a shorter timeout
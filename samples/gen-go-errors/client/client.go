@@ -32,10 +32,13 @@ const VersionHeader = "X-Client-Version"
 // WagClient is used to make requests to the swagger-test service.
 type WagClient struct {
 	basePath    string
-	requestDoer doer
+	requestDoer Doer
 	client      *http.Client
 	// Keep the retry doer around so that we can set the number of retries
-	retryDoer      *retryDoer
+	retryDoer  *retryDoer
+	middleware []Middleware
+	// Keep the circuit breaker doer around so that we can set its options
+	circuitBreaker *circuitBreakerDoer
 	defaultTimeout time.Duration
 	logger         wcl.WagClientLogger
 }
@@ -55,9 +58,11 @@ func New(basePath string, logger wcl.WagClientLogger, transport *http.RoundTripp
 
 	basePath = strings.TrimSuffix(basePath, "/")
 	base := baseDoer{}
+	// The circuit breaker is off until its options are set
+	circuit := &circuitBreakerDoer{d: base, service: "swagger-test", logger: logger}
 
 	// Don't use the default retry policy since its 5 retries can 5X the traffic
-	retry := retryDoer{d: base, retryPolicy: SingleRetryPolicy{}}
+	retry := retryDoer{d: circuit, retryPolicy: SingleRetryPolicy{}}
 
 	client := &WagClient{
 		basePath:    basePath,
@@ -66,6 +71,7 @@ func New(basePath string, logger wcl.WagClientLogger, transport *http.RoundTripp
 			Transport: t,
 		},
 		retryDoer:      &retry,
+		circuitBreaker: circuit,
 		defaultTimeout: 5 * time.Second,
 		logger:         logger,
 	}
@@ -93,9 +99,37 @@ func (c *WagClient) SetRetryPolicy(retryPolicy RetryPolicy) {
 	c.retryDoer.retryPolicy = retryPolicy
 }
 
+// SetCircuitBreaker turns on a circuit breaker with the given options for all the operations that
+// don't have their own. Each attempt at a request counts, so retries of failed requests stop when
+// the circuit opens. Requests fail with ErrCircuitOpen while it's open. Setting the options resets
+// the circuit.
+func (c *WagClient) SetCircuitBreaker(options CircuitBreakerOptions) {
+	c.circuitBreaker.setOptions("", options)
+}
+
+// SetOperationCircuitBreaker gives an operation, e.g. "getBookByID", its own circuit breaker with
+// the given options. Use CircuitBreakerOptions{ForceClosed: true} to turn the circuit breaker off
+// for the operation.
+func (c *WagClient) SetOperationCircuitBreaker(operation string, options CircuitBreakerOptions) {
+	c.circuitBreaker.setOptions(operation, options)
+}
+
+// AddMiddleware adds middleware that wraps the requests of all operations. Middleware is called in
+// the order it's added, before the client retries requests, so it's called once per call to an
+// operation, or per page for operations with paging.
+func (c *WagClient) AddMiddleware(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+	var d Doer = c.retryDoer
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		d = c.middleware[i](d)
+	}
+	c.requestDoer = d
+}
+
 // SetLogger allows for setting a custom logger
 func (c *WagClient) SetLogger(l wcl.WagClientLogger) {
 	c.logger = l
+	c.circuitBreaker.setLogger(l)
 }
 
 // SetTimeout sets a timeout on all operations for the client. To make a single request with a shorter timeout
@@ -129,10 +163,10 @@ func (c *WagClient) GetBook(ctx context.Context, i *models.GetBookInput) error {
 		return err
 	}
 
-	return c.doGetBookRequest(ctx, req, headers)
+	return c.doGetBookRequest(ctx, req, headers, i)
 }
 
-func (c *WagClient) doGetBookRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
+func (c *WagClient) doGetBookRequest(ctx context.Context, req *http.Request, headers map[string]string, input interface{}) (err error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Canonical-Resource", "getBook")
 	req.Header.Set(VersionHeader, Version)
@@ -143,6 +177,11 @@ func (c *WagClient) doGetBookRequest(ctx context.Context, req *http.Request, hea
 
 	// Add the opname for doers like tracing
 	ctx = context.WithValue(ctx, opNameCtx{}, "getBook")
+	operation := &Operation{Name: "getBook", Input: input}
+	defer func() {
+		operation.finish(nil, err)
+	}()
+	ctx = context.WithValue(ctx, operationCtx{}, operation)
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
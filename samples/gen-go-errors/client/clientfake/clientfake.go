@@ -0,0 +1,86 @@
+// Package clientfake has an in-memory implementation of the swagger-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-errors/client/v9"
+	"github.com/Clever/wag/samples/gen-go-errors/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	getBookStub  func(ctx context.Context, i *models.GetBookInput) error
+	getBookQueue []getBookResult
+	getBookCalls []GetBookCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// GetBookCall records a call to GetBook.
+type GetBookCall struct {
+	Ctx   context.Context
+	Input *models.GetBookInput
+}
+
+type getBookResult struct {
+	err error
+}
+
+// StubGetBook sets the function that answers calls to GetBook once its queued responses
+// are used up.
+func (f *Fake) StubGetBook(stub func(ctx context.Context, i *models.GetBookInput) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBookStub = stub
+}
+
+// QueueGetBook adds a response for a call to GetBook.
+func (f *Fake) QueueGetBook(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBookQueue = append(f.getBookQueue, getBookResult{err: err})
+}
+
+// GetBookCalls returns the calls made to GetBook.
+func (f *Fake) GetBookCalls() []GetBookCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetBookCall{}, f.getBookCalls...)
+}
+
+// GetBook returns the next queued response or calls the stub.
+func (f *Fake) GetBook(ctx context.Context, i *models.GetBookInput) error {
+	f.mu.Lock()
+	f.getBookCalls = append(f.getBookCalls, GetBookCall{Ctx: ctx, Input: i})
+	if len(f.getBookQueue) > 0 {
+		result := f.getBookQueue[0]
+		f.getBookQueue = f.getBookQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.getBookStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	return notStubbed("GetBook")
+}
@@ -68,6 +68,11 @@ func jsonMarshalNoError(i interface{}) string {
 	return string(bytes)
 }
 
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+
 // statusCodeForGetBook returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
 func statusCodeForGetBook(obj interface{}) int {
@@ -102,7 +107,7 @@ func (h handler) GetBookHandler(ctx context.Context, w http.ResponseWriter, r *h
 	input, err := newGetBookInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.ExtendedError{Message: err.Error()}), http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, models.ExtendedError{Message: err.Error()})
 		return
 	}
 
@@ -110,7 +115,7 @@ func (h handler) GetBookHandler(ctx context.Context, w http.ResponseWriter, r *h
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.ExtendedError{Message: err.Error()}), http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, models.ExtendedError{Message: err.Error()})
 		return
 	}
 
@@ -128,7 +133,7 @@ func (h handler) GetBookHandler(ctx context.Context, w http.ResponseWriter, r *h
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
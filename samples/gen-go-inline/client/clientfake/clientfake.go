@@ -0,0 +1,201 @@
+// Package clientfake has an in-memory implementation of the inline-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-inline/client/v9"
+	"github.com/Clever/wag/samples/gen-go-inline/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	listThingsStub  func(ctx context.Context) ([]models.ListThingsOKBodyItem, error)
+	listThingsQueue []listThingsResult
+	listThingsCalls []ListThingsCall
+
+	createThingStub  func(ctx context.Context, i *models.CreateThingRequestBody) (*models.Thing, error)
+	createThingQueue []createThingResult
+	createThingCalls []CreateThingCall
+
+	getThingStub  func(ctx context.Context, id string) (*models.GetThingOKBody, error)
+	getThingQueue []getThingResult
+	getThingCalls []GetThingCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// ListThingsCall records a call to ListThings.
+type ListThingsCall struct {
+	Ctx context.Context
+}
+
+type listThingsResult struct {
+	resp []models.ListThingsOKBodyItem
+	err  error
+}
+
+// StubListThings sets the function that answers calls to ListThings once its queued responses
+// are used up.
+func (f *Fake) StubListThings(stub func(ctx context.Context) ([]models.ListThingsOKBodyItem, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listThingsStub = stub
+}
+
+// QueueListThings adds a response for a call to ListThings.
+func (f *Fake) QueueListThings(resp []models.ListThingsOKBodyItem, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listThingsQueue = append(f.listThingsQueue, listThingsResult{resp: resp, err: err})
+}
+
+// ListThingsCalls returns the calls made to ListThings.
+func (f *Fake) ListThingsCalls() []ListThingsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]ListThingsCall{}, f.listThingsCalls...)
+}
+
+// ListThings returns the next queued response or calls the stub.
+func (f *Fake) ListThings(ctx context.Context) ([]models.ListThingsOKBodyItem, error) {
+	f.mu.Lock()
+	f.listThingsCalls = append(f.listThingsCalls, ListThingsCall{Ctx: ctx})
+	if len(f.listThingsQueue) > 0 {
+		result := f.listThingsQueue[0]
+		f.listThingsQueue = f.listThingsQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.listThingsStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	var resp []models.ListThingsOKBodyItem
+	return resp, notStubbed("ListThings")
+}
+
+// CreateThingCall records a call to CreateThing.
+type CreateThingCall struct {
+	Ctx   context.Context
+	Input *models.CreateThingRequestBody
+}
+
+type createThingResult struct {
+	resp *models.Thing
+	err  error
+}
+
+// StubCreateThing sets the function that answers calls to CreateThing once its queued responses
+// are used up.
+func (f *Fake) StubCreateThing(stub func(ctx context.Context, i *models.CreateThingRequestBody) (*models.Thing, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createThingStub = stub
+}
+
+// QueueCreateThing adds a response for a call to CreateThing.
+func (f *Fake) QueueCreateThing(resp *models.Thing, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createThingQueue = append(f.createThingQueue, createThingResult{resp: resp, err: err})
+}
+
+// CreateThingCalls returns the calls made to CreateThing.
+func (f *Fake) CreateThingCalls() []CreateThingCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]CreateThingCall{}, f.createThingCalls...)
+}
+
+// CreateThing returns the next queued response or calls the stub.
+func (f *Fake) CreateThing(ctx context.Context, i *models.CreateThingRequestBody) (*models.Thing, error) {
+	f.mu.Lock()
+	f.createThingCalls = append(f.createThingCalls, CreateThingCall{Ctx: ctx, Input: i})
+	if len(f.createThingQueue) > 0 {
+		result := f.createThingQueue[0]
+		f.createThingQueue = f.createThingQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.createThingStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.Thing
+	return resp, notStubbed("CreateThing")
+}
+
+// GetThingCall records a call to GetThing.
+type GetThingCall struct {
+	Ctx   context.Context
+	Input string
+}
+
+type getThingResult struct {
+	resp *models.GetThingOKBody
+	err  error
+}
+
+// StubGetThing sets the function that answers calls to GetThing once its queued responses
+// are used up.
+func (f *Fake) StubGetThing(stub func(ctx context.Context, id string) (*models.GetThingOKBody, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getThingStub = stub
+}
+
+// QueueGetThing adds a response for a call to GetThing.
+func (f *Fake) QueueGetThing(resp *models.GetThingOKBody, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getThingQueue = append(f.getThingQueue, getThingResult{resp: resp, err: err})
+}
+
+// GetThingCalls returns the calls made to GetThing.
+func (f *Fake) GetThingCalls() []GetThingCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetThingCall{}, f.getThingCalls...)
+}
+
+// GetThing returns the next queued response or calls the stub.
+func (f *Fake) GetThing(ctx context.Context, id string) (*models.GetThingOKBody, error) {
+	f.mu.Lock()
+	f.getThingCalls = append(f.getThingCalls, GetThingCall{Ctx: ctx, Input: id})
+	if len(f.getThingQueue) > 0 {
+		result := f.getThingQueue[0]
+		f.getThingQueue = f.getThingQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getThingStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	var resp *models.GetThingOKBody
+	return resp, notStubbed("GetThing")
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-inline/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_client.go -package client --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-inline/models/v9
+
+// Client defines the methods available to clients of the inline-test service.
+type Client interface {
+
+	// ListThings makes a GET request to /things
+	//
+	// 200: []models.ListThingsOKBodyItem
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	ListThings(ctx context.Context) ([]models.ListThingsOKBodyItem, error)
+
+	// CreateThing makes a POST request to /things
+	//
+	// 200: *models.Thing
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateThing(ctx context.Context, i *models.CreateThingRequestBody) (*models.Thing, error)
+
+	// GetThing makes a GET request to /things/{id}
+	//
+	// 200: *models.GetThingOKBody
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetThing(ctx context.Context, id string) (*models.GetThingOKBody, error)
+}
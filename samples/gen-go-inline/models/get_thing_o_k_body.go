@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetThingOKBody get thing o k body
+//
+// swagger:model GetThingOKBody
+type GetThingOKBody struct {
+
+	// fetched at
+	// Format: date-time
+	FetchedAt strfmt.DateTime `json:"fetchedAt,omitempty"`
+
+	// thing
+	Thing *Thing `json:"thing,omitempty"`
+}
+
+// Validate validates this get thing o k body
+func (m *GetThingOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFetchedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateThing(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetThingOKBody) validateFetchedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.FetchedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("fetchedAt", "body", "date-time", m.FetchedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetThingOKBody) validateThing(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Thing) { // not required
+		return nil
+	}
+
+	if m.Thing != nil {
+		if err := m.Thing.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("thing")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetThingOKBody) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetThingOKBody) UnmarshalBinary(b []byte) error {
+	var res GetThingOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
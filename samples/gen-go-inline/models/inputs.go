@@ -0,0 +1,68 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// These imports may not be used depending on the input parameters
+var _ = json.Marshal
+var _ = fmt.Sprintf
+var _ = url.QueryEscape
+var _ = strconv.FormatInt
+var _ = strings.Replace
+var _ = validate.Maximum
+var _ = strfmt.NewFormats
+
+// ListThingsInput holds the input parameters for a listThings operation.
+type ListThingsInput struct {
+}
+
+// Validate returns an error if any of the ListThingsInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i ListThingsInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i ListThingsInput) Path() (string, error) {
+	path := "/v1/things"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetThingInput holds the input parameters for a getThing operation.
+type GetThingInput struct {
+	ID string
+}
+
+// ValidateGetThingInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetThingInput(id string) error {
+
+	return nil
+}
+
+// GetThingInputPath returns the URI path for the input.
+func GetThingInputPath(id string) (string, error) {
+	path := "/v1/things/{id}"
+	urlVals := url.Values{}
+
+	pathid := id
+	if pathid == "" {
+		err := fmt.Errorf("id cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{id}", pathid, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
@@ -0,0 +1,46 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ThingHistoryItem thing history item
+//
+// swagger:model ThingHistoryItem
+type ThingHistoryItem struct {
+
+	// count
+	Count int64 `json:"count,omitempty"`
+
+	// event
+	Event string `json:"event,omitempty"`
+}
+
+// Validate validates this thing history item
+func (m *ThingHistoryItem) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ThingHistoryItem) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ThingHistoryItem) UnmarshalBinary(b []byte) error {
+	var res ThingHistoryItem
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,43 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ThingLabelsValue thing labels value
+//
+// swagger:model ThingLabelsValue
+type ThingLabelsValue struct {
+
+	// color
+	Color string `json:"color,omitempty"`
+}
+
+// Validate validates this thing labels value
+func (m *ThingLabelsValue) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ThingLabelsValue) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ThingLabelsValue) UnmarshalBinary(b []byte) error {
+	var res ThingLabelsValue
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
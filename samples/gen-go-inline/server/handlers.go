@@ -0,0 +1,346 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/Clever/kayvee-go/v7/logger"
+	"github.com/Clever/wag/samples/gen-go-inline/models/v9"
+	"github.com/go-errors/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"golang.org/x/xerrors"
+)
+
+var _ = strconv.ParseInt
+var _ = strfmt.Default
+var _ = swag.ConvertInt32
+var _ = errors.New
+var _ = mux.Vars
+var _ = bytes.Compare
+var _ = ioutil.ReadAll
+
+var formats = strfmt.Default
+var _ = formats
+
+// convertBase64 takes in a string and returns a strfmt.Base64 if the input
+// is valid base64 and an error otherwise.
+func convertBase64(input string) (strfmt.Base64, error) {
+	temp, err := formats.Parse("byte", input)
+	if err != nil {
+		return strfmt.Base64{}, err
+	}
+	return *temp.(*strfmt.Base64), nil
+}
+
+// convertDateTime takes in a string and returns a strfmt.DateTime if the input
+// is a valid DateTime and an error otherwise.
+func convertDateTime(input string) (strfmt.DateTime, error) {
+	temp, err := formats.Parse("date-time", input)
+	if err != nil {
+		return strfmt.DateTime{}, err
+	}
+	return *temp.(*strfmt.DateTime), nil
+}
+
+// convertDate takes in a string and returns a strfmt.Date if the input
+// is a valid Date and an error otherwise.
+func convertDate(input string) (strfmt.Date, error) {
+	temp, err := formats.Parse("date", input)
+	if err != nil {
+		return strfmt.Date{}, err
+	}
+	return *temp.(*strfmt.Date), nil
+}
+
+func jsonMarshalNoError(i interface{}) string {
+	bytes, err := json.Marshal(i)
+	if err != nil {
+		// This should never happen
+		return ""
+	}
+	return string(bytes)
+}
+
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+
+// statusCodeForListThings returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForListThings(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.ListThingsOKBodyItem:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case []models.ListThingsOKBodyItem:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) ListThingsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	resp, err := h.ListThings(ctx)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.ListThingsOKBodyItem{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForListThings(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "listThings", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForListThings(resp))
+	w.Write(respBytes)
+
+}
+
+// newListThingsInput takes in an http.Request an returns the input struct.
+func newListThingsInput(r *http.Request) (*models.ListThingsInput, error) {
+	var input models.ListThingsInput
+
+	var err error
+	_ = err
+
+	return &input, nil
+}
+
+// statusCodeForCreateThing returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForCreateThing(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.Thing:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.Thing:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) CreateThingHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newCreateThingInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	if input != nil {
+		err = input.Validate(nil)
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	resp, err := h.CreateThing(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForCreateThing(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "createThing", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForCreateThing(resp))
+	w.Write(respBytes)
+
+}
+
+// newCreateThingInput takes in an http.Request an returns the input struct.
+func newCreateThingInput(r *http.Request) (*models.CreateThingRequestBody, error) {
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+	if len(data) > 0 {
+		var input models.CreateThingRequestBody
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+		return &input, nil
+	}
+
+	return nil, nil
+}
+
+// statusCodeForGetThing returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetThing(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.GetThingOKBody:
+		return 200
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.GetThingOKBody:
+		return 200
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetThingHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	id, err := newGetThingInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	err = models.ValidateGetThingInput(id)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	resp, err := h.GetThing(ctx, id)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForGetThing(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "getThing", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetThing(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetThingInput takes in an http.Request an returns the id parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetThingInput(r *http.Request) (string, error) {
+	id := mux.Vars(r)["id"]
+	if len(id) == 0 {
+		return "", errors.New("Parameter id must be specified")
+	}
+	return id, nil
+}
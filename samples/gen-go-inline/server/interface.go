@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-inline/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_controller.go -package server --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-inline/models/v9
+
+// Controller defines the interface for the inline-test service.
+type Controller interface {
+
+	// ListThings handles GET requests to /things
+	//
+	// 200: []models.ListThingsOKBodyItem
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	ListThings(ctx context.Context) ([]models.ListThingsOKBodyItem, error)
+
+	// CreateThing handles POST requests to /things
+	//
+	// 200: *models.Thing
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateThing(ctx context.Context, i *models.CreateThingRequestBody) (*models.Thing, error)
+
+	// GetThing handles GET requests to /things/{id}
+	//
+	// 200: *models.GetThingOKBody
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetThing(ctx context.Context, id string) (*models.GetThingOKBody, error)
+}
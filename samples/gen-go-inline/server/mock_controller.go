@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+
+// Package server is a generated GoMock package.
+package server
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Clever/wag/samples/gen-go-inline/models/v9"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockController is a mock of Controller interface.
+type MockController struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerMockRecorder
+}
+
+// MockControllerMockRecorder is the mock recorder for MockController.
+type MockControllerMockRecorder struct {
+	mock *MockController
+}
+
+// NewMockController creates a new mock instance.
+func NewMockController(ctrl *gomock.Controller) *MockController {
+	mock := &MockController{ctrl: ctrl}
+	mock.recorder = &MockControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockController) EXPECT() *MockControllerMockRecorder {
+	return m.recorder
+}
+
+// CreateThing mocks base method.
+func (m *MockController) CreateThing(ctx context.Context, i *models.CreateThingRequestBody) (*models.Thing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateThing", ctx, i)
+	ret0, _ := ret[0].(*models.Thing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateThing indicates an expected call of CreateThing.
+func (mr *MockControllerMockRecorder) CreateThing(ctx, i interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateThing", reflect.TypeOf((*MockController)(nil).CreateThing), ctx, i)
+}
+
+// GetThing mocks base method.
+func (m *MockController) GetThing(ctx context.Context, id string) (*models.GetThingOKBody, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThing", ctx, id)
+	ret0, _ := ret[0].(*models.GetThingOKBody)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThing indicates an expected call of GetThing.
+func (mr *MockControllerMockRecorder) GetThing(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThing", reflect.TypeOf((*MockController)(nil).GetThing), ctx, id)
+}
+
+// ListThings mocks base method.
+func (m *MockController) ListThings(ctx context.Context) ([]models.ListThingsOKBodyItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListThings", ctx)
+	ret0, _ := ret[0].([]models.ListThingsOKBodyItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListThings indicates an expected call of ListThings.
+func (mr *MockControllerMockRecorder) ListThings(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListThings", reflect.TypeOf((*MockController)(nil).ListThings), ctx)
+}
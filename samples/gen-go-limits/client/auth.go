@@ -0,0 +1,78 @@
+package client
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"net/http"
+)
+
+// SecurityScheme is the name of a security scheme in the swagger spec's securityDefinitions.
+type SecurityScheme string
+
+// The security schemes defined in the swagger spec.
+const (
+	SecuritySchemeAPIKey SecurityScheme = "api_key"
+)
+
+// Credentials are the credentials the client sends for a security scheme.
+type Credentials struct {
+	// Token is the key for apiKey schemes and the bearer token for oauth2 schemes.
+	Token string
+	// Username and Password are used for basic schemes.
+	Username string
+	Password string
+}
+
+// CredentialsProvider returns the credentials to send for a security scheme, or nil if the
+// client doesn't have credentials for it. It's called on every request to an operation with
+// security requirements, so it can hand out short-lived tokens.
+type CredentialsProvider func(ctx context.Context, scheme SecurityScheme) (*Credentials, error)
+
+// SetCredentialsProvider sets the provider of the credentials attached to requests.
+func (c *WagClient) SetCredentialsProvider(p CredentialsProvider) {
+	c.credentials = p
+}
+
+// applyCredentials attaches the credentials for the first of an operation's security
+// requirements that the credentials provider can satisfy. Empty requirements, which allow
+// anonymous requests, are skipped so that credentials are still sent when they're available. If
+// it can't satisfy any of them the request is sent without credentials.
+func (c *WagClient) applyCredentials(ctx context.Context, req *http.Request, requirements [][]SecurityScheme) error {
+	if c.credentials == nil {
+		return nil
+	}
+	for _, requirement := range requirements {
+		if len(requirement) == 0 {
+			continue
+		}
+		creds := make([]*Credentials, 0, len(requirement))
+		for _, scheme := range requirement {
+			cred, err := c.credentials(ctx, scheme)
+			if err != nil {
+				return err
+			}
+			if cred == nil {
+				break
+			}
+			creds = append(creds, cred)
+		}
+		if len(creds) != len(requirement) {
+			continue
+		}
+
+		for i, scheme := range requirement {
+			setCredentials(req, scheme, creds[i])
+		}
+		return nil
+	}
+	return nil
+}
+
+// setCredentials attaches credentials to a request where the security scheme expects them.
+func setCredentials(req *http.Request, scheme SecurityScheme, creds *Credentials) {
+	switch scheme {
+	case SecuritySchemeAPIKey:
+		req.Header.Set("X-API-Key", creds.Token)
+	}
+}
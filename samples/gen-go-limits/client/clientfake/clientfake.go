@@ -0,0 +1,525 @@
+// Package clientfake has an in-memory implementation of the limits-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-limits/client/v9"
+	"github.com/Clever/wag/samples/gen-go-limits/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	limitedByAuthenticatedCallerStub  func(ctx context.Context) error
+	limitedByAuthenticatedCallerQueue []limitedByAuthenticatedCallerResult
+	limitedByAuthenticatedCallerCalls []LimitedByAuthenticatedCallerCall
+
+	limitedByCallerStub  func(ctx context.Context) error
+	limitedByCallerQueue []limitedByCallerResult
+	limitedByCallerCalls []LimitedByCallerCall
+
+	limitedByHeaderStub  func(ctx context.Context) error
+	limitedByHeaderQueue []limitedByHeaderResult
+	limitedByHeaderCalls []LimitedByHeaderCall
+
+	limitedByIPStub  func(ctx context.Context) error
+	limitedByIPQueue []limitedByIPResult
+	limitedByIPCalls []LimitedByIPCall
+
+	createItemStub  func(ctx context.Context, i *models.Item) (*models.Item, error)
+	createItemQueue []createItemResult
+	createItemCalls []CreateItemCall
+
+	createNoteStub  func(ctx context.Context, i *models.Item) (*models.Item, error)
+	createNoteQueue []createNoteResult
+	createNoteCalls []CreateNoteCall
+
+	sleepStub  func(ctx context.Context, i *models.SleepInput) error
+	sleepQueue []sleepResult
+	sleepCalls []SleepCall
+
+	slowStub  func(ctx context.Context, i *models.SlowInput) error
+	slowQueue []slowResult
+	slowCalls []SlowCall
+
+	unlimitedStub  func(ctx context.Context) error
+	unlimitedQueue []unlimitedResult
+	unlimitedCalls []UnlimitedCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// LimitedByAuthenticatedCallerCall records a call to LimitedByAuthenticatedCaller.
+type LimitedByAuthenticatedCallerCall struct {
+	Ctx context.Context
+}
+
+type limitedByAuthenticatedCallerResult struct {
+	err error
+}
+
+// StubLimitedByAuthenticatedCaller sets the function that answers calls to LimitedByAuthenticatedCaller once its queued responses
+// are used up.
+func (f *Fake) StubLimitedByAuthenticatedCaller(stub func(ctx context.Context) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limitedByAuthenticatedCallerStub = stub
+}
+
+// QueueLimitedByAuthenticatedCaller adds a response for a call to LimitedByAuthenticatedCaller.
+func (f *Fake) QueueLimitedByAuthenticatedCaller(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limitedByAuthenticatedCallerQueue = append(f.limitedByAuthenticatedCallerQueue, limitedByAuthenticatedCallerResult{err: err})
+}
+
+// LimitedByAuthenticatedCallerCalls returns the calls made to LimitedByAuthenticatedCaller.
+func (f *Fake) LimitedByAuthenticatedCallerCalls() []LimitedByAuthenticatedCallerCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]LimitedByAuthenticatedCallerCall{}, f.limitedByAuthenticatedCallerCalls...)
+}
+
+// LimitedByAuthenticatedCaller returns the next queued response or calls the stub.
+func (f *Fake) LimitedByAuthenticatedCaller(ctx context.Context) error {
+	f.mu.Lock()
+	f.limitedByAuthenticatedCallerCalls = append(f.limitedByAuthenticatedCallerCalls, LimitedByAuthenticatedCallerCall{Ctx: ctx})
+	if len(f.limitedByAuthenticatedCallerQueue) > 0 {
+		result := f.limitedByAuthenticatedCallerQueue[0]
+		f.limitedByAuthenticatedCallerQueue = f.limitedByAuthenticatedCallerQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.limitedByAuthenticatedCallerStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	return notStubbed("LimitedByAuthenticatedCaller")
+}
+
+// LimitedByCallerCall records a call to LimitedByCaller.
+type LimitedByCallerCall struct {
+	Ctx context.Context
+}
+
+type limitedByCallerResult struct {
+	err error
+}
+
+// StubLimitedByCaller sets the function that answers calls to LimitedByCaller once its queued responses
+// are used up.
+func (f *Fake) StubLimitedByCaller(stub func(ctx context.Context) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limitedByCallerStub = stub
+}
+
+// QueueLimitedByCaller adds a response for a call to LimitedByCaller.
+func (f *Fake) QueueLimitedByCaller(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limitedByCallerQueue = append(f.limitedByCallerQueue, limitedByCallerResult{err: err})
+}
+
+// LimitedByCallerCalls returns the calls made to LimitedByCaller.
+func (f *Fake) LimitedByCallerCalls() []LimitedByCallerCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]LimitedByCallerCall{}, f.limitedByCallerCalls...)
+}
+
+// LimitedByCaller returns the next queued response or calls the stub.
+func (f *Fake) LimitedByCaller(ctx context.Context) error {
+	f.mu.Lock()
+	f.limitedByCallerCalls = append(f.limitedByCallerCalls, LimitedByCallerCall{Ctx: ctx})
+	if len(f.limitedByCallerQueue) > 0 {
+		result := f.limitedByCallerQueue[0]
+		f.limitedByCallerQueue = f.limitedByCallerQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.limitedByCallerStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	return notStubbed("LimitedByCaller")
+}
+
+// LimitedByHeaderCall records a call to LimitedByHeader.
+type LimitedByHeaderCall struct {
+	Ctx context.Context
+}
+
+type limitedByHeaderResult struct {
+	err error
+}
+
+// StubLimitedByHeader sets the function that answers calls to LimitedByHeader once its queued responses
+// are used up.
+func (f *Fake) StubLimitedByHeader(stub func(ctx context.Context) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limitedByHeaderStub = stub
+}
+
+// QueueLimitedByHeader adds a response for a call to LimitedByHeader.
+func (f *Fake) QueueLimitedByHeader(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limitedByHeaderQueue = append(f.limitedByHeaderQueue, limitedByHeaderResult{err: err})
+}
+
+// LimitedByHeaderCalls returns the calls made to LimitedByHeader.
+func (f *Fake) LimitedByHeaderCalls() []LimitedByHeaderCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]LimitedByHeaderCall{}, f.limitedByHeaderCalls...)
+}
+
+// LimitedByHeader returns the next queued response or calls the stub.
+func (f *Fake) LimitedByHeader(ctx context.Context) error {
+	f.mu.Lock()
+	f.limitedByHeaderCalls = append(f.limitedByHeaderCalls, LimitedByHeaderCall{Ctx: ctx})
+	if len(f.limitedByHeaderQueue) > 0 {
+		result := f.limitedByHeaderQueue[0]
+		f.limitedByHeaderQueue = f.limitedByHeaderQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.limitedByHeaderStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	return notStubbed("LimitedByHeader")
+}
+
+// LimitedByIPCall records a call to LimitedByIP.
+type LimitedByIPCall struct {
+	Ctx context.Context
+}
+
+type limitedByIPResult struct {
+	err error
+}
+
+// StubLimitedByIP sets the function that answers calls to LimitedByIP once its queued responses
+// are used up.
+func (f *Fake) StubLimitedByIP(stub func(ctx context.Context) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limitedByIPStub = stub
+}
+
+// QueueLimitedByIP adds a response for a call to LimitedByIP.
+func (f *Fake) QueueLimitedByIP(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limitedByIPQueue = append(f.limitedByIPQueue, limitedByIPResult{err: err})
+}
+
+// LimitedByIPCalls returns the calls made to LimitedByIP.
+func (f *Fake) LimitedByIPCalls() []LimitedByIPCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]LimitedByIPCall{}, f.limitedByIPCalls...)
+}
+
+// LimitedByIP returns the next queued response or calls the stub.
+func (f *Fake) LimitedByIP(ctx context.Context) error {
+	f.mu.Lock()
+	f.limitedByIPCalls = append(f.limitedByIPCalls, LimitedByIPCall{Ctx: ctx})
+	if len(f.limitedByIPQueue) > 0 {
+		result := f.limitedByIPQueue[0]
+		f.limitedByIPQueue = f.limitedByIPQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.limitedByIPStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	return notStubbed("LimitedByIP")
+}
+
+// CreateItemCall records a call to CreateItem.
+type CreateItemCall struct {
+	Ctx   context.Context
+	Input *models.Item
+}
+
+type createItemResult struct {
+	resp *models.Item
+	err  error
+}
+
+// StubCreateItem sets the function that answers calls to CreateItem once its queued responses
+// are used up.
+func (f *Fake) StubCreateItem(stub func(ctx context.Context, i *models.Item) (*models.Item, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createItemStub = stub
+}
+
+// QueueCreateItem adds a response for a call to CreateItem.
+func (f *Fake) QueueCreateItem(resp *models.Item, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createItemQueue = append(f.createItemQueue, createItemResult{resp: resp, err: err})
+}
+
+// CreateItemCalls returns the calls made to CreateItem.
+func (f *Fake) CreateItemCalls() []CreateItemCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]CreateItemCall{}, f.createItemCalls...)
+}
+
+// CreateItem returns the next queued response or calls the stub.
+func (f *Fake) CreateItem(ctx context.Context, i *models.Item) (*models.Item, error) {
+	f.mu.Lock()
+	f.createItemCalls = append(f.createItemCalls, CreateItemCall{Ctx: ctx, Input: i})
+	if len(f.createItemQueue) > 0 {
+		result := f.createItemQueue[0]
+		f.createItemQueue = f.createItemQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.createItemStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.Item
+	return resp, notStubbed("CreateItem")
+}
+
+// CreateNoteCall records a call to CreateNote.
+type CreateNoteCall struct {
+	Ctx   context.Context
+	Input *models.Item
+}
+
+type createNoteResult struct {
+	resp *models.Item
+	err  error
+}
+
+// StubCreateNote sets the function that answers calls to CreateNote once its queued responses
+// are used up.
+func (f *Fake) StubCreateNote(stub func(ctx context.Context, i *models.Item) (*models.Item, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createNoteStub = stub
+}
+
+// QueueCreateNote adds a response for a call to CreateNote.
+func (f *Fake) QueueCreateNote(resp *models.Item, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createNoteQueue = append(f.createNoteQueue, createNoteResult{resp: resp, err: err})
+}
+
+// CreateNoteCalls returns the calls made to CreateNote.
+func (f *Fake) CreateNoteCalls() []CreateNoteCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]CreateNoteCall{}, f.createNoteCalls...)
+}
+
+// CreateNote returns the next queued response or calls the stub.
+func (f *Fake) CreateNote(ctx context.Context, i *models.Item) (*models.Item, error) {
+	f.mu.Lock()
+	f.createNoteCalls = append(f.createNoteCalls, CreateNoteCall{Ctx: ctx, Input: i})
+	if len(f.createNoteQueue) > 0 {
+		result := f.createNoteQueue[0]
+		f.createNoteQueue = f.createNoteQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.createNoteStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.Item
+	return resp, notStubbed("CreateNote")
+}
+
+// SleepCall records a call to Sleep.
+type SleepCall struct {
+	Ctx   context.Context
+	Input *models.SleepInput
+}
+
+type sleepResult struct {
+	err error
+}
+
+// StubSleep sets the function that answers calls to Sleep once its queued responses
+// are used up.
+func (f *Fake) StubSleep(stub func(ctx context.Context, i *models.SleepInput) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sleepStub = stub
+}
+
+// QueueSleep adds a response for a call to Sleep.
+func (f *Fake) QueueSleep(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sleepQueue = append(f.sleepQueue, sleepResult{err: err})
+}
+
+// SleepCalls returns the calls made to Sleep.
+func (f *Fake) SleepCalls() []SleepCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]SleepCall{}, f.sleepCalls...)
+}
+
+// Sleep returns the next queued response or calls the stub.
+func (f *Fake) Sleep(ctx context.Context, i *models.SleepInput) error {
+	f.mu.Lock()
+	f.sleepCalls = append(f.sleepCalls, SleepCall{Ctx: ctx, Input: i})
+	if len(f.sleepQueue) > 0 {
+		result := f.sleepQueue[0]
+		f.sleepQueue = f.sleepQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.sleepStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	return notStubbed("Sleep")
+}
+
+// SlowCall records a call to Slow.
+type SlowCall struct {
+	Ctx   context.Context
+	Input *models.SlowInput
+}
+
+type slowResult struct {
+	err error
+}
+
+// StubSlow sets the function that answers calls to Slow once its queued responses
+// are used up.
+func (f *Fake) StubSlow(stub func(ctx context.Context, i *models.SlowInput) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.slowStub = stub
+}
+
+// QueueSlow adds a response for a call to Slow.
+func (f *Fake) QueueSlow(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.slowQueue = append(f.slowQueue, slowResult{err: err})
+}
+
+// SlowCalls returns the calls made to Slow.
+func (f *Fake) SlowCalls() []SlowCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]SlowCall{}, f.slowCalls...)
+}
+
+// Slow returns the next queued response or calls the stub.
+func (f *Fake) Slow(ctx context.Context, i *models.SlowInput) error {
+	f.mu.Lock()
+	f.slowCalls = append(f.slowCalls, SlowCall{Ctx: ctx, Input: i})
+	if len(f.slowQueue) > 0 {
+		result := f.slowQueue[0]
+		f.slowQueue = f.slowQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.slowStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	return notStubbed("Slow")
+}
+
+// UnlimitedCall records a call to Unlimited.
+type UnlimitedCall struct {
+	Ctx context.Context
+}
+
+type unlimitedResult struct {
+	err error
+}
+
+// StubUnlimited sets the function that answers calls to Unlimited once its queued responses
+// are used up.
+func (f *Fake) StubUnlimited(stub func(ctx context.Context) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unlimitedStub = stub
+}
+
+// QueueUnlimited adds a response for a call to Unlimited.
+func (f *Fake) QueueUnlimited(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unlimitedQueue = append(f.unlimitedQueue, unlimitedResult{err: err})
+}
+
+// UnlimitedCalls returns the calls made to Unlimited.
+func (f *Fake) UnlimitedCalls() []UnlimitedCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]UnlimitedCall{}, f.unlimitedCalls...)
+}
+
+// Unlimited returns the next queued response or calls the stub.
+func (f *Fake) Unlimited(ctx context.Context) error {
+	f.mu.Lock()
+	f.unlimitedCalls = append(f.unlimitedCalls, UnlimitedCall{Ctx: ctx})
+	if len(f.unlimitedQueue) > 0 {
+		result := f.unlimitedQueue[0]
+		f.unlimitedQueue = f.unlimitedQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.unlimitedStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	return notStubbed("Unlimited")
+}
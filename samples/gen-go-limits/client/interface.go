@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-limits/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_client.go -package client --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-limits/models/v9
+
+// Client defines the methods available to clients of the limits-test service.
+type Client interface {
+
+	// LimitedByAuthenticatedCaller makes a GET request to /by-authenticated-caller
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	LimitedByAuthenticatedCaller(ctx context.Context) error
+
+	// LimitedByCaller makes a GET request to /by-caller
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	LimitedByCaller(ctx context.Context) error
+
+	// LimitedByHeader makes a GET request to /by-header
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 429: *models.TooManyRequests
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	LimitedByHeader(ctx context.Context) error
+
+	// LimitedByIP makes a GET request to /by-ip
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	LimitedByIP(ctx context.Context) error
+
+	// CreateItem makes a POST request to /items
+	//
+	// 200: *models.Item
+	// 400: *models.BadRequest
+	// 413: *models.PayloadTooLarge
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateItem(ctx context.Context, i *models.Item) (*models.Item, error)
+
+	// CreateNote makes a POST request to /notes
+	//
+	// 200: *models.Item
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateNote(ctx context.Context, i *models.Item) (*models.Item, error)
+
+	// Sleep makes a GET request to /sleep
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	Sleep(ctx context.Context, i *models.SleepInput) error
+
+	// Slow makes a GET request to /slow
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// 504: *models.GatewayTimeout
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	Slow(ctx context.Context, i *models.SlowInput) error
+
+	// Unlimited makes a GET request to /unlimited
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	Unlimited(ctx context.Context) error
+}
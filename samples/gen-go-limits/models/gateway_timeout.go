@@ -0,0 +1,43 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// GatewayTimeout gateway timeout
+//
+// swagger:model GatewayTimeout
+type GatewayTimeout struct {
+
+	// message
+	Message string `json:"message,omitempty"`
+}
+
+// Validate validates this gateway timeout
+func (m *GatewayTimeout) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GatewayTimeout) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GatewayTimeout) UnmarshalBinary(b []byte) error {
+	var res GatewayTimeout
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// These imports may not be used depending on the input parameters
+var _ = json.Marshal
+var _ = fmt.Sprintf
+var _ = url.QueryEscape
+var _ = strconv.FormatInt
+var _ = strings.Replace
+var _ = validate.Maximum
+var _ = strfmt.NewFormats
+
+// LimitedByAuthenticatedCallerInput holds the input parameters for a limitedByAuthenticatedCaller operation.
+type LimitedByAuthenticatedCallerInput struct {
+}
+
+// Validate returns an error if any of the LimitedByAuthenticatedCallerInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i LimitedByAuthenticatedCallerInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i LimitedByAuthenticatedCallerInput) Path() (string, error) {
+	path := "/v1/by-authenticated-caller"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// LimitedByCallerInput holds the input parameters for a limitedByCaller operation.
+type LimitedByCallerInput struct {
+}
+
+// Validate returns an error if any of the LimitedByCallerInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i LimitedByCallerInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i LimitedByCallerInput) Path() (string, error) {
+	path := "/v1/by-caller"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// LimitedByHeaderInput holds the input parameters for a limitedByHeader operation.
+type LimitedByHeaderInput struct {
+}
+
+// Validate returns an error if any of the LimitedByHeaderInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i LimitedByHeaderInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i LimitedByHeaderInput) Path() (string, error) {
+	path := "/v1/by-header"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// LimitedByIPInput holds the input parameters for a limitedByIP operation.
+type LimitedByIPInput struct {
+}
+
+// Validate returns an error if any of the LimitedByIPInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i LimitedByIPInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i LimitedByIPInput) Path() (string, error) {
+	path := "/v1/by-ip"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// SleepInput holds the input parameters for a sleep operation.
+type SleepInput struct {
+	Sleep int64
+}
+
+// Validate returns an error if any of the SleepInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i SleepInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i SleepInput) Path() (string, error) {
+	path := "/v1/sleep"
+	urlVals := url.Values{}
+
+	urlVals.Add("sleep", strconv.FormatInt(i.Sleep, 10))
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// SlowInput holds the input parameters for a slow operation.
+type SlowInput struct {
+	Sleep int64
+}
+
+// Validate returns an error if any of the SlowInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i SlowInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i SlowInput) Path() (string, error) {
+	path := "/v1/slow"
+	urlVals := url.Values{}
+
+	urlVals.Add("sleep", strconv.FormatInt(i.Sleep, 10))
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// UnlimitedInput holds the input parameters for a unlimited operation.
+type UnlimitedInput struct {
+}
+
+// Validate returns an error if any of the UnlimitedInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i UnlimitedInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i UnlimitedInput) Path() (string, error) {
+	path := "/v1/unlimited"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
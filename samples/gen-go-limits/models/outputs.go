@@ -0,0 +1,27 @@
+package models
+
+import "fmt"
+
+func (o BadRequest) Error() string {
+	return o.Message
+}
+
+func (o GatewayTimeout) Error() string {
+	return o.Message
+}
+
+func (o InternalError) Error() string {
+	return o.Message
+}
+
+func (o PayloadTooLarge) Error() string {
+	return o.Message
+}
+
+func (o TooManyRequests) Error() string {
+	return o.Message
+}
+
+func (u UnknownResponse) Error() string {
+	return fmt.Sprintf("unknown response with status: %d body: %s", u.StatusCode, u.Body)
+}
@@ -0,0 +1,43 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// PayloadTooLarge payload too large
+//
+// swagger:model PayloadTooLarge
+type PayloadTooLarge struct {
+
+	// message
+	Message string `json:"message,omitempty"`
+}
+
+// Validate validates this payload too large
+func (m *PayloadTooLarge) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PayloadTooLarge) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PayloadTooLarge) UnmarshalBinary(b []byte) error {
+	var res PayloadTooLarge
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,797 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Clever/kayvee-go/v7/logger"
+	"github.com/Clever/wag/samples/gen-go-limits/models/v9"
+	"github.com/go-errors/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"golang.org/x/xerrors"
+)
+
+var _ = strconv.ParseInt
+var _ = strfmt.Default
+var _ = swag.ConvertInt32
+var _ = errors.New
+var _ = mux.Vars
+var _ = bytes.Compare
+var _ = ioutil.ReadAll
+
+var formats = strfmt.Default
+var _ = formats
+
+// convertBase64 takes in a string and returns a strfmt.Base64 if the input
+// is valid base64 and an error otherwise.
+func convertBase64(input string) (strfmt.Base64, error) {
+	temp, err := formats.Parse("byte", input)
+	if err != nil {
+		return strfmt.Base64{}, err
+	}
+	return *temp.(*strfmt.Base64), nil
+}
+
+// convertDateTime takes in a string and returns a strfmt.DateTime if the input
+// is a valid DateTime and an error otherwise.
+func convertDateTime(input string) (strfmt.DateTime, error) {
+	temp, err := formats.Parse("date-time", input)
+	if err != nil {
+		return strfmt.DateTime{}, err
+	}
+	return *temp.(*strfmt.DateTime), nil
+}
+
+// convertDate takes in a string and returns a strfmt.Date if the input
+// is a valid Date and an error otherwise.
+func convertDate(input string) (strfmt.Date, error) {
+	temp, err := formats.Parse("date", input)
+	if err != nil {
+		return strfmt.Date{}, err
+	}
+	return *temp.(*strfmt.Date), nil
+}
+
+func jsonMarshalNoError(i interface{}) string {
+	bytes, err := json.Marshal(i)
+	if err != nil {
+		// This should never happen
+		return ""
+	}
+	return string(bytes)
+}
+
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+
+// statusCodeForLimitedByAuthenticatedCaller returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForLimitedByAuthenticatedCaller(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+// securityForLimitedByAuthenticatedCaller are the security requirements of limitedByAuthenticatedCaller.
+var securityForLimitedByAuthenticatedCaller = [][]securityRequirement{
+	{{scheme: SecuritySchemeAPIKey, scopes: nil}},
+}
+
+func (h handler) LimitedByAuthenticatedCallerHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	ctx, authErr := authenticate(ctx, h.Controller, r, "limitedByAuthenticatedCaller", securityForLimitedByAuthenticatedCaller)
+	if authErr != nil {
+		logger.FromContext(ctx).AddContext("error", authErr.Error())
+		statusCode := statusCodeForLimitedByAuthenticatedCaller(authErr)
+		if statusCode == -1 {
+			writeError(w, r, http.StatusUnauthorized, unauthorized{Message: authErr.Error()})
+			return
+		}
+		writeError(w, r, statusCode, authErr)
+		return
+	}
+
+	limit := RateLimit{Requests: 1, Interval: 1 * time.Hour, Burst: 1}
+	if ok, retryAfter := takeRateLimit(ctx, "limitedByAuthenticatedCaller", limit, rateLimitKey(ctx, r, "caller", "")); !ok {
+		w.Header().Set("Retry-After", retryAfterSeconds(retryAfter))
+		writeError(w, r, http.StatusTooManyRequests, map[string]string{"message": "rate limit exceeded"})
+		return
+	}
+
+	err := h.LimitedByAuthenticatedCaller(ctx)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForLimitedByAuthenticatedCaller(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newLimitedByAuthenticatedCallerInput takes in an http.Request an returns the input struct.
+func newLimitedByAuthenticatedCallerInput(r *http.Request) (*models.LimitedByAuthenticatedCallerInput, error) {
+	var input models.LimitedByAuthenticatedCallerInput
+
+	var err error
+	_ = err
+
+	return &input, nil
+}
+
+// statusCodeForLimitedByCaller returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForLimitedByCaller(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) LimitedByCallerHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	err := h.LimitedByCaller(ctx)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForLimitedByCaller(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newLimitedByCallerInput takes in an http.Request an returns the input struct.
+func newLimitedByCallerInput(r *http.Request) (*models.LimitedByCallerInput, error) {
+	var input models.LimitedByCallerInput
+
+	var err error
+	_ = err
+
+	return &input, nil
+}
+
+// statusCodeForLimitedByHeader returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForLimitedByHeader(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.TooManyRequests:
+		return 429
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.TooManyRequests:
+		return 429
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) LimitedByHeaderHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	err := h.LimitedByHeader(ctx)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForLimitedByHeader(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newLimitedByHeaderInput takes in an http.Request an returns the input struct.
+func newLimitedByHeaderInput(r *http.Request) (*models.LimitedByHeaderInput, error) {
+	var input models.LimitedByHeaderInput
+
+	var err error
+	_ = err
+
+	return &input, nil
+}
+
+// statusCodeForLimitedByIP returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForLimitedByIP(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) LimitedByIPHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	err := h.LimitedByIP(ctx)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForLimitedByIP(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newLimitedByIPInput takes in an http.Request an returns the input struct.
+func newLimitedByIPInput(r *http.Request) (*models.LimitedByIPInput, error) {
+	var input models.LimitedByIPInput
+
+	var err error
+	_ = err
+
+	return &input, nil
+}
+
+// statusCodeForCreateItem returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForCreateItem(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.Item:
+		return 200
+
+	case *models.PayloadTooLarge:
+		return 413
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.Item:
+		return 200
+
+	case models.PayloadTooLarge:
+		return 413
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) CreateItemHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newCreateItemInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, models.PayloadTooLarge{Message: tooLarge.Error()})
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	if input != nil {
+		err = input.Validate(nil)
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	resp, err := h.CreateItem(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForCreateItem(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "createItem", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForCreateItem(resp))
+	w.Write(respBytes)
+
+}
+
+// newCreateItemInput takes in an http.Request an returns the input struct.
+func newCreateItemInput(r *http.Request) (*models.Item, error) {
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+	if len(data) > 0 {
+		var input models.Item
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+		return &input, nil
+	}
+
+	return nil, nil
+}
+
+// statusCodeForCreateNote returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForCreateNote(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.Item:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.Item:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) CreateNoteHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newCreateNoteInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	if input != nil {
+		err = input.Validate(nil)
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	resp, err := h.CreateNote(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForCreateNote(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "createNote", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForCreateNote(resp))
+	w.Write(respBytes)
+
+}
+
+// newCreateNoteInput takes in an http.Request an returns the input struct.
+func newCreateNoteInput(r *http.Request) (*models.Item, error) {
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+	if len(data) > 0 {
+		var input models.Item
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+		return &input, nil
+	}
+
+	return nil, nil
+}
+
+// statusCodeForSleep returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForSleep(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) SleepHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newSleepInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	err = h.Sleep(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForSleep(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newSleepInput takes in an http.Request an returns the input struct.
+func newSleepInput(r *http.Request) (*models.SleepInput, error) {
+	var input models.SleepInput
+
+	var err error
+	_ = err
+
+	sleepStrs := r.URL.Query()["sleep"]
+	if len(sleepStrs) == 0 {
+		return nil, errors.New("query parameter 'sleep' must be specified")
+	}
+
+	if len(sleepStrs) > 0 {
+		var sleepTmp int64
+		sleepStr := sleepStrs[0]
+		sleepTmp, err = swag.ConvertInt64(sleepStr)
+		if err != nil {
+			return nil, err
+		}
+		input.Sleep = sleepTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForSlow returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForSlow(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.GatewayTimeout:
+		return 504
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.GatewayTimeout:
+		return 504
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) SlowHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newSlowInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	err = h.Slow(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForSlow(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newSlowInput takes in an http.Request an returns the input struct.
+func newSlowInput(r *http.Request) (*models.SlowInput, error) {
+	var input models.SlowInput
+
+	var err error
+	_ = err
+
+	sleepStrs := r.URL.Query()["sleep"]
+	if len(sleepStrs) == 0 {
+		return nil, errors.New("query parameter 'sleep' must be specified")
+	}
+
+	if len(sleepStrs) > 0 {
+		var sleepTmp int64
+		sleepStr := sleepStrs[0]
+		sleepTmp, err = swag.ConvertInt64(sleepStr)
+		if err != nil {
+			return nil, err
+		}
+		input.Sleep = sleepTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForUnlimited returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForUnlimited(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) UnlimitedHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	err := h.Unlimited(ctx)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForUnlimited(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newUnlimitedInput takes in an http.Request an returns the input struct.
+func newUnlimitedInput(r *http.Request) (*models.UnlimitedInput, error) {
+	var input models.UnlimitedInput
+
+	var err error
+	_ = err
+
+	return &input, nil
+}
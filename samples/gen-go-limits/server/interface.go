@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-limits/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_controller.go -package server --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-limits/models/v9
+
+// Authenticator authenticates requests to operations with security requirements.
+type Authenticator interface {
+	// Authenticate checks the credentials a request presented for one of the operation's
+	// security schemes. scopes are the scopes the operation requires for that scheme. It's called
+	// before the controller method, once per scheme, and the returned context is passed on to the
+	// controller method, so it can carry the authenticated caller.
+	// Errors that match one of the operation's responses are returned with that status code,
+	// all other errors are returned as a 401.
+	Authenticate(ctx context.Context, op string, creds Credentials, scopes []string) (context.Context, error)
+}
+
+// Controller defines the interface for the limits-test service.
+type Controller interface {
+	Authenticator
+
+	// LimitedByAuthenticatedCaller handles GET requests to /by-authenticated-caller
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	LimitedByAuthenticatedCaller(ctx context.Context) error
+
+	// LimitedByCaller handles GET requests to /by-caller
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	LimitedByCaller(ctx context.Context) error
+
+	// LimitedByHeader handles GET requests to /by-header
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 429: *models.TooManyRequests
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	LimitedByHeader(ctx context.Context) error
+
+	// LimitedByIP handles GET requests to /by-ip
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	LimitedByIP(ctx context.Context) error
+
+	// CreateItem handles POST requests to /items
+	//
+	// 200: *models.Item
+	// 400: *models.BadRequest
+	// 413: *models.PayloadTooLarge
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateItem(ctx context.Context, i *models.Item) (*models.Item, error)
+
+	// CreateNote handles POST requests to /notes
+	//
+	// 200: *models.Item
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateNote(ctx context.Context, i *models.Item) (*models.Item, error)
+
+	// Sleep handles GET requests to /sleep
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	Sleep(ctx context.Context, i *models.SleepInput) error
+
+	// Slow handles GET requests to /slow
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// 504: *models.GatewayTimeout
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	Slow(ctx context.Context, i *models.SlowInput) error
+
+	// Unlimited handles GET requests to /unlimited
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	Unlimited(ctx context.Context) error
+}
@@ -0,0 +1,217 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+
+// Package server is a generated GoMock package.
+package server
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v9 "github.com/Clever/wag/samples/gen-go-limits/models/v9"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthenticator is a mock of Authenticator interface.
+type MockAuthenticator struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthenticatorMockRecorder
+}
+
+// MockAuthenticatorMockRecorder is the mock recorder for MockAuthenticator.
+type MockAuthenticatorMockRecorder struct {
+	mock *MockAuthenticator
+}
+
+// NewMockAuthenticator creates a new mock instance.
+func NewMockAuthenticator(ctrl *gomock.Controller) *MockAuthenticator {
+	mock := &MockAuthenticator{ctrl: ctrl}
+	mock.recorder = &MockAuthenticatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthenticator) EXPECT() *MockAuthenticatorMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAuthenticator) Authenticate(ctx context.Context, op string, creds Credentials, scopes []string) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, op, creds, scopes)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAuthenticatorMockRecorder) Authenticate(ctx, op, creds, scopes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAuthenticator)(nil).Authenticate), ctx, op, creds, scopes)
+}
+
+// MockController is a mock of Controller interface.
+type MockController struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerMockRecorder
+}
+
+// MockControllerMockRecorder is the mock recorder for MockController.
+type MockControllerMockRecorder struct {
+	mock *MockController
+}
+
+// NewMockController creates a new mock instance.
+func NewMockController(ctrl *gomock.Controller) *MockController {
+	mock := &MockController{ctrl: ctrl}
+	mock.recorder = &MockControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockController) EXPECT() *MockControllerMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockController) Authenticate(ctx context.Context, op string, creds Credentials, scopes []string) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, op, creds, scopes)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockControllerMockRecorder) Authenticate(ctx, op, creds, scopes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockController)(nil).Authenticate), ctx, op, creds, scopes)
+}
+
+// CreateItem mocks base method.
+func (m *MockController) CreateItem(ctx context.Context, i *v9.Item) (*v9.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItem", ctx, i)
+	ret0, _ := ret[0].(*v9.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateItem indicates an expected call of CreateItem.
+func (mr *MockControllerMockRecorder) CreateItem(ctx, i interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockController)(nil).CreateItem), ctx, i)
+}
+
+// CreateNote mocks base method.
+func (m *MockController) CreateNote(ctx context.Context, i *v9.Item) (*v9.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNote", ctx, i)
+	ret0, _ := ret[0].(*v9.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNote indicates an expected call of CreateNote.
+func (mr *MockControllerMockRecorder) CreateNote(ctx, i interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNote", reflect.TypeOf((*MockController)(nil).CreateNote), ctx, i)
+}
+
+// LimitedByAuthenticatedCaller mocks base method.
+func (m *MockController) LimitedByAuthenticatedCaller(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitedByAuthenticatedCaller", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LimitedByAuthenticatedCaller indicates an expected call of LimitedByAuthenticatedCaller.
+func (mr *MockControllerMockRecorder) LimitedByAuthenticatedCaller(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitedByAuthenticatedCaller", reflect.TypeOf((*MockController)(nil).LimitedByAuthenticatedCaller), ctx)
+}
+
+// LimitedByCaller mocks base method.
+func (m *MockController) LimitedByCaller(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitedByCaller", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LimitedByCaller indicates an expected call of LimitedByCaller.
+func (mr *MockControllerMockRecorder) LimitedByCaller(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitedByCaller", reflect.TypeOf((*MockController)(nil).LimitedByCaller), ctx)
+}
+
+// LimitedByHeader mocks base method.
+func (m *MockController) LimitedByHeader(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitedByHeader", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LimitedByHeader indicates an expected call of LimitedByHeader.
+func (mr *MockControllerMockRecorder) LimitedByHeader(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitedByHeader", reflect.TypeOf((*MockController)(nil).LimitedByHeader), ctx)
+}
+
+// LimitedByIP mocks base method.
+func (m *MockController) LimitedByIP(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitedByIP", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LimitedByIP indicates an expected call of LimitedByIP.
+func (mr *MockControllerMockRecorder) LimitedByIP(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitedByIP", reflect.TypeOf((*MockController)(nil).LimitedByIP), ctx)
+}
+
+// Sleep mocks base method.
+func (m *MockController) Sleep(ctx context.Context, i *v9.SleepInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sleep", ctx, i)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sleep indicates an expected call of Sleep.
+func (mr *MockControllerMockRecorder) Sleep(ctx, i interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sleep", reflect.TypeOf((*MockController)(nil).Sleep), ctx, i)
+}
+
+// Slow mocks base method.
+func (m *MockController) Slow(ctx context.Context, i *v9.SlowInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Slow", ctx, i)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Slow indicates an expected call of Slow.
+func (mr *MockControllerMockRecorder) Slow(ctx, i interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slow", reflect.TypeOf((*MockController)(nil).Slow), ctx, i)
+}
+
+// Unlimited mocks base method.
+func (m *MockController) Unlimited(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlimited", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlimited indicates an expected call of Unlimited.
+func (mr *MockControllerMockRecorder) Unlimited(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlimited", reflect.TypeOf((*MockController)(nil).Unlimited), ctx)
+}
@@ -0,0 +1,86 @@
+// Package clientfake has an in-memory implementation of the nil-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-nils/client/v9"
+	"github.com/Clever/wag/samples/gen-go-nils/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	nilCheckStub  func(ctx context.Context, i *models.NilCheckInput) error
+	nilCheckQueue []nilCheckResult
+	nilCheckCalls []NilCheckCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// NilCheckCall records a call to NilCheck.
+type NilCheckCall struct {
+	Ctx   context.Context
+	Input *models.NilCheckInput
+}
+
+type nilCheckResult struct {
+	err error
+}
+
+// StubNilCheck sets the function that answers calls to NilCheck once its queued responses
+// are used up.
+func (f *Fake) StubNilCheck(stub func(ctx context.Context, i *models.NilCheckInput) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nilCheckStub = stub
+}
+
+// QueueNilCheck adds a response for a call to NilCheck.
+func (f *Fake) QueueNilCheck(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nilCheckQueue = append(f.nilCheckQueue, nilCheckResult{err: err})
+}
+
+// NilCheckCalls returns the calls made to NilCheck.
+func (f *Fake) NilCheckCalls() []NilCheckCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]NilCheckCall{}, f.nilCheckCalls...)
+}
+
+// NilCheck returns the next queued response or calls the stub.
+func (f *Fake) NilCheck(ctx context.Context, i *models.NilCheckInput) error {
+	f.mu.Lock()
+	f.nilCheckCalls = append(f.nilCheckCalls, NilCheckCall{Ctx: ctx, Input: i})
+	if len(f.nilCheckQueue) > 0 {
+		result := f.nilCheckQueue[0]
+		f.nilCheckQueue = f.nilCheckQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.nilCheckStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	return notStubbed("NilCheck")
+}
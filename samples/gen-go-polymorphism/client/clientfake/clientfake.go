@@ -0,0 +1,257 @@
+// Package clientfake has an in-memory implementation of the polymorphism-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-polymorphism/client/v9"
+	"github.com/Clever/wag/samples/gen-go-polymorphism/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	listEventsStub  func(ctx context.Context) ([]models.Event, error)
+	listEventsQueue []listEventsResult
+	listEventsCalls []ListEventsCall
+
+	createEventStub  func(ctx context.Context, i models.Event) (models.Event, error)
+	createEventQueue []createEventResult
+	createEventCalls []CreateEventCall
+
+	putEventStub  func(ctx context.Context, i *models.PutEventInput) (*models.PutEventResponse, error)
+	putEventQueue []putEventResult
+	putEventCalls []PutEventCall
+
+	getFeedStub  func(ctx context.Context) (*models.Feed, error)
+	getFeedQueue []getFeedResult
+	getFeedCalls []GetFeedCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// ListEventsCall records a call to ListEvents.
+type ListEventsCall struct {
+	Ctx context.Context
+}
+
+type listEventsResult struct {
+	resp []models.Event
+	err  error
+}
+
+// StubListEvents sets the function that answers calls to ListEvents once its queued responses
+// are used up.
+func (f *Fake) StubListEvents(stub func(ctx context.Context) ([]models.Event, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listEventsStub = stub
+}
+
+// QueueListEvents adds a response for a call to ListEvents.
+func (f *Fake) QueueListEvents(resp []models.Event, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listEventsQueue = append(f.listEventsQueue, listEventsResult{resp: resp, err: err})
+}
+
+// ListEventsCalls returns the calls made to ListEvents.
+func (f *Fake) ListEventsCalls() []ListEventsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]ListEventsCall{}, f.listEventsCalls...)
+}
+
+// ListEvents returns the next queued response or calls the stub.
+func (f *Fake) ListEvents(ctx context.Context) ([]models.Event, error) {
+	f.mu.Lock()
+	f.listEventsCalls = append(f.listEventsCalls, ListEventsCall{Ctx: ctx})
+	if len(f.listEventsQueue) > 0 {
+		result := f.listEventsQueue[0]
+		f.listEventsQueue = f.listEventsQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.listEventsStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	var resp []models.Event
+	return resp, notStubbed("ListEvents")
+}
+
+// CreateEventCall records a call to CreateEvent.
+type CreateEventCall struct {
+	Ctx   context.Context
+	Input models.Event
+}
+
+type createEventResult struct {
+	resp models.Event
+	err  error
+}
+
+// StubCreateEvent sets the function that answers calls to CreateEvent once its queued responses
+// are used up.
+func (f *Fake) StubCreateEvent(stub func(ctx context.Context, i models.Event) (models.Event, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createEventStub = stub
+}
+
+// QueueCreateEvent adds a response for a call to CreateEvent.
+func (f *Fake) QueueCreateEvent(resp models.Event, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createEventQueue = append(f.createEventQueue, createEventResult{resp: resp, err: err})
+}
+
+// CreateEventCalls returns the calls made to CreateEvent.
+func (f *Fake) CreateEventCalls() []CreateEventCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]CreateEventCall{}, f.createEventCalls...)
+}
+
+// CreateEvent returns the next queued response or calls the stub.
+func (f *Fake) CreateEvent(ctx context.Context, i models.Event) (models.Event, error) {
+	f.mu.Lock()
+	f.createEventCalls = append(f.createEventCalls, CreateEventCall{Ctx: ctx, Input: i})
+	if len(f.createEventQueue) > 0 {
+		result := f.createEventQueue[0]
+		f.createEventQueue = f.createEventQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.createEventStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp models.Event
+	return resp, notStubbed("CreateEvent")
+}
+
+// PutEventCall records a call to PutEvent.
+type PutEventCall struct {
+	Ctx   context.Context
+	Input *models.PutEventInput
+}
+
+type putEventResult struct {
+	resp *models.PutEventResponse
+	err  error
+}
+
+// StubPutEvent sets the function that answers calls to PutEvent once its queued responses
+// are used up.
+func (f *Fake) StubPutEvent(stub func(ctx context.Context, i *models.PutEventInput) (*models.PutEventResponse, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.putEventStub = stub
+}
+
+// QueuePutEvent adds a response for a call to PutEvent.
+func (f *Fake) QueuePutEvent(resp *models.PutEventResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.putEventQueue = append(f.putEventQueue, putEventResult{resp: resp, err: err})
+}
+
+// PutEventCalls returns the calls made to PutEvent.
+func (f *Fake) PutEventCalls() []PutEventCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]PutEventCall{}, f.putEventCalls...)
+}
+
+// PutEvent returns the next queued response or calls the stub.
+func (f *Fake) PutEvent(ctx context.Context, i *models.PutEventInput) (*models.PutEventResponse, error) {
+	f.mu.Lock()
+	f.putEventCalls = append(f.putEventCalls, PutEventCall{Ctx: ctx, Input: i})
+	if len(f.putEventQueue) > 0 {
+		result := f.putEventQueue[0]
+		f.putEventQueue = f.putEventQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.putEventStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.PutEventResponse
+	return resp, notStubbed("PutEvent")
+}
+
+// GetFeedCall records a call to GetFeed.
+type GetFeedCall struct {
+	Ctx context.Context
+}
+
+type getFeedResult struct {
+	resp *models.Feed
+	err  error
+}
+
+// StubGetFeed sets the function that answers calls to GetFeed once its queued responses
+// are used up.
+func (f *Fake) StubGetFeed(stub func(ctx context.Context) (*models.Feed, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getFeedStub = stub
+}
+
+// QueueGetFeed adds a response for a call to GetFeed.
+func (f *Fake) QueueGetFeed(resp *models.Feed, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getFeedQueue = append(f.getFeedQueue, getFeedResult{resp: resp, err: err})
+}
+
+// GetFeedCalls returns the calls made to GetFeed.
+func (f *Fake) GetFeedCalls() []GetFeedCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetFeedCall{}, f.getFeedCalls...)
+}
+
+// GetFeed returns the next queued response or calls the stub.
+func (f *Fake) GetFeed(ctx context.Context) (*models.Feed, error) {
+	f.mu.Lock()
+	f.getFeedCalls = append(f.getFeedCalls, GetFeedCall{Ctx: ctx})
+	if len(f.getFeedQueue) > 0 {
+		result := f.getFeedQueue[0]
+		f.getFeedQueue = f.getFeedQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getFeedStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	var resp *models.Feed
+	return resp, notStubbed("GetFeed")
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-polymorphism/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_client.go -package client --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-polymorphism/models/v9
+
+// Client defines the methods available to clients of the polymorphism-test service.
+type Client interface {
+
+	// ListEvents makes a GET request to /events
+	//
+	// 200: []models.Event
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	ListEvents(ctx context.Context) ([]models.Event, error)
+
+	// CreateEvent makes a POST request to /events
+	//
+	// 200: models.Event
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateEvent(ctx context.Context, i models.Event) (models.Event, error)
+
+	// PutEvent makes a PUT request to /events/{id}
+	//
+	// 200: models.Event
+	// 201: models.Event
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	PutEvent(ctx context.Context, i *models.PutEventInput) (*models.PutEventResponse, error)
+
+	// GetFeed makes a GET request to /feed
+	//
+	// 200: *models.Feed
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetFeed(ctx context.Context) (*models.Feed, error)
+}
@@ -0,0 +1,192 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Click click
+//
+// swagger:model Click
+type Click struct {
+	idField *string
+
+	// count
+	Count int64 `json:"count,omitempty"`
+
+	// element
+	// Required: true
+	Element *string `json:"element"`
+}
+
+// ID gets the id of this subtype
+func (m *Click) ID() *string {
+	return m.idField
+}
+
+// SetID sets the id of this subtype
+func (m *Click) SetID(val *string) {
+	m.idField = val
+}
+
+// Kind gets the kind of this subtype
+func (m *Click) Kind() string {
+	return "Click"
+}
+
+// SetKind sets the kind of this subtype
+func (m *Click) SetKind(val string) {
+}
+
+// UnmarshalJSON unmarshals this object with a polymorphic type from a JSON structure
+func (m *Click) UnmarshalJSON(raw []byte) error {
+	var data struct {
+
+		// count
+		Count int64 `json:"count,omitempty"`
+
+		// element
+		// Required: true
+		Element *string `json:"element"`
+	}
+	buf := bytes.NewBuffer(raw)
+	dec := json.NewDecoder(buf)
+	dec.UseNumber()
+
+	if err := dec.Decode(&data); err != nil {
+		return err
+	}
+
+	var base struct {
+		/* Just the base type fields. Used for unmashalling polymorphic types.*/
+
+		ID *string `json:"id"`
+
+		Kind string `json:"kind"`
+	}
+	buf = bytes.NewBuffer(raw)
+	dec = json.NewDecoder(buf)
+	dec.UseNumber()
+
+	if err := dec.Decode(&base); err != nil {
+		return err
+	}
+
+	var result Click
+
+	result.idField = base.ID
+
+	if base.Kind != result.Kind() {
+		/* Not the type we're looking for. */
+		return errors.New(422, "invalid kind value: %q", base.Kind)
+	}
+
+	result.Count = data.Count
+	result.Element = data.Element
+
+	*m = result
+
+	return nil
+}
+
+// MarshalJSON marshals this object with a polymorphic type to a JSON structure
+func (m Click) MarshalJSON() ([]byte, error) {
+	var b1, b2, b3 []byte
+	var err error
+	b1, err = json.Marshal(struct {
+
+		// count
+		Count int64 `json:"count,omitempty"`
+
+		// element
+		// Required: true
+		Element *string `json:"element"`
+	}{
+
+		Count: m.Count,
+
+		Element: m.Element,
+	})
+	if err != nil {
+		return nil, err
+	}
+	b2, err = json.Marshal(struct {
+		ID *string `json:"id"`
+
+		Kind string `json:"kind"`
+	}{
+
+		ID: m.ID(),
+
+		Kind: m.Kind(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swag.ConcatJSON(b1, b2, b3), nil
+}
+
+// Validate validates this click
+func (m *Click) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateElement(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Click) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Click) validateElement(formats strfmt.Registry) error {
+
+	if err := validate.Required("element", "body", m.Element); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Click) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Click) UnmarshalBinary(b []byte) error {
+	var res Click
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,154 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// Event event
+//
+// swagger:discriminator Event kind
+type Event interface {
+	runtime.Validatable
+
+	// id
+	// Required: true
+	ID() *string
+	SetID(*string)
+
+	// kind
+	// Required: true
+	Kind() string
+	SetKind(string)
+
+	// AdditionalProperties in base type shoud be handled just like regular properties
+	// At this moment, the base type property is pushed down to the subtype
+}
+
+type event struct {
+	idField *string
+
+	kindField string
+}
+
+// ID gets the id of this polymorphic type
+func (m *event) ID() *string {
+	return m.idField
+}
+
+// SetID sets the id of this polymorphic type
+func (m *event) SetID(val *string) {
+	m.idField = val
+}
+
+// Kind gets the kind of this polymorphic type
+func (m *event) Kind() string {
+	return "Event"
+}
+
+// SetKind sets the kind of this polymorphic type
+func (m *event) SetKind(val string) {
+}
+
+// UnmarshalEventSlice unmarshals polymorphic slices of Event
+func UnmarshalEventSlice(reader io.Reader, consumer runtime.Consumer) ([]Event, error) {
+	var elements []json.RawMessage
+	if err := consumer.Consume(reader, &elements); err != nil {
+		return nil, err
+	}
+
+	var result []Event
+	for _, element := range elements {
+		obj, err := unmarshalEvent(element, consumer)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, obj)
+	}
+	return result, nil
+}
+
+// UnmarshalEvent unmarshals polymorphic Event
+func UnmarshalEvent(reader io.Reader, consumer runtime.Consumer) (Event, error) {
+	// we need to read this twice, so first into a buffer
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalEvent(data, consumer)
+}
+
+func unmarshalEvent(data []byte, consumer runtime.Consumer) (Event, error) {
+	buf := bytes.NewBuffer(data)
+	buf2 := bytes.NewBuffer(data)
+
+	// the first time this is read is to fetch the value of the kind property.
+	var getType struct {
+		Kind string `json:"kind"`
+	}
+	if err := consumer.Consume(buf, &getType); err != nil {
+		return nil, err
+	}
+
+	if err := validate.RequiredString("kind", "body", getType.Kind); err != nil {
+		return nil, err
+	}
+
+	// The value of kind is used to determine which type to create and unmarshal the data into
+	switch getType.Kind {
+	case "Click":
+		var result Click
+		if err := consumer.Consume(buf2, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	case "Event":
+		var result event
+		if err := consumer.Consume(buf2, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	case "Purchase":
+		var result Purchase
+		if err := consumer.Consume(buf2, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+	return nil, errors.New(422, "invalid kind value: %q", getType.Kind)
+}
+
+// Validate validates this event
+func (m *event) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *event) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID()); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// Feed feed
+//
+// swagger:model Feed
+type Feed struct {
+	eventsField []Event
+
+	latestField Event
+}
+
+// Events gets the events of this base type
+func (m *Feed) Events() []Event {
+	return m.eventsField
+}
+
+// SetEvents sets the events of this base type
+func (m *Feed) SetEvents(val []Event) {
+	m.eventsField = val
+}
+
+// Latest gets the latest of this base type
+func (m *Feed) Latest() Event {
+	return m.latestField
+}
+
+// SetLatest sets the latest of this base type
+func (m *Feed) SetLatest(val Event) {
+	m.latestField = val
+}
+
+// UnmarshalJSON unmarshals this object with a polymorphic type from a JSON structure
+func (m *Feed) UnmarshalJSON(raw []byte) error {
+	var data struct {
+		Events json.RawMessage `json:"events"`
+
+		Latest json.RawMessage `json:"latest,omitempty"`
+	}
+	buf := bytes.NewBuffer(raw)
+	dec := json.NewDecoder(buf)
+	dec.UseNumber()
+
+	if err := dec.Decode(&data); err != nil {
+		return err
+	}
+
+	var propEvents []Event
+	if string(data.Events) != "null" {
+		events, err := UnmarshalEventSlice(bytes.NewBuffer(data.Events), runtime.JSONConsumer())
+		if err != nil && err != io.EOF {
+			return err
+		}
+		propEvents = events
+	}
+	var propLatest Event
+	if string(data.Latest) != "null" {
+		latest, err := UnmarshalEvent(bytes.NewBuffer(data.Latest), runtime.JSONConsumer())
+		if err != nil && err != io.EOF {
+			return err
+		}
+		propLatest = latest
+	}
+
+	var result Feed
+
+	// events
+	result.eventsField = propEvents
+
+	// latest
+	result.latestField = propLatest
+
+	*m = result
+
+	return nil
+}
+
+// MarshalJSON marshals this object with a polymorphic type to a JSON structure
+func (m Feed) MarshalJSON() ([]byte, error) {
+	var b1, b2, b3 []byte
+	var err error
+	b1, err = json.Marshal(struct {
+	}{})
+	if err != nil {
+		return nil, err
+	}
+	b2, err = json.Marshal(struct {
+		Events []Event `json:"events"`
+
+		Latest Event `json:"latest,omitempty"`
+	}{
+
+		Events: m.eventsField,
+
+		Latest: m.latestField,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swag.ConcatJSON(b1, b2, b3), nil
+}
+
+// Validate validates this feed
+func (m *Feed) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEvents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLatest(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Feed) validateEvents(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Events()) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Events()); i++ {
+
+		if err := m.eventsField[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("events" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Feed) validateLatest(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Latest()) { // not required
+		return nil
+	}
+
+	if err := m.Latest().Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("latest")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Feed) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Feed) UnmarshalBinary(b []byte) error {
+	var res Feed
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// These imports may not be used depending on the input parameters
+var _ = json.Marshal
+var _ = fmt.Sprintf
+var _ = url.QueryEscape
+var _ = strconv.FormatInt
+var _ = strings.Replace
+var _ = validate.Maximum
+var _ = strfmt.NewFormats
+
+// ListEventsInput holds the input parameters for a listEvents operation.
+type ListEventsInput struct {
+}
+
+// Validate returns an error if any of the ListEventsInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i ListEventsInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i ListEventsInput) Path() (string, error) {
+	path := "/v1/events"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// PutEventInput holds the input parameters for a putEvent operation.
+type PutEventInput struct {
+	ID    string
+	Event Event
+}
+
+// Validate returns an error if any of the PutEventInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i PutEventInput) Validate() error {
+
+	if i.Event != nil {
+		if err := i.Event.Validate(nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i PutEventInput) Path() (string, error) {
+	path := "/v1/events/{id}"
+	urlVals := url.Values{}
+
+	pathid := i.ID
+	if pathid == "" {
+		err := fmt.Errorf("id cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{id}", pathid, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetFeedInput holds the input parameters for a getFeed operation.
+type GetFeedInput struct {
+}
+
+// Validate returns an error if any of the GetFeedInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetFeedInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetFeedInput) Path() (string, error) {
+	path := "/v1/feed"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
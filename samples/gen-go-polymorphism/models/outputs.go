@@ -0,0 +1,25 @@
+package models
+
+import "fmt"
+
+func (o BadRequest) Error() string {
+	return o.Message
+}
+
+func (o InternalError) Error() string {
+	return o.Message
+}
+
+func (u UnknownResponse) Error() string {
+	return fmt.Sprintf("unknown response with status: %d body: %s", u.StatusCode, u.Body)
+}
+
+// PutEventResponse holds the success responses of the putEvent operation. StatusCode is the status
+// code of the response, one of 200, 201, and the field for that status code holds its body.
+type PutEventResponse struct {
+	StatusCode int
+	// OK is the body of a 200 response.
+	OK Event
+	// Created is the body of a 201 response.
+	Created Event
+}
@@ -0,0 +1,187 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Purchase purchase
+//
+// swagger:model Purchase
+type Purchase struct {
+	idField *string
+
+	// amount
+	// Required: true
+	// Minimum: 0
+	Amount *float64 `json:"amount"`
+}
+
+// ID gets the id of this subtype
+func (m *Purchase) ID() *string {
+	return m.idField
+}
+
+// SetID sets the id of this subtype
+func (m *Purchase) SetID(val *string) {
+	m.idField = val
+}
+
+// Kind gets the kind of this subtype
+func (m *Purchase) Kind() string {
+	return "Purchase"
+}
+
+// SetKind sets the kind of this subtype
+func (m *Purchase) SetKind(val string) {
+}
+
+// UnmarshalJSON unmarshals this object with a polymorphic type from a JSON structure
+func (m *Purchase) UnmarshalJSON(raw []byte) error {
+	var data struct {
+
+		// amount
+		// Required: true
+		// Minimum: 0
+		Amount *float64 `json:"amount"`
+	}
+	buf := bytes.NewBuffer(raw)
+	dec := json.NewDecoder(buf)
+	dec.UseNumber()
+
+	if err := dec.Decode(&data); err != nil {
+		return err
+	}
+
+	var base struct {
+		/* Just the base type fields. Used for unmashalling polymorphic types.*/
+
+		ID *string `json:"id"`
+
+		Kind string `json:"kind"`
+	}
+	buf = bytes.NewBuffer(raw)
+	dec = json.NewDecoder(buf)
+	dec.UseNumber()
+
+	if err := dec.Decode(&base); err != nil {
+		return err
+	}
+
+	var result Purchase
+
+	result.idField = base.ID
+
+	if base.Kind != result.Kind() {
+		/* Not the type we're looking for. */
+		return errors.New(422, "invalid kind value: %q", base.Kind)
+	}
+
+	result.Amount = data.Amount
+
+	*m = result
+
+	return nil
+}
+
+// MarshalJSON marshals this object with a polymorphic type to a JSON structure
+func (m Purchase) MarshalJSON() ([]byte, error) {
+	var b1, b2, b3 []byte
+	var err error
+	b1, err = json.Marshal(struct {
+
+		// amount
+		// Required: true
+		// Minimum: 0
+		Amount *float64 `json:"amount"`
+	}{
+
+		Amount: m.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	b2, err = json.Marshal(struct {
+		ID *string `json:"id"`
+
+		Kind string `json:"kind"`
+	}{
+
+		ID: m.ID(),
+
+		Kind: m.Kind(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swag.ConcatJSON(b1, b2, b3), nil
+}
+
+// Validate validates this purchase
+func (m *Purchase) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Purchase) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Purchase) validateAmount(formats strfmt.Registry) error {
+
+	if err := validate.Required("amount", "body", m.Amount); err != nil {
+		return err
+	}
+
+	if err := validate.Minimum("amount", "body", float64(*m.Amount), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Purchase) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Purchase) UnmarshalBinary(b []byte) error {
+	var res Purchase
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
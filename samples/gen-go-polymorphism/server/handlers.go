@@ -0,0 +1,460 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/Clever/kayvee-go/v7/logger"
+	"github.com/Clever/wag/samples/gen-go-polymorphism/models/v9"
+	"github.com/go-errors/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"golang.org/x/xerrors"
+)
+
+var _ = strconv.ParseInt
+var _ = strfmt.Default
+var _ = swag.ConvertInt32
+var _ = errors.New
+var _ = mux.Vars
+var _ = bytes.Compare
+var _ = ioutil.ReadAll
+var _ = runtime.JSONConsumer
+
+var formats = strfmt.Default
+var _ = formats
+
+// convertBase64 takes in a string and returns a strfmt.Base64 if the input
+// is valid base64 and an error otherwise.
+func convertBase64(input string) (strfmt.Base64, error) {
+	temp, err := formats.Parse("byte", input)
+	if err != nil {
+		return strfmt.Base64{}, err
+	}
+	return *temp.(*strfmt.Base64), nil
+}
+
+// convertDateTime takes in a string and returns a strfmt.DateTime if the input
+// is a valid DateTime and an error otherwise.
+func convertDateTime(input string) (strfmt.DateTime, error) {
+	temp, err := formats.Parse("date-time", input)
+	if err != nil {
+		return strfmt.DateTime{}, err
+	}
+	return *temp.(*strfmt.DateTime), nil
+}
+
+// convertDate takes in a string and returns a strfmt.Date if the input
+// is a valid Date and an error otherwise.
+func convertDate(input string) (strfmt.Date, error) {
+	temp, err := formats.Parse("date", input)
+	if err != nil {
+		return strfmt.Date{}, err
+	}
+	return *temp.(*strfmt.Date), nil
+}
+
+func jsonMarshalNoError(i interface{}) string {
+	bytes, err := json.Marshal(i)
+	if err != nil {
+		// This should never happen
+		return ""
+	}
+	return string(bytes)
+}
+
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+
+// statusCodeForListEvents returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForListEvents(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.Event:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case []models.Event:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) ListEventsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	resp, err := h.ListEvents(ctx)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.Event{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForListEvents(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "listEvents", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForListEvents(resp))
+	w.Write(respBytes)
+
+}
+
+// newListEventsInput takes in an http.Request an returns the input struct.
+func newListEventsInput(r *http.Request) (*models.ListEventsInput, error) {
+	var input models.ListEventsInput
+
+	var err error
+	_ = err
+
+	return &input, nil
+}
+
+// statusCodeForCreateEvent returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForCreateEvent(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.Event:
+		return 200
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.Event:
+		return 200
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) CreateEventHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newCreateEventInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	if input != nil {
+		err = input.Validate(nil)
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	resp, err := h.CreateEvent(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForCreateEvent(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "createEvent", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForCreateEvent(resp))
+	w.Write(respBytes)
+
+}
+
+// newCreateEventInput takes in an http.Request an returns the input struct.
+func newCreateEventInput(r *http.Request) (models.Event, error) {
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+	if len(data) > 0 {
+		return models.UnmarshalEvent(bytes.NewReader(data), runtime.JSONConsumer())
+	}
+
+	return nil, nil
+}
+
+// statusCodeForPutEvent returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForPutEvent(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) PutEventHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newPutEventInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	resp, err := h.PutEvent(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForPutEvent(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	if resp == nil {
+		err = fmt.Errorf("putEvent returned a nil response")
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	var respBytes []byte
+	switch resp.StatusCode {
+	case 200:
+		respBytes, err = marshalResponse(ctx, "putEvent", resp.OK)
+	case 201:
+		respBytes, err = marshalResponse(ctx, "putEvent", resp.Created)
+	default:
+		err = fmt.Errorf("putEvent returned a response with unexpected status code %d", resp.StatusCode)
+	}
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+	if len(respBytes) > 0 {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(resp.StatusCode)
+	w.Write(respBytes)
+
+}
+
+// newPutEventInput takes in an http.Request an returns the input struct.
+func newPutEventInput(r *http.Request) (*models.PutEventInput, error) {
+	var input models.PutEventInput
+
+	var err error
+	_ = err
+
+	idStr := mux.Vars(r)["id"]
+	if len(idStr) == 0 {
+		return nil, errors.New("path parameter 'id' must be specified")
+	}
+	idStrs := []string{idStr}
+
+	if len(idStrs) > 0 {
+		var idTmp string
+		idStr := idStrs[0]
+		idTmp, err = idStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.ID = idTmp
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+	if len(data) > 0 {
+		if input.Event, err = models.UnmarshalEvent(bytes.NewReader(data), runtime.JSONConsumer()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetFeed returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetFeed(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.Feed:
+		return 200
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.Feed:
+		return 200
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetFeedHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	resp, err := h.GetFeed(ctx)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForGetFeed(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "getFeed", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetFeed(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetFeedInput takes in an http.Request an returns the input struct.
+func newGetFeedInput(r *http.Request) (*models.GetFeedInput, error) {
+	var input models.GetFeedInput
+
+	var err error
+	_ = err
+
+	return &input, nil
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-polymorphism/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_controller.go -package server --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-polymorphism/models/v9
+
+// Controller defines the interface for the polymorphism-test service.
+type Controller interface {
+
+	// ListEvents handles GET requests to /events
+	//
+	// 200: []models.Event
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	ListEvents(ctx context.Context) ([]models.Event, error)
+
+	// CreateEvent handles POST requests to /events
+	//
+	// 200: models.Event
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateEvent(ctx context.Context, i models.Event) (models.Event, error)
+
+	// PutEvent handles PUT requests to /events/{id}
+	//
+	// 200: models.Event
+	// 201: models.Event
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	PutEvent(ctx context.Context, i *models.PutEventInput) (*models.PutEventResponse, error)
+
+	// GetFeed handles GET requests to /feed
+	//
+	// 200: *models.Feed
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetFeed(ctx context.Context) (*models.Feed, error)
+}
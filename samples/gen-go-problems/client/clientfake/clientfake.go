@@ -0,0 +1,145 @@
+// Package clientfake has an in-memory implementation of the problems-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-problems/client/v9"
+	"github.com/Clever/wag/samples/gen-go-problems/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	createWidgetStub  func(ctx context.Context, i *models.Widget) (*models.Widget, error)
+	createWidgetQueue []createWidgetResult
+	createWidgetCalls []CreateWidgetCall
+
+	getWidgetStub  func(ctx context.Context, i *models.GetWidgetInput) (*models.Widget, error)
+	getWidgetQueue []getWidgetResult
+	getWidgetCalls []GetWidgetCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// CreateWidgetCall records a call to CreateWidget.
+type CreateWidgetCall struct {
+	Ctx   context.Context
+	Input *models.Widget
+}
+
+type createWidgetResult struct {
+	resp *models.Widget
+	err  error
+}
+
+// StubCreateWidget sets the function that answers calls to CreateWidget once its queued responses
+// are used up.
+func (f *Fake) StubCreateWidget(stub func(ctx context.Context, i *models.Widget) (*models.Widget, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createWidgetStub = stub
+}
+
+// QueueCreateWidget adds a response for a call to CreateWidget.
+func (f *Fake) QueueCreateWidget(resp *models.Widget, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createWidgetQueue = append(f.createWidgetQueue, createWidgetResult{resp: resp, err: err})
+}
+
+// CreateWidgetCalls returns the calls made to CreateWidget.
+func (f *Fake) CreateWidgetCalls() []CreateWidgetCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]CreateWidgetCall{}, f.createWidgetCalls...)
+}
+
+// CreateWidget returns the next queued response or calls the stub.
+func (f *Fake) CreateWidget(ctx context.Context, i *models.Widget) (*models.Widget, error) {
+	f.mu.Lock()
+	f.createWidgetCalls = append(f.createWidgetCalls, CreateWidgetCall{Ctx: ctx, Input: i})
+	if len(f.createWidgetQueue) > 0 {
+		result := f.createWidgetQueue[0]
+		f.createWidgetQueue = f.createWidgetQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.createWidgetStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.Widget
+	return resp, notStubbed("CreateWidget")
+}
+
+// GetWidgetCall records a call to GetWidget.
+type GetWidgetCall struct {
+	Ctx   context.Context
+	Input *models.GetWidgetInput
+}
+
+type getWidgetResult struct {
+	resp *models.Widget
+	err  error
+}
+
+// StubGetWidget sets the function that answers calls to GetWidget once its queued responses
+// are used up.
+func (f *Fake) StubGetWidget(stub func(ctx context.Context, i *models.GetWidgetInput) (*models.Widget, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getWidgetStub = stub
+}
+
+// QueueGetWidget adds a response for a call to GetWidget.
+func (f *Fake) QueueGetWidget(resp *models.Widget, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getWidgetQueue = append(f.getWidgetQueue, getWidgetResult{resp: resp, err: err})
+}
+
+// GetWidgetCalls returns the calls made to GetWidget.
+func (f *Fake) GetWidgetCalls() []GetWidgetCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetWidgetCall{}, f.getWidgetCalls...)
+}
+
+// GetWidget returns the next queued response or calls the stub.
+func (f *Fake) GetWidget(ctx context.Context, i *models.GetWidgetInput) (*models.Widget, error) {
+	f.mu.Lock()
+	f.getWidgetCalls = append(f.getWidgetCalls, GetWidgetCall{Ctx: ctx, Input: i})
+	if len(f.getWidgetQueue) > 0 {
+		result := f.getWidgetQueue[0]
+		f.getWidgetQueue = f.getWidgetQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getWidgetStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.Widget
+	return resp, notStubbed("GetWidget")
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-problems/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_client.go -package client --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-problems/models/v9
+
+// Client defines the methods available to clients of the problems-test service.
+type Client interface {
+
+	// CreateWidget makes a POST request to /widgets
+	//
+	// 200: *models.Widget
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateWidget(ctx context.Context, i *models.Widget) (*models.Widget, error)
+
+	// GetWidget makes a GET request to /widgets/{id}
+	//
+	// 200: *models.Widget
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWidget(ctx context.Context, i *models.GetWidgetInput) (*models.Widget, error)
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// These imports may not be used depending on the input parameters
+var _ = json.Marshal
+var _ = fmt.Sprintf
+var _ = url.QueryEscape
+var _ = strconv.FormatInt
+var _ = strings.Replace
+var _ = validate.Maximum
+var _ = strfmt.NewFormats
+
+// GetWidgetInput holds the input parameters for a getWidget operation.
+type GetWidgetInput struct {
+	ID    int64
+	Color *string
+}
+
+// Validate returns an error if any of the GetWidgetInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWidgetInput) Validate() error {
+
+	if err := validate.MinimumInt("id", "path", i.ID, int64(1), false); err != nil {
+		return err
+	}
+
+	if i.Color != nil {
+		if err := validate.Enum("color", "query", *i.Color, []interface{}{"red", "blue"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWidgetInput) Path() (string, error) {
+	path := "/v1/widgets/{id}"
+	urlVals := url.Values{}
+
+	pathid := strconv.FormatInt(i.ID, 10)
+	if pathid == "" {
+		err := fmt.Errorf("id cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{id}", pathid, -1)
+
+	if i.Color != nil {
+		urlVals.Add("color", *i.Color)
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
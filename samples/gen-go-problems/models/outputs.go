@@ -0,0 +1,62 @@
+package models
+
+import "fmt"
+
+func (o BadRequest) Error() string {
+	return o.Message
+}
+
+func (o InternalError) Error() string {
+	return o.Message
+}
+
+func (o NotFound) Error() string {
+	return o.Message
+}
+
+func (u UnknownResponse) Error() string {
+	return fmt.Sprintf("unknown response with status: %d body: %s", u.StatusCode, u.Body)
+}
+
+// ProblemDetails is an RFC 7807 problem details error response. The client returns it for
+// error responses, with the error model of the response's status code as Err.
+type ProblemDetails struct {
+	// Type is a URI reference that identifies the type of problem, "about:blank" by default.
+	Type string `json:"type,omitempty"`
+	// Title is a summary of the type of problem.
+	Title string `json:"title,omitempty"`
+	// Status is the HTTP status code of the response.
+	Status int `json:"status,omitempty"`
+	// Detail explains this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+	// Instance is a URI reference that identifies this occurrence of the problem.
+	Instance string `json:"instance,omitempty"`
+	// InvalidParams are the parameters of the request that are invalid.
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+
+	// Err is the error model of the response's status code, decoded from the same body.
+	Err error `json:"-"`
+}
+
+func (p *ProblemDetails) Error() string {
+	if p.Detail != "" {
+		return p.Detail
+	}
+	if p.Err != nil {
+		return p.Err.Error()
+	}
+	return p.Title
+}
+
+// Unwrap returns the error model of the response, so errors.As finds it.
+func (p *ProblemDetails) Unwrap() error {
+	return p.Err
+}
+
+// InvalidParam is a parameter of a request that is invalid.
+type InvalidParam struct {
+	// Name is the name of the parameter, or the path of the invalid field in a body parameter.
+	Name string `json:"name"`
+	// Reason explains why the parameter is invalid.
+	Reason string `json:"reason"`
+}
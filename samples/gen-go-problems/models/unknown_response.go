@@ -0,0 +1,46 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// UnknownResponse unknown response
+//
+// swagger:model UnknownResponse
+type UnknownResponse struct {
+
+	// body
+	Body string `json:"body,omitempty"`
+
+	// status code
+	StatusCode int64 `json:"statusCode,omitempty"`
+}
+
+// Validate validates this unknown response
+func (m *UnknownResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UnknownResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UnknownResponse) UnmarshalBinary(b []byte) error {
+	var res UnknownResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Widget widget
+//
+// swagger:model Widget
+type Widget struct {
+
+	// id
+	ID int64 `json:"id,omitempty"`
+
+	// name
+	// Required: true
+	// Min Length: 1
+	Name *string `json:"name"`
+
+	// size
+	// Maximum: 10
+	Size int64 `json:"size,omitempty"`
+}
+
+// Validate validates this widget
+func (m *Widget) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Widget) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("name", "body", string(*m.Name), 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Widget) validateSize(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Size) { // not required
+		return nil
+	}
+
+	if err := validate.MaximumInt("size", "body", int64(m.Size), 10, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Widget) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Widget) UnmarshalBinary(b []byte) error {
+	var res Widget
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
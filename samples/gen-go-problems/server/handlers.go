@@ -0,0 +1,369 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/Clever/kayvee-go/v7/logger"
+	"github.com/Clever/wag/samples/gen-go-problems/models/v9"
+	"github.com/go-errors/errors"
+	openapierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"golang.org/x/xerrors"
+)
+
+var _ = strconv.ParseInt
+var _ = strfmt.Default
+var _ = swag.ConvertInt32
+var _ = errors.New
+var _ = mux.Vars
+var _ = bytes.Compare
+var _ = ioutil.ReadAll
+
+var formats = strfmt.Default
+var _ = formats
+
+// convertBase64 takes in a string and returns a strfmt.Base64 if the input
+// is valid base64 and an error otherwise.
+func convertBase64(input string) (strfmt.Base64, error) {
+	temp, err := formats.Parse("byte", input)
+	if err != nil {
+		return strfmt.Base64{}, err
+	}
+	return *temp.(*strfmt.Base64), nil
+}
+
+// convertDateTime takes in a string and returns a strfmt.DateTime if the input
+// is a valid DateTime and an error otherwise.
+func convertDateTime(input string) (strfmt.DateTime, error) {
+	temp, err := formats.Parse("date-time", input)
+	if err != nil {
+		return strfmt.DateTime{}, err
+	}
+	return *temp.(*strfmt.DateTime), nil
+}
+
+// convertDate takes in a string and returns a strfmt.Date if the input
+// is a valid Date and an error otherwise.
+func convertDate(input string) (strfmt.Date, error) {
+	temp, err := formats.Parse("date", input)
+	if err != nil {
+		return strfmt.Date{}, err
+	}
+	return *temp.(*strfmt.Date), nil
+}
+
+func jsonMarshalNoError(i interface{}) string {
+	bytes, err := json.Marshal(i)
+	if err != nil {
+		// This should never happen
+		return ""
+	}
+	return string(bytes)
+}
+
+// writeError writes an RFC 7807 problem details response for an error. The fields of the error
+// model are kept alongside the problem details, and its message is the detail.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}, params ...models.InvalidParam) {
+	var problem map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonMarshalNoError(body)), &problem); err != nil || problem == nil {
+		problem = map[string]interface{}{}
+	}
+	if _, ok := problem["type"]; !ok {
+		problem["type"] = "about:blank"
+	}
+	problem["title"] = http.StatusText(statusCode)
+	problem["status"] = statusCode
+	if message, ok := problem["message"].(string); ok {
+		problem["detail"] = message
+	}
+	problem["instance"] = r.URL.Path
+	if len(params) > 0 {
+		problem["invalid-params"] = params
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(statusCode)
+	fmt.Fprintln(w, jsonMarshalNoError(problem))
+}
+
+// invalidParams returns the invalid parameters of a request from the error reading or validating
+// its input.
+func invalidParams(err error) []models.InvalidParam {
+	var params []models.InvalidParam
+	for _, e := range validationErrors(err) {
+		params = append(params, models.InvalidParam{Name: e.path, Reason: e.message})
+	}
+	return params
+}
+
+// invalidParamError is an error reading a parameter from a request.
+type invalidParamError struct {
+	name string
+	code int32
+	err  error
+}
+
+func (e invalidParamError) Error() string {
+	return e.err.Error()
+}
+
+// validationError is a parameter or field of a request that couldn't be read or failed
+// validation. code is one of the codes of github.com/go-openapi/errors.
+type validationError struct {
+	path    string
+	code    int32
+	message string
+}
+
+// validationErrors returns the parameters and fields of a request that couldn't be read or
+// failed validation from the error reading or validating its input.
+func validationErrors(err error) []validationError {
+	var errs []validationError
+	switch err := err.(type) {
+	case invalidParamError:
+		errs = append(errs, validationError{path: err.name, code: err.code, message: err.Error()})
+	case *openapierrors.Validation:
+		errs = append(errs, validationError{path: err.Name, code: err.Code(), message: err.Error()})
+	case *openapierrors.CompositeError:
+		for _, err := range err.Errors {
+			errs = append(errs, validationErrors(err)...)
+		}
+	}
+	return errs
+}
+
+// statusCodeForCreateWidget returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForCreateWidget(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.Widget:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.Widget:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) CreateWidgetHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newCreateWidgetInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()}, invalidParams(err)...)
+		return
+	}
+
+	if input != nil {
+		err = input.Validate(nil)
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()}, invalidParams(err)...)
+		return
+	}
+
+	resp, err := h.CreateWidget(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForCreateWidget(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "createWidget", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForCreateWidget(resp))
+	w.Write(respBytes)
+
+}
+
+// newCreateWidgetInput takes in an http.Request an returns the input struct.
+func newCreateWidgetInput(r *http.Request) (*models.Widget, error) {
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+	if len(data) > 0 {
+		var input models.Widget
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+		return &input, nil
+	}
+
+	return nil, nil
+}
+
+// statusCodeForGetWidget returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWidget(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.Widget:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.Widget:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWidgetHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWidgetInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()}, invalidParams(err)...)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()}, invalidParams(err)...)
+		return
+	}
+
+	resp, err := h.GetWidget(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForGetWidget(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "getWidget", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWidget(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWidgetInput takes in an http.Request an returns the input struct.
+func newGetWidgetInput(r *http.Request) (*models.GetWidgetInput, error) {
+	var input models.GetWidgetInput
+
+	var err error
+	_ = err
+
+	idStr := mux.Vars(r)["id"]
+	if len(idStr) == 0 {
+		return nil, invalidParamError{name: "id", code: openapierrors.RequiredFailCode, err: errors.New("path parameter 'id' must be specified")}
+	}
+	idStrs := []string{idStr}
+
+	if len(idStrs) > 0 {
+		var idTmp int64
+		idStr := idStrs[0]
+		idTmp, err = swag.ConvertInt64(idStr)
+		if err != nil {
+			return nil, invalidParamError{name: "id", code: openapierrors.InvalidTypeCode, err: err}
+		}
+		input.ID = idTmp
+	}
+
+	colorStrs := r.URL.Query()["color"]
+
+	if len(colorStrs) > 0 {
+		var colorTmp string
+		colorStr := colorStrs[0]
+		colorTmp, err = colorStr, error(nil)
+		if err != nil {
+			return nil, invalidParamError{name: "color", code: openapierrors.InvalidTypeCode, err: err}
+		}
+		input.Color = &colorTmp
+	}
+
+	return &input, nil
+}
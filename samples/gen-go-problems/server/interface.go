@@ -0,0 +1,30 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-problems/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_controller.go -package server --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-problems/models/v9
+
+// Controller defines the interface for the problems-test service.
+type Controller interface {
+
+	// CreateWidget handles POST requests to /widgets
+	//
+	// 200: *models.Widget
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CreateWidget(ctx context.Context, i *models.Widget) (*models.Widget, error)
+
+	// GetWidget handles GET requests to /widgets/{id}
+	//
+	// 200: *models.Widget
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWidget(ctx context.Context, i *models.GetWidgetInput) (*models.Widget, error)
+}
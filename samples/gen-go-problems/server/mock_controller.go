@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+
+// Package server is a generated GoMock package.
+package server
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Clever/wag/samples/gen-go-problems/models/v9"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockController is a mock of Controller interface.
+type MockController struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerMockRecorder
+}
+
+// MockControllerMockRecorder is the mock recorder for MockController.
+type MockControllerMockRecorder struct {
+	mock *MockController
+}
+
+// NewMockController creates a new mock instance.
+func NewMockController(ctrl *gomock.Controller) *MockController {
+	mock := &MockController{ctrl: ctrl}
+	mock.recorder = &MockControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockController) EXPECT() *MockControllerMockRecorder {
+	return m.recorder
+}
+
+// CreateWidget mocks base method.
+func (m *MockController) CreateWidget(ctx context.Context, i *models.Widget) (*models.Widget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWidget", ctx, i)
+	ret0, _ := ret[0].(*models.Widget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWidget indicates an expected call of CreateWidget.
+func (mr *MockControllerMockRecorder) CreateWidget(ctx, i interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWidget", reflect.TypeOf((*MockController)(nil).CreateWidget), ctx, i)
+}
+
+// GetWidget mocks base method.
+func (m *MockController) GetWidget(ctx context.Context, i *models.GetWidgetInput) (*models.Widget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWidget", ctx, i)
+	ret0, _ := ret[0].(*models.Widget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWidget indicates an expected call of GetWidget.
+func (mr *MockControllerMockRecorder) GetWidget(ctx, i interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWidget", reflect.TypeOf((*MockController)(nil).GetWidget), ctx, i)
+}
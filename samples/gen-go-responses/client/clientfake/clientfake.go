@@ -0,0 +1,315 @@
+// Package clientfake has an in-memory implementation of the responses-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-responses/client/v9"
+	"github.com/Clever/wag/samples/gen-go-responses/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	deleteBookStub  func(ctx context.Context, id string) (*models.DeleteBookOutput, error)
+	deleteBookQueue []deleteBookResult
+	deleteBookCalls []DeleteBookCall
+
+	getBookStub  func(ctx context.Context, id string) (*models.GetBookOutput, error)
+	getBookQueue []getBookResult
+	getBookCalls []GetBookCall
+
+	upsertBookStub  func(ctx context.Context, i *models.UpsertBookInput) (*models.UpsertBookResponse, error)
+	upsertBookQueue []upsertBookResult
+	upsertBookCalls []UpsertBookCall
+
+	listJobsStub  func(ctx context.Context) (*models.ListJobsResponse, error)
+	listJobsQueue []listJobsResult
+	listJobsCalls []ListJobsCall
+
+	getJobStub  func(ctx context.Context, id string) (*models.GetJobResponse, error)
+	getJobQueue []getJobResult
+	getJobCalls []GetJobCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// DeleteBookCall records a call to DeleteBook.
+type DeleteBookCall struct {
+	Ctx   context.Context
+	Input string
+}
+
+type deleteBookResult struct {
+	resp *models.DeleteBookOutput
+	err  error
+}
+
+// StubDeleteBook sets the function that answers calls to DeleteBook once its queued responses
+// are used up.
+func (f *Fake) StubDeleteBook(stub func(ctx context.Context, id string) (*models.DeleteBookOutput, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteBookStub = stub
+}
+
+// QueueDeleteBook adds a response for a call to DeleteBook.
+func (f *Fake) QueueDeleteBook(resp *models.DeleteBookOutput, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleteBookQueue = append(f.deleteBookQueue, deleteBookResult{resp: resp, err: err})
+}
+
+// DeleteBookCalls returns the calls made to DeleteBook.
+func (f *Fake) DeleteBookCalls() []DeleteBookCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]DeleteBookCall{}, f.deleteBookCalls...)
+}
+
+// DeleteBook returns the next queued response or calls the stub.
+func (f *Fake) DeleteBook(ctx context.Context, id string) (*models.DeleteBookOutput, error) {
+	f.mu.Lock()
+	f.deleteBookCalls = append(f.deleteBookCalls, DeleteBookCall{Ctx: ctx, Input: id})
+	if len(f.deleteBookQueue) > 0 {
+		result := f.deleteBookQueue[0]
+		f.deleteBookQueue = f.deleteBookQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.deleteBookStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	var resp *models.DeleteBookOutput
+	return resp, notStubbed("DeleteBook")
+}
+
+// GetBookCall records a call to GetBook.
+type GetBookCall struct {
+	Ctx   context.Context
+	Input string
+}
+
+type getBookResult struct {
+	resp *models.GetBookOutput
+	err  error
+}
+
+// StubGetBook sets the function that answers calls to GetBook once its queued responses
+// are used up.
+func (f *Fake) StubGetBook(stub func(ctx context.Context, id string) (*models.GetBookOutput, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBookStub = stub
+}
+
+// QueueGetBook adds a response for a call to GetBook.
+func (f *Fake) QueueGetBook(resp *models.GetBookOutput, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getBookQueue = append(f.getBookQueue, getBookResult{resp: resp, err: err})
+}
+
+// GetBookCalls returns the calls made to GetBook.
+func (f *Fake) GetBookCalls() []GetBookCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetBookCall{}, f.getBookCalls...)
+}
+
+// GetBook returns the next queued response or calls the stub.
+func (f *Fake) GetBook(ctx context.Context, id string) (*models.GetBookOutput, error) {
+	f.mu.Lock()
+	f.getBookCalls = append(f.getBookCalls, GetBookCall{Ctx: ctx, Input: id})
+	if len(f.getBookQueue) > 0 {
+		result := f.getBookQueue[0]
+		f.getBookQueue = f.getBookQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getBookStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	var resp *models.GetBookOutput
+	return resp, notStubbed("GetBook")
+}
+
+// UpsertBookCall records a call to UpsertBook.
+type UpsertBookCall struct {
+	Ctx   context.Context
+	Input *models.UpsertBookInput
+}
+
+type upsertBookResult struct {
+	resp *models.UpsertBookResponse
+	err  error
+}
+
+// StubUpsertBook sets the function that answers calls to UpsertBook once its queued responses
+// are used up.
+func (f *Fake) StubUpsertBook(stub func(ctx context.Context, i *models.UpsertBookInput) (*models.UpsertBookResponse, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.upsertBookStub = stub
+}
+
+// QueueUpsertBook adds a response for a call to UpsertBook.
+func (f *Fake) QueueUpsertBook(resp *models.UpsertBookResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.upsertBookQueue = append(f.upsertBookQueue, upsertBookResult{resp: resp, err: err})
+}
+
+// UpsertBookCalls returns the calls made to UpsertBook.
+func (f *Fake) UpsertBookCalls() []UpsertBookCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]UpsertBookCall{}, f.upsertBookCalls...)
+}
+
+// UpsertBook returns the next queued response or calls the stub.
+func (f *Fake) UpsertBook(ctx context.Context, i *models.UpsertBookInput) (*models.UpsertBookResponse, error) {
+	f.mu.Lock()
+	f.upsertBookCalls = append(f.upsertBookCalls, UpsertBookCall{Ctx: ctx, Input: i})
+	if len(f.upsertBookQueue) > 0 {
+		result := f.upsertBookQueue[0]
+		f.upsertBookQueue = f.upsertBookQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.upsertBookStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.UpsertBookResponse
+	return resp, notStubbed("UpsertBook")
+}
+
+// ListJobsCall records a call to ListJobs.
+type ListJobsCall struct {
+	Ctx context.Context
+}
+
+type listJobsResult struct {
+	resp *models.ListJobsResponse
+	err  error
+}
+
+// StubListJobs sets the function that answers calls to ListJobs once its queued responses
+// are used up.
+func (f *Fake) StubListJobs(stub func(ctx context.Context) (*models.ListJobsResponse, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listJobsStub = stub
+}
+
+// QueueListJobs adds a response for a call to ListJobs.
+func (f *Fake) QueueListJobs(resp *models.ListJobsResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listJobsQueue = append(f.listJobsQueue, listJobsResult{resp: resp, err: err})
+}
+
+// ListJobsCalls returns the calls made to ListJobs.
+func (f *Fake) ListJobsCalls() []ListJobsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]ListJobsCall{}, f.listJobsCalls...)
+}
+
+// ListJobs returns the next queued response or calls the stub.
+func (f *Fake) ListJobs(ctx context.Context) (*models.ListJobsResponse, error) {
+	f.mu.Lock()
+	f.listJobsCalls = append(f.listJobsCalls, ListJobsCall{Ctx: ctx})
+	if len(f.listJobsQueue) > 0 {
+		result := f.listJobsQueue[0]
+		f.listJobsQueue = f.listJobsQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.listJobsStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx)
+	}
+	var resp *models.ListJobsResponse
+	return resp, notStubbed("ListJobs")
+}
+
+// GetJobCall records a call to GetJob.
+type GetJobCall struct {
+	Ctx   context.Context
+	Input string
+}
+
+type getJobResult struct {
+	resp *models.GetJobResponse
+	err  error
+}
+
+// StubGetJob sets the function that answers calls to GetJob once its queued responses
+// are used up.
+func (f *Fake) StubGetJob(stub func(ctx context.Context, id string) (*models.GetJobResponse, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getJobStub = stub
+}
+
+// QueueGetJob adds a response for a call to GetJob.
+func (f *Fake) QueueGetJob(resp *models.GetJobResponse, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getJobQueue = append(f.getJobQueue, getJobResult{resp: resp, err: err})
+}
+
+// GetJobCalls returns the calls made to GetJob.
+func (f *Fake) GetJobCalls() []GetJobCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetJobCall{}, f.getJobCalls...)
+}
+
+// GetJob returns the next queued response or calls the stub.
+func (f *Fake) GetJob(ctx context.Context, id string) (*models.GetJobResponse, error) {
+	f.mu.Lock()
+	f.getJobCalls = append(f.getJobCalls, GetJobCall{Ctx: ctx, Input: id})
+	if len(f.getJobQueue) > 0 {
+		result := f.getJobQueue[0]
+		f.getJobQueue = f.getJobQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.getJobStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, id)
+	}
+	var resp *models.GetJobResponse
+	return resp, notStubbed("GetJob")
+}
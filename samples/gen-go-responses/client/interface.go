@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-responses/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_client.go -package client --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-responses/models/v9
+
+// Client defines the methods available to clients of the responses-test service.
+type Client interface {
+
+	// DeleteBook makes a DELETE request to /books/{id}
+	//
+	// 204: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	DeleteBook(ctx context.Context, id string) (*models.DeleteBookOutput, error)
+
+	// GetBook makes a GET request to /books/{id}
+	//
+	// 200: *models.Book
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetBook(ctx context.Context, id string) (*models.GetBookOutput, error)
+
+	// UpsertBook makes a PUT request to /books/{id}
+	//
+	// 200: *models.Book
+	// 201: *models.Book
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	UpsertBook(ctx context.Context, i *models.UpsertBookInput) (*models.UpsertBookResponse, error)
+
+	// ListJobs makes a GET request to /jobs
+	//
+	// 200: []models.Job
+	// 204: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	ListJobs(ctx context.Context) (*models.ListJobsResponse, error)
+
+	// GetJob makes a GET request to /jobs/{id}
+	//
+	// 200: *models.Job
+	// 202: nil
+	// 204: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetJob(ctx context.Context, id string) (*models.GetJobResponse, error)
+}
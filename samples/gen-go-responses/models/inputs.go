@@ -0,0 +1,161 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// These imports may not be used depending on the input parameters
+var _ = json.Marshal
+var _ = fmt.Sprintf
+var _ = url.QueryEscape
+var _ = strconv.FormatInt
+var _ = strings.Replace
+var _ = validate.Maximum
+var _ = strfmt.NewFormats
+
+// DeleteBookInput holds the input parameters for a deleteBook operation.
+type DeleteBookInput struct {
+	ID string
+}
+
+// ValidateDeleteBookInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateDeleteBookInput(id string) error {
+
+	return nil
+}
+
+// DeleteBookInputPath returns the URI path for the input.
+func DeleteBookInputPath(id string) (string, error) {
+	path := "/v1/books/{id}"
+	urlVals := url.Values{}
+
+	pathid := id
+	if pathid == "" {
+		err := fmt.Errorf("id cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{id}", pathid, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetBookInput holds the input parameters for a getBook operation.
+type GetBookInput struct {
+	ID string
+}
+
+// ValidateGetBookInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetBookInput(id string) error {
+
+	return nil
+}
+
+// GetBookInputPath returns the URI path for the input.
+func GetBookInputPath(id string) (string, error) {
+	path := "/v1/books/{id}"
+	urlVals := url.Values{}
+
+	pathid := id
+	if pathid == "" {
+		err := fmt.Errorf("id cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{id}", pathid, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// UpsertBookInput holds the input parameters for a upsertBook operation.
+type UpsertBookInput struct {
+	ID   string
+	Book *Book
+}
+
+// Validate returns an error if any of the UpsertBookInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i UpsertBookInput) Validate() error {
+
+	if i.Book != nil {
+		if err := i.Book.Validate(nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i UpsertBookInput) Path() (string, error) {
+	path := "/v1/books/{id}"
+	urlVals := url.Values{}
+
+	pathid := i.ID
+	if pathid == "" {
+		err := fmt.Errorf("id cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{id}", pathid, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// ListJobsInput holds the input parameters for a listJobs operation.
+type ListJobsInput struct {
+}
+
+// Validate returns an error if any of the ListJobsInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i ListJobsInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i ListJobsInput) Path() (string, error) {
+	path := "/v1/jobs"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetJobInput holds the input parameters for a getJob operation.
+type GetJobInput struct {
+	ID string
+}
+
+// ValidateGetJobInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetJobInput(id string) error {
+
+	return nil
+}
+
+// GetJobInputPath returns the URI path for the input.
+func GetJobInputPath(id string) (string, error) {
+	path := "/v1/jobs/{id}"
+	urlVals := url.Values{}
+
+	pathid := id
+	if pathid == "" {
+		err := fmt.Errorf("id cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{id}", pathid, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
@@ -0,0 +1,67 @@
+package models
+
+import "fmt"
+
+func (o BadRequest) Error() string {
+	return o.Message
+}
+
+func (o InternalError) Error() string {
+	return o.Message
+}
+
+func (u UnknownResponse) Error() string {
+	return fmt.Sprintf("unknown response with status: %d body: %s", u.StatusCode, u.Body)
+}
+
+// DeleteBookOutput is the output of the deleteBook operation, which holds the headers
+// of its 204 response.
+type DeleteBookOutput struct {
+	// XDeletedCount is the X-Deleted-Count header.
+	XDeletedCount *int64
+}
+
+// GetBookOutput is the output of the getBook operation, which holds the body and headers
+// of its 200 response.
+type GetBookOutput struct {
+	// Body is the body of the response.
+	Body *Book
+	// ETag is the ETag header.
+	ETag string
+	// XCacheHit is the X-Cache-Hit header.
+	XCacheHit *bool
+	// XPopularity is the X-Popularity header.
+	XPopularity *float64
+	// XRateLimitRemaining is the X-Rate-Limit-Remaining header.
+	XRateLimitRemaining *int32
+}
+
+// UpsertBookResponse holds the success responses of the upsertBook operation. StatusCode is the status
+// code of the response, one of 200, 201, and the field for that status code holds its body.
+type UpsertBookResponse struct {
+	StatusCode int
+	// OK is the body of a 200 response.
+	OK *Book
+	// Created is the body of a 201 response.
+	Created *Book
+	// ETag is the ETag header.
+	ETag string
+	// Location is the Location header.
+	Location string
+}
+
+// ListJobsResponse holds the success responses of the listJobs operation. StatusCode is the status
+// code of the response, one of 200, 204, and the field for that status code holds its body.
+type ListJobsResponse struct {
+	StatusCode int
+	// OK is the body of a 200 response.
+	OK []Job
+}
+
+// GetJobResponse holds the success responses of the getJob operation. StatusCode is the status
+// code of the response, one of 200, 202, 204, and the field for that status code holds its body.
+type GetJobResponse struct {
+	StatusCode int
+	// OK is the body of a 200 response.
+	OK *Job
+}
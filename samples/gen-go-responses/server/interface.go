@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-responses/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_controller.go -package server --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-responses/models/v9
+
+// Controller defines the interface for the responses-test service.
+type Controller interface {
+
+	// DeleteBook handles DELETE requests to /books/{id}
+	//
+	// 204: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	DeleteBook(ctx context.Context, id string) (*models.DeleteBookOutput, error)
+
+	// GetBook handles GET requests to /books/{id}
+	//
+	// 200: *models.Book
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetBook(ctx context.Context, id string) (*models.GetBookOutput, error)
+
+	// UpsertBook handles PUT requests to /books/{id}
+	//
+	// 200: *models.Book
+	// 201: *models.Book
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	UpsertBook(ctx context.Context, i *models.UpsertBookInput) (*models.UpsertBookResponse, error)
+
+	// ListJobs handles GET requests to /jobs
+	//
+	// 200: []models.Job
+	// 204: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	ListJobs(ctx context.Context) (*models.ListJobsResponse, error)
+
+	// GetJob handles GET requests to /jobs/{id}
+	//
+	// 200: *models.Job
+	// 202: nil
+	// 204: nil
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetJob(ctx context.Context, id string) (*models.GetJobResponse, error)
+}
@@ -0,0 +1,86 @@
+// Package clientfake has an in-memory implementation of the nil-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-strings/client/v9"
+	"github.com/Clever/wag/samples/gen-go-strings/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	getDistrictsStub  func(ctx context.Context, i *models.GetDistrictsInput) error
+	getDistrictsQueue []getDistrictsResult
+	getDistrictsCalls []GetDistrictsCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// GetDistrictsCall records a call to GetDistricts.
+type GetDistrictsCall struct {
+	Ctx   context.Context
+	Input *models.GetDistrictsInput
+}
+
+type getDistrictsResult struct {
+	err error
+}
+
+// StubGetDistricts sets the function that answers calls to GetDistricts once its queued responses
+// are used up.
+func (f *Fake) StubGetDistricts(stub func(ctx context.Context, i *models.GetDistrictsInput) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getDistrictsStub = stub
+}
+
+// QueueGetDistricts adds a response for a call to GetDistricts.
+func (f *Fake) QueueGetDistricts(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getDistrictsQueue = append(f.getDistrictsQueue, getDistrictsResult{err: err})
+}
+
+// GetDistrictsCalls returns the calls made to GetDistricts.
+func (f *Fake) GetDistrictsCalls() []GetDistrictsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]GetDistrictsCall{}, f.getDistrictsCalls...)
+}
+
+// GetDistricts returns the next queued response or calls the stub.
+func (f *Fake) GetDistricts(ctx context.Context, i *models.GetDistrictsInput) error {
+	f.mu.Lock()
+	f.getDistrictsCalls = append(f.getDistrictsCalls, GetDistrictsCall{Ctx: ctx, Input: i})
+	if len(f.getDistrictsQueue) > 0 {
+		result := f.getDistrictsQueue[0]
+		f.getDistrictsQueue = f.getDistrictsQueue[1:]
+		f.mu.Unlock()
+		return result.err
+	}
+	stub := f.getDistrictsStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	return notStubbed("GetDistricts")
+}
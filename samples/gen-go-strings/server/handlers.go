@@ -68,6 +68,11 @@ func jsonMarshalNoError(i interface{}) string {
 	return string(bytes)
 }
 
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+
 // statusCodeForGetDistricts returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
 func statusCodeForGetDistricts(obj interface{}) int {
@@ -96,7 +101,12 @@ func (h handler) GetDistrictsHandler(ctx context.Context, w http.ResponseWriter,
 	input, err := newGetDistrictsInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
 		return
 	}
 
@@ -104,7 +114,7 @@ func (h handler) GetDistrictsHandler(ctx context.Context, w http.ResponseWriter,
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
 		return
 	}
 
@@ -122,7 +132,7 @@ func (h handler) GetDistrictsHandler(ctx context.Context, w http.ResponseWriter,
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
@@ -139,6 +149,9 @@ func newGetDistrictsInput(r *http.Request) (*models.GetDistrictsInput, error) {
 	_ = err
 
 	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
 
 	if len(data) > 0 {
 		input.Where = new(models.WhereQueryString)
@@ -0,0 +1,145 @@
+// Package clientfake has an in-memory implementation of the upload-test client for tests.
+package clientfake
+
+// Code auto-generated. Do not edit.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Clever/wag/samples/gen-go-upload/client/v9"
+	"github.com/Clever/wag/samples/gen-go-upload/models/v9"
+)
+
+// ErrNotStubbed is returned by the operations of a Fake that have neither a queued response
+// nor a stub.
+var ErrNotStubbed = errors.New("clientfake: no queued response or stub")
+
+// Fake is an in-memory implementation of client.Client. Each operation returns its queued
+// responses in order, then calls its stub, and returns ErrNotStubbed if it has neither. Every
+// call is recorded. The zero value is ready to use, and a Fake is safe for concurrent use.
+type Fake struct {
+	mu sync.Mutex
+
+	uploadDocumentStub  func(ctx context.Context, i *models.UploadDocumentInput) (*models.Document, error)
+	uploadDocumentQueue []uploadDocumentResult
+	uploadDocumentCalls []UploadDocumentCall
+
+	addCommentStub  func(ctx context.Context, i *models.AddCommentInput) (*models.Comment, error)
+	addCommentQueue []addCommentResult
+	addCommentCalls []AddCommentCall
+}
+
+var _ client.Client = (*Fake)(nil)
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w for %s", ErrNotStubbed, operation)
+}
+
+// UploadDocumentCall records a call to UploadDocument.
+type UploadDocumentCall struct {
+	Ctx   context.Context
+	Input *models.UploadDocumentInput
+}
+
+type uploadDocumentResult struct {
+	resp *models.Document
+	err  error
+}
+
+// StubUploadDocument sets the function that answers calls to UploadDocument once its queued responses
+// are used up.
+func (f *Fake) StubUploadDocument(stub func(ctx context.Context, i *models.UploadDocumentInput) (*models.Document, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.uploadDocumentStub = stub
+}
+
+// QueueUploadDocument adds a response for a call to UploadDocument.
+func (f *Fake) QueueUploadDocument(resp *models.Document, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.uploadDocumentQueue = append(f.uploadDocumentQueue, uploadDocumentResult{resp: resp, err: err})
+}
+
+// UploadDocumentCalls returns the calls made to UploadDocument.
+func (f *Fake) UploadDocumentCalls() []UploadDocumentCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]UploadDocumentCall{}, f.uploadDocumentCalls...)
+}
+
+// UploadDocument returns the next queued response or calls the stub.
+func (f *Fake) UploadDocument(ctx context.Context, i *models.UploadDocumentInput) (*models.Document, error) {
+	f.mu.Lock()
+	f.uploadDocumentCalls = append(f.uploadDocumentCalls, UploadDocumentCall{Ctx: ctx, Input: i})
+	if len(f.uploadDocumentQueue) > 0 {
+		result := f.uploadDocumentQueue[0]
+		f.uploadDocumentQueue = f.uploadDocumentQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.uploadDocumentStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.Document
+	return resp, notStubbed("UploadDocument")
+}
+
+// AddCommentCall records a call to AddComment.
+type AddCommentCall struct {
+	Ctx   context.Context
+	Input *models.AddCommentInput
+}
+
+type addCommentResult struct {
+	resp *models.Comment
+	err  error
+}
+
+// StubAddComment sets the function that answers calls to AddComment once its queued responses
+// are used up.
+func (f *Fake) StubAddComment(stub func(ctx context.Context, i *models.AddCommentInput) (*models.Comment, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.addCommentStub = stub
+}
+
+// QueueAddComment adds a response for a call to AddComment.
+func (f *Fake) QueueAddComment(resp *models.Comment, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.addCommentQueue = append(f.addCommentQueue, addCommentResult{resp: resp, err: err})
+}
+
+// AddCommentCalls returns the calls made to AddComment.
+func (f *Fake) AddCommentCalls() []AddCommentCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]AddCommentCall{}, f.addCommentCalls...)
+}
+
+// AddComment returns the next queued response or calls the stub.
+func (f *Fake) AddComment(ctx context.Context, i *models.AddCommentInput) (*models.Comment, error) {
+	f.mu.Lock()
+	f.addCommentCalls = append(f.addCommentCalls, AddCommentCall{Ctx: ctx, Input: i})
+	if len(f.addCommentQueue) > 0 {
+		result := f.addCommentQueue[0]
+		f.addCommentQueue = f.addCommentQueue[1:]
+		f.mu.Unlock()
+		return result.resp, result.err
+	}
+	stub := f.addCommentStub
+	f.mu.Unlock()
+
+	if stub != nil {
+		return stub(ctx, i)
+	}
+	var resp *models.Comment
+	return resp, notStubbed("AddComment")
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-upload/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_client.go -package client --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-upload/models/v9
+
+// Client defines the methods available to clients of the upload-test service.
+type Client interface {
+
+	// UploadDocument makes a POST request to /documents
+	//
+	// 200: *models.Document
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	UploadDocument(ctx context.Context, i *models.UploadDocumentInput) (*models.Document, error)
+
+	// AddComment makes a POST request to /documents/{id}/comments
+	//
+	// 200: *models.Comment
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	AddComment(ctx context.Context, i *models.AddCommentInput) (*models.Comment, error)
+}
@@ -0,0 +1,52 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// Comment comment
+//
+// swagger:model Comment
+type Comment struct {
+
+	// author
+	Author string `json:"author,omitempty"`
+
+	// body
+	Body string `json:"body,omitempty"`
+
+	// document ID
+	DocumentID string `json:"documentID,omitempty"`
+
+	// mentions
+	Mentions []string `json:"mentions"`
+}
+
+// Validate validates this comment
+func (m *Comment) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Comment) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Comment) UnmarshalBinary(b []byte) error {
+	var res Comment
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
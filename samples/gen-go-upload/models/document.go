@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// Document document
+//
+// swagger:model Document
+type Document struct {
+
+	// contents
+	Contents string `json:"contents,omitempty"`
+
+	// pages
+	Pages int64 `json:"pages,omitempty"`
+
+	// source
+	Source string `json:"source,omitempty"`
+
+	// tags
+	Tags []string `json:"tags"`
+
+	// thumbnail size
+	ThumbnailSize int64 `json:"thumbnailSize,omitempty"`
+
+	// title
+	Title string `json:"title,omitempty"`
+}
+
+// Validate validates this document
+func (m *Document) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Document) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Document) UnmarshalBinary(b []byte) error {
+	var res Document
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// These imports may not be used depending on the input parameters
+var _ = json.Marshal
+var _ = fmt.Sprintf
+var _ = url.QueryEscape
+var _ = strconv.FormatInt
+var _ = strings.Replace
+var _ = validate.Maximum
+var _ = strfmt.NewFormats
+
+// UploadDocumentInput holds the input parameters for a uploadDocument operation.
+type UploadDocumentInput struct {
+	File           io.ReadCloser
+	Thumbnail      io.ReadCloser
+	Title          string
+	Pages          *int64
+	Tags           []string
+	XRequestSource string
+}
+
+// Validate returns an error if any of the UploadDocumentInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i UploadDocumentInput) Validate() error {
+
+	if err := validate.MaxLength("title", "formData", string(i.Title), 20); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i UploadDocumentInput) Path() (string, error) {
+	path := "/v1/documents"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// AddCommentInput holds the input parameters for a addComment operation.
+type AddCommentInput struct {
+	ID       string
+	Author   string
+	Body     *string
+	Mentions []string
+}
+
+// Validate returns an error if any of the AddCommentInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i AddCommentInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i AddCommentInput) Path() (string, error) {
+	path := "/v1/documents/{id}/comments"
+	urlVals := url.Values{}
+
+	pathid := i.ID
+	if pathid == "" {
+		err := fmt.Errorf("id cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{id}", pathid, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
@@ -0,0 +1,369 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/Clever/kayvee-go/v7/logger"
+	"github.com/Clever/wag/samples/gen-go-upload/models/v9"
+	"github.com/go-errors/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"golang.org/x/xerrors"
+)
+
+var _ = strconv.ParseInt
+var _ = strfmt.Default
+var _ = swag.ConvertInt32
+var _ = errors.New
+var _ = mux.Vars
+var _ = bytes.Compare
+var _ = ioutil.ReadAll
+
+var formats = strfmt.Default
+var _ = formats
+
+// convertBase64 takes in a string and returns a strfmt.Base64 if the input
+// is valid base64 and an error otherwise.
+func convertBase64(input string) (strfmt.Base64, error) {
+	temp, err := formats.Parse("byte", input)
+	if err != nil {
+		return strfmt.Base64{}, err
+	}
+	return *temp.(*strfmt.Base64), nil
+}
+
+// convertDateTime takes in a string and returns a strfmt.DateTime if the input
+// is a valid DateTime and an error otherwise.
+func convertDateTime(input string) (strfmt.DateTime, error) {
+	temp, err := formats.Parse("date-time", input)
+	if err != nil {
+		return strfmt.DateTime{}, err
+	}
+	return *temp.(*strfmt.DateTime), nil
+}
+
+// convertDate takes in a string and returns a strfmt.Date if the input
+// is a valid Date and an error otherwise.
+func convertDate(input string) (strfmt.Date, error) {
+	temp, err := formats.Parse("date", input)
+	if err != nil {
+		return strfmt.Date{}, err
+	}
+	return *temp.(*strfmt.Date), nil
+}
+
+func jsonMarshalNoError(i interface{}) string {
+	bytes, err := json.Marshal(i)
+	if err != nil {
+		// This should never happen
+		return ""
+	}
+	return string(bytes)
+}
+
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+
+// statusCodeForUploadDocument returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForUploadDocument(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.Document:
+		return 200
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.Document:
+		return 200
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) UploadDocumentHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newUploadDocumentInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+	if input.File != nil {
+		defer input.File.Close()
+	}
+	if input.Thumbnail != nil {
+		defer input.Thumbnail.Close()
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	resp, err := h.UploadDocument(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForUploadDocument(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "uploadDocument", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForUploadDocument(resp))
+	w.Write(respBytes)
+
+}
+
+// newUploadDocumentInput takes in an http.Request an returns the input struct.
+func newUploadDocumentInput(r *http.Request) (*models.UploadDocumentInput, error) {
+	var input models.UploadDocumentInput
+
+	var err error
+	_ = err
+	if err := r.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+		return nil, err
+	}
+
+	fileFile, _, err := r.FormFile("file")
+	if err != nil && err != http.ErrMissingFile && err != http.ErrNotMultipart {
+		return nil, err
+	}
+	if fileFile == nil {
+		return nil, errors.New("form file 'file' must be specified")
+	}
+	input.File = fileFile
+
+	thumbnailFile, _, err := r.FormFile("thumbnail")
+	if err != nil && err != http.ErrMissingFile && err != http.ErrNotMultipart {
+		return nil, err
+	}
+	input.Thumbnail = thumbnailFile
+
+	titleStrs := r.PostForm["title"]
+	if len(titleStrs) == 0 {
+		return nil, errors.New("form parameter 'title' must be specified")
+	}
+
+	if len(titleStrs) > 0 {
+		var titleTmp string
+		titleStr := titleStrs[0]
+		titleTmp, err = titleStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Title = titleTmp
+	}
+
+	pagesStrs := r.PostForm["pages"]
+
+	if len(pagesStrs) > 0 {
+		var pagesTmp int64
+		pagesStr := pagesStrs[0]
+		pagesTmp, err = swag.ConvertInt64(pagesStr)
+		if err != nil {
+			return nil, err
+		}
+		input.Pages = &pagesTmp
+	}
+	if tags, ok := r.PostForm["tags"]; ok {
+		input.Tags = tags
+	}
+
+	xRequestSourceStrs := r.Header.Get("X-Request-Source")
+
+	if len(xRequestSourceStrs) > 0 {
+		var xRequestSourceTmp string
+		xRequestSourceTmp = xRequestSourceStrs
+		input.XRequestSource = xRequestSourceTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForAddComment returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForAddComment(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.Comment:
+		return 200
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.Comment:
+		return 200
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) AddCommentHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newAddCommentInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, map[string]string{"message": tooLarge.Error()})
+			return
+		}
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusBadRequest, models.BadRequest{Message: err.Error()})
+		return
+	}
+
+	resp, err := h.AddComment(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		} else if xerr, ok := err.(xerrors.Formatter); ok {
+			logger.FromContext(ctx).AddContext("frames", fmt.Sprintf("%+v", xerr))
+		}
+		statusCode := statusCodeForAddComment(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		writeError(w, r, statusCode, err)
+		return
+	}
+
+	respBytes, err := marshalResponse(ctx, "addComment", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, models.InternalError{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForAddComment(resp))
+	w.Write(respBytes)
+
+}
+
+// newAddCommentInput takes in an http.Request an returns the input struct.
+func newAddCommentInput(r *http.Request) (*models.AddCommentInput, error) {
+	var input models.AddCommentInput
+
+	var err error
+	_ = err
+	if err := r.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+		return nil, err
+	}
+
+	idStr := mux.Vars(r)["id"]
+	if len(idStr) == 0 {
+		return nil, errors.New("path parameter 'id' must be specified")
+	}
+	idStrs := []string{idStr}
+
+	if len(idStrs) > 0 {
+		var idTmp string
+		idStr := idStrs[0]
+		idTmp, err = idStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.ID = idTmp
+	}
+
+	authorStrs := r.PostForm["author"]
+	if len(authorStrs) == 0 {
+		return nil, errors.New("form parameter 'author' must be specified")
+	}
+
+	if len(authorStrs) > 0 {
+		var authorTmp string
+		authorStr := authorStrs[0]
+		authorTmp, err = authorStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Author = authorTmp
+	}
+
+	bodyStrs := r.PostForm["body"]
+
+	if len(bodyStrs) > 0 {
+		var bodyTmp string
+		bodyStr := bodyStrs[0]
+		bodyTmp, err = bodyStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Body = &bodyTmp
+	}
+	if mentions, ok := r.PostForm["mentions"]; ok {
+		input.Mentions = mentions
+	}
+
+	return &input, nil
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Clever/wag/samples/gen-go-upload/models/v9"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock_controller.go -package server --build_flags=--mod=mod -imports=models=github.com/Clever/wag/samples/gen-go-upload/models/v9
+
+// Controller defines the interface for the upload-test service.
+type Controller interface {
+
+	// UploadDocument handles POST requests to /documents
+	//
+	// 200: *models.Document
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	UploadDocument(ctx context.Context, i *models.UploadDocumentInput) (*models.Document, error)
+
+	// AddComment handles POST requests to /documents/{id}/comments
+	//
+	// 200: *models.Comment
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	AddComment(ctx context.Context, i *models.AddCommentInput) (*models.Comment, error)
+}
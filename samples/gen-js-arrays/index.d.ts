@@ -0,0 +1,125 @@
+import { Logger } from "kayvee";
+
+type Callback<R> = (err: Error, result: R) => void;
+type ArrayInner<R> = R extends (infer T)[] ? T : never;
+
+interface RetryPolicy {
+  backoffs(): number[];
+  retry(requestOptions: {method: string}, err: Error, res: {statusCode: number}): boolean;
+}
+
+interface RequestOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  retryPolicy?: RetryPolicy;
+  headers?: { [key: string]: string };
+}
+
+interface IterResult<R> {
+  map<T>(f: (r: R) => T, cb?: Callback<T[]>): Promise<T[]>;
+  toArray(cb?: Callback<R[]>): Promise<R[]>;
+  forEach(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+  forEachAsync(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+}
+
+interface CircuitOptions {
+  forceClosed?: boolean;
+  maxConcurrentRequests?: number;
+  requestVolumeThreshold?: number;
+  sleepWindow?: number;
+  errorPercentThreshold?: number;
+}
+
+interface GenericOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  keepalive?: boolean;
+  retryPolicy?: RetryPolicy;
+  logger?: Logger;
+  circuit?: CircuitOptions;
+  serviceName?: string;
+  asynclocalstore?: object;
+}
+
+interface DiscoveryOptions {
+  discovery: true;
+  address?: undefined;
+}
+
+interface AddressOptions {
+  discovery?: false;
+  address: string;
+}
+
+type ArraysTestOptions = (DiscoveryOptions | AddressOptions) & GenericOptions;
+
+import models = ArraysTest.Models
+
+declare class ArraysTest {
+  constructor(options: ArraysTestOptions);
+
+  close(): void;
+  
+  getBooks(params: models.GetBooksParams, options?: RequestOptions, cb?: Callback<models.BookQuery>): Promise<models.BookQuery>
+  
+}
+
+declare namespace ArraysTest {
+  const RetryPolicies: {
+    Single: RetryPolicy;
+    Exponential: RetryPolicy;
+    None: RetryPolicy;
+  }
+
+  const DefaultCircuitOptions: CircuitOptions;
+
+  namespace Errors {
+    interface ErrorBody {
+      message: string;
+      [key: string]: any;
+    }
+
+    
+    class BadRequest {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class InternalError {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+  }
+
+  namespace Models {
+    
+    type BookQuery = {
+  authors?: string[];
+  flags?: boolean[];
+  ids?: number[];
+  ratings?: number[];
+  tags?: string[];
+  years?: number[];
+};
+    
+    type GetBooksParams = {
+  ids?: number[];
+  authors?: string[];
+  ratings?: number[];
+  flags?: boolean[];
+  years?: number[];
+  tags?: string[];
+};
+    
+    type UnknownResponse = {
+  body?: string;
+  statusCode?: number;
+};
+    
+  }
+}
+
+export = ArraysTest;
@@ -0,0 +1,34 @@
+module.exports.Errors = {};
+
+/**
+ * BadRequest
+ * @extends Error
+ * @memberof module:arrays-test
+ * @alias module:arrays-test.Errors.BadRequest
+ * @property {string} message
+ */
+module.exports.Errors.BadRequest = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+  }
+};
+
+/**
+ * InternalError
+ * @extends Error
+ * @memberof module:arrays-test
+ * @alias module:arrays-test.Errors.InternalError
+ * @property {string} message
+ */
+module.exports.Errors.InternalError = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+  }
+};
+
@@ -0,0 +1,136 @@
+import { Logger } from "kayvee";
+
+type Callback<R> = (err: Error, result: R) => void;
+type ArrayInner<R> = R extends (infer T)[] ? T : never;
+
+interface RetryPolicy {
+  backoffs(): number[];
+  retry(requestOptions: {method: string}, err: Error, res: {statusCode: number}): boolean;
+}
+
+interface RequestOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  retryPolicy?: RetryPolicy;
+  headers?: { [key: string]: string };
+}
+
+interface IterResult<R> {
+  map<T>(f: (r: R) => T, cb?: Callback<T[]>): Promise<T[]>;
+  toArray(cb?: Callback<R[]>): Promise<R[]>;
+  forEach(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+  forEachAsync(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+}
+
+interface CircuitOptions {
+  forceClosed?: boolean;
+  maxConcurrentRequests?: number;
+  requestVolumeThreshold?: number;
+  sleepWindow?: number;
+  errorPercentThreshold?: number;
+}
+
+interface Credentials {
+  token?: string;
+  username?: string;
+  password?: string;
+}
+
+type CredentialsProvider = (scheme: string) => Credentials | undefined | Promise<Credentials | undefined>;
+
+interface GenericOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  keepalive?: boolean;
+  retryPolicy?: RetryPolicy;
+  logger?: Logger;
+  circuit?: CircuitOptions;
+  serviceName?: string;
+  asynclocalstore?: object;
+  credentialsProvider?: CredentialsProvider;
+}
+
+interface DiscoveryOptions {
+  discovery: true;
+  address?: undefined;
+}
+
+interface AddressOptions {
+  discovery?: false;
+  address: string;
+}
+
+type AuthTestOptions = (DiscoveryOptions | AddressOptions) & GenericOptions;
+
+import models = AuthTest.Models
+
+declare class AuthTest {
+  constructor(options: AuthTestOptions);
+
+  close(): void;
+  
+  healthCheck(options?: RequestOptions, cb?: Callback<void>): Promise<void>
+  
+  getWidgets(options?: RequestOptions, cb?: Callback<models.Widget[]>): Promise<models.Widget[]>
+  
+  createWidget(widget: models.Widget, options?: RequestOptions, cb?: Callback<models.Widget>): Promise<models.Widget>
+  
+  getWidget(name: string, options?: RequestOptions, cb?: Callback<models.Widget>): Promise<models.Widget>
+  
+}
+
+declare namespace AuthTest {
+  const RetryPolicies: {
+    Single: RetryPolicy;
+    Exponential: RetryPolicy;
+    None: RetryPolicy;
+  }
+
+  const DefaultCircuitOptions: CircuitOptions;
+
+  namespace Errors {
+    interface ErrorBody {
+      message: string;
+      [key: string]: any;
+    }
+
+    
+    class BadRequest {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class InternalError {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class Forbidden {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+  }
+
+  namespace Models {
+    
+    type Forbidden = {
+  message?: string;
+};
+    
+    type UnknownResponse = {
+  body?: string;
+  statusCode?: number;
+};
+    
+    type Widget = {
+  name?: string;
+};
+    
+  }
+}
+
+export = AuthTest;
@@ -0,0 +1,50 @@
+module.exports.Errors = {};
+
+/**
+ * BadRequest
+ * @extends Error
+ * @memberof module:auth-test
+ * @alias module:auth-test.Errors.BadRequest
+ * @property {string} message
+ */
+module.exports.Errors.BadRequest = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+  }
+};
+
+/**
+ * InternalError
+ * @extends Error
+ * @memberof module:auth-test
+ * @alias module:auth-test.Errors.InternalError
+ * @property {string} message
+ */
+module.exports.Errors.InternalError = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+  }
+};
+
+/**
+ * Forbidden
+ * @extends Error
+ * @memberof module:auth-test
+ * @alias module:auth-test.Errors.Forbidden
+ * @property {string} message
+ */
+module.exports.Errors.Forbidden = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+  }
+};
+
@@ -100,6 +100,7 @@ declare namespace SwaggerTest {
 
     
     class BadRequest {
+  errors?: models.FieldError[];
   message?: string;
 
   constructor(body: ErrorBody);
@@ -172,6 +173,12 @@ declare namespace SwaggerTest {
   message?: string;
 };
     
+    type FieldError = {
+  code?: number;
+  message?: string;
+  path?: string;
+};
+    
     type GetAuthorsParams = {
   name?: string;
   startingAfter?: string;
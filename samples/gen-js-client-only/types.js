@@ -5,6 +5,7 @@ module.exports.Errors = {};
  * @extends Error
  * @memberof module:swagger-test
  * @alias module:swagger-test.Errors.BadRequest
+ * @property {Object[]} errors
  * @property {string} message
  */
 module.exports.Errors.BadRequest = class extends Error {
@@ -0,0 +1,157 @@
+import { Logger } from "kayvee";
+
+type Callback<R> = (err: Error, result: R) => void;
+type ArrayInner<R> = R extends (infer T)[] ? T : never;
+
+interface RetryPolicy {
+  backoffs(): number[];
+  retry(requestOptions: {method: string}, err: Error, res: {statusCode: number}): boolean;
+}
+
+interface RequestOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  retryPolicy?: RetryPolicy;
+  headers?: { [key: string]: string };
+}
+
+interface IterResult<R> {
+  map<T>(f: (r: R) => T, cb?: Callback<T[]>): Promise<T[]>;
+  toArray(cb?: Callback<R[]>): Promise<R[]>;
+  forEach(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+  forEachAsync(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+}
+
+interface CircuitOptions {
+  forceClosed?: boolean;
+  maxConcurrentRequests?: number;
+  requestVolumeThreshold?: number;
+  sleepWindow?: number;
+  errorPercentThreshold?: number;
+}
+
+interface GenericOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  keepalive?: boolean;
+  retryPolicy?: RetryPolicy;
+  logger?: Logger;
+  circuit?: CircuitOptions;
+  serviceName?: string;
+  asynclocalstore?: object;
+}
+
+interface DiscoveryOptions {
+  discovery: true;
+  address?: undefined;
+}
+
+interface AddressOptions {
+  discovery?: false;
+  address: string;
+}
+
+type InlineTestOptions = (DiscoveryOptions | AddressOptions) & GenericOptions;
+
+import models = InlineTest.Models
+
+declare class InlineTest {
+  constructor(options: InlineTestOptions);
+
+  close(): void;
+  
+  listThings(options?: RequestOptions, cb?: Callback<models.ListThingsOKBodyItem[]>): Promise<models.ListThingsOKBodyItem[]>
+  
+  createThing(thing: models.CreateThingRequestBody, options?: RequestOptions, cb?: Callback<models.Thing>): Promise<models.Thing>
+  
+  getThing(id: string, options?: RequestOptions, cb?: Callback<models.GetThingOKBody>): Promise<models.GetThingOKBody>
+  
+}
+
+declare namespace InlineTest {
+  const RetryPolicies: {
+    Single: RetryPolicy;
+    Exponential: RetryPolicy;
+    None: RetryPolicy;
+  }
+
+  const DefaultCircuitOptions: CircuitOptions;
+
+  namespace Errors {
+    interface ErrorBody {
+      message: string;
+      [key: string]: any;
+    }
+
+    
+    class BadRequest {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class InternalError {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+  }
+
+  namespace Models {
+    
+    type CreateThingRequestBody = {
+  metadata?: ThingMetadata;
+  name: string;
+};
+    
+    type GetThingOKBody = {
+  fetchedAt?: string;
+  thing?: Thing;
+};
+    
+    type ListThingsOKBodyItem = {
+  id?: string;
+  name?: string;
+};
+    
+    type Thing = {
+  history?: ThingHistoryItem[];
+  id?: string;
+  labels?: ThingLabels;
+  metadata?: ThingMetadata;
+  name?: string;
+};
+    
+    type ThingHistoryItem = {
+  count?: number;
+  event?: string;
+};
+    
+    type ThingLabels = {
+  [key: string]: ThingLabelsValue;
+};
+    
+    type ThingLabelsValue = {
+  color?: string;
+};
+    
+    type ThingMetadata = {
+  createdAt?: string;
+  owner?: ThingMetadataOwner;
+};
+    
+    type ThingMetadataOwner = {
+  email?: string;
+  name?: string;
+};
+    
+    type UnknownResponse = {
+  body?: string;
+  statusCode?: number;
+};
+    
+  }
+}
+
+export = InlineTest;
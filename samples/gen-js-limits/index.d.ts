@@ -0,0 +1,166 @@
+import { Logger } from "kayvee";
+
+type Callback<R> = (err: Error, result: R) => void;
+type ArrayInner<R> = R extends (infer T)[] ? T : never;
+
+interface RetryPolicy {
+  backoffs(): number[];
+  retry(requestOptions: {method: string}, err: Error, res: {statusCode: number}): boolean;
+}
+
+interface RequestOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  retryPolicy?: RetryPolicy;
+  headers?: { [key: string]: string };
+}
+
+interface IterResult<R> {
+  map<T>(f: (r: R) => T, cb?: Callback<T[]>): Promise<T[]>;
+  toArray(cb?: Callback<R[]>): Promise<R[]>;
+  forEach(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+  forEachAsync(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+}
+
+interface CircuitOptions {
+  forceClosed?: boolean;
+  maxConcurrentRequests?: number;
+  requestVolumeThreshold?: number;
+  sleepWindow?: number;
+  errorPercentThreshold?: number;
+}
+
+interface Credentials {
+  token?: string;
+  username?: string;
+  password?: string;
+}
+
+type CredentialsProvider = (scheme: string) => Credentials | undefined | Promise<Credentials | undefined>;
+
+interface GenericOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  keepalive?: boolean;
+  retryPolicy?: RetryPolicy;
+  logger?: Logger;
+  circuit?: CircuitOptions;
+  serviceName?: string;
+  asynclocalstore?: object;
+  credentialsProvider?: CredentialsProvider;
+}
+
+interface DiscoveryOptions {
+  discovery: true;
+  address?: undefined;
+}
+
+interface AddressOptions {
+  discovery?: false;
+  address: string;
+}
+
+type LimitsTestOptions = (DiscoveryOptions | AddressOptions) & GenericOptions;
+
+import models = LimitsTest.Models
+
+declare class LimitsTest {
+  constructor(options: LimitsTestOptions);
+
+  close(): void;
+  
+  limitedByAuthenticatedCaller(options?: RequestOptions, cb?: Callback<void>): Promise<void>
+  
+  limitedByCaller(options?: RequestOptions, cb?: Callback<void>): Promise<void>
+  
+  limitedByHeader(options?: RequestOptions, cb?: Callback<void>): Promise<void>
+  
+  limitedByIP(options?: RequestOptions, cb?: Callback<void>): Promise<void>
+  
+  createItem(item: models.Item, options?: RequestOptions, cb?: Callback<models.Item>): Promise<models.Item>
+  
+  createNote(note: models.Item, options?: RequestOptions, cb?: Callback<models.Item>): Promise<models.Item>
+  
+  sleep(sleep: number, options?: RequestOptions, cb?: Callback<void>): Promise<void>
+  
+  slow(sleep: number, options?: RequestOptions, cb?: Callback<void>): Promise<void>
+  
+  unlimited(options?: RequestOptions, cb?: Callback<void>): Promise<void>
+  
+}
+
+declare namespace LimitsTest {
+  const RetryPolicies: {
+    Single: RetryPolicy;
+    Exponential: RetryPolicy;
+    None: RetryPolicy;
+  }
+
+  const DefaultCircuitOptions: CircuitOptions;
+
+  namespace Errors {
+    interface ErrorBody {
+      message: string;
+      [key: string]: any;
+    }
+
+    
+    class BadRequest {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class InternalError {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class TooManyRequests {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class PayloadTooLarge {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class GatewayTimeout {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+  }
+
+  namespace Models {
+    
+    type GatewayTimeout = {
+  message?: string;
+};
+    
+    type Item = {
+  name?: string;
+};
+    
+    type PayloadTooLarge = {
+  message?: string;
+};
+    
+    type TooManyRequests = {
+  message?: string;
+};
+    
+    type UnknownResponse = {
+  body?: string;
+  statusCode?: number;
+};
+    
+  }
+}
+
+export = LimitsTest;
@@ -0,0 +1,1450 @@
+const async = require("async");
+const discovery = require("clever-discovery");
+const kayvee = require("kayvee");
+const request = require("request");
+const {commandFactory, circuitFactory, metricsFactory} = require("hystrixjs");
+const RollingNumberEvent = require("hystrixjs/lib/metrics/RollingNumberEvent");
+
+const { Errors } = require("./types");
+
+function parseForBaggage(entries) {
+  if (!entries) {
+    return "";
+  }
+  // Regular expression for valid characters in keys and values
+  const validChars = /^[a-zA-Z0-9!#$%&'*+`\-.^_`|~]+$/;
+
+  const pairs = [];
+
+  entries.forEach((value, key) => {
+    const validKey = key.match(validChars) ? key : encodeURIComponent(key);
+    const validValue = value.match(validChars) ? value : encodeURIComponent(value);
+    pairs.push(`${validKey}=${validValue}`);
+  });
+
+  return pairs.join(",");
+}
+
+/**
+ * The exponential retry policy will retry five times with an exponential backoff.
+ * @alias module:limits-test.RetryPolicies.Exponential
+ */
+const exponentialRetryPolicy = {
+  backoffs() {
+    const ret = [];
+    let next = 100.0; // milliseconds
+    const e = 0.05; // +/- 5% jitter
+    while (ret.length < 5) {
+      const jitter = ((Math.random() * 2) - 1) * e * next;
+      ret.push(next + jitter);
+      next *= 2;
+    }
+    return ret;
+  },
+  retry(requestOptions, err, res) {
+    if (err || requestOptions.method === "POST" ||
+        requestOptions.method === "PATCH" ||
+        res.statusCode < 500) {
+      return false;
+    }
+    return true;
+  },
+};
+
+/**
+ * Use this retry policy to retry a request once.
+ * @alias module:limits-test.RetryPolicies.Single
+ */
+const singleRetryPolicy = {
+  backoffs() {
+    return [1000];
+  },
+  retry(requestOptions, err, res) {
+    if (err || requestOptions.method === "POST" ||
+        requestOptions.method === "PATCH" ||
+        res.statusCode < 500) {
+      return false;
+    }
+    return true;
+  },
+};
+
+/**
+ * Use this retry policy to turn off retries.
+ * @alias module:limits-test.RetryPolicies.None
+ */
+const noRetryPolicy = {
+  backoffs() {
+    return [];
+  },
+  retry() {
+    return false;
+  },
+};
+
+/**
+ * Request status log is used to
+ * to output the status of a request returned
+ * by the client.
+ * @private
+ */
+function responseLog(logger, req, res, err) {
+  var res = res || { };
+  var req = req || { };
+  var logData = {
+	"backend": "limits-test",
+	"method": req.method || "",
+	"uri": req.uri || "",
+    "message": err || (res.statusMessage || ""),
+    "status_code": res.statusCode || 0,
+  };
+  
+  if (err) {
+	if (logData.status_code <= 499){
+		logger.warnD("client-request-finished", logData);
+	}else{
+		logger.errorD("client-request-finished", logData);
+	}
+  } else {
+    logger.infoD("client-request-finished", logData);
+  }
+}
+
+/**
+ * Takes a promise and uses the provided callback (if any) to handle promise
+ * resolutions and rejections
+ * @private
+ */
+function applyCallback(promise, cb) {
+  if (!cb) {
+    return promise;
+  }
+  return promise.then((result) => {
+    cb(null, result);
+  }).catch((err) => {
+    cb(err);
+  });
+}
+
+/**
+ * The security schemes defined in the swagger spec.
+ * @private
+ */
+const securitySchemes = {
+  "api_key": { type: "apiKey", in: "header", name: "X-API-Key" },
+};
+
+/**
+ * Resolves the headers and query parameters that carry credentials for an operation. The
+ * requirements are alternatives, so the first one the credentials provider can satisfy is used.
+ * Empty requirements, which allow anonymous requests, are skipped so that credentials are still
+ * sent when they're available. If none can be satisfied the request is sent without credentials.
+ * @private
+ */
+async function resolveCredentials(provider, requirements) {
+  const resolved = { headers: {}, query: {} };
+  if (!provider) {
+    return resolved;
+  }
+  for (const requirement of requirements) {
+    if (requirement.length === 0) {
+      continue;
+    }
+    const credentials = await Promise.all(requirement.map(scheme => provider(scheme)));
+    if (credentials.some(c => !c)) {
+      continue;
+    }
+    requirement.forEach((scheme, i) => {
+      const c = credentials[i];
+      const def = securitySchemes[scheme];
+      if (def.type === "basic") {
+        resolved.headers.authorization = "Basic " + Buffer.from(c.username + ":" + c.password).toString("base64");
+      } else if (def.type === "oauth2") {
+        resolved.headers.authorization = "Bearer " + c.token;
+      } else if (def.in === "query") {
+        resolved.query[def.name] = c.token;
+      } else {
+        resolved.headers[def.name] = c.token;
+      }
+    });
+    return resolved;
+  }
+  return resolved;
+}
+
+/**
+ * Default circuit breaker options.
+ * @alias module:limits-test.DefaultCircuitOptions
+ */
+const defaultCircuitOptions = {
+  forceClosed:            true,
+  requestVolumeThreshold: 20,
+  maxConcurrentRequests:  100,
+  requestVolumeThreshold: 20,
+  sleepWindow:            5000,
+  errorPercentThreshold:  90,
+  logIntervalMs:          30000
+};
+
+/**
+ * limits-test client library.
+ * @module limits-test
+ * @typicalname LimitsTest
+ */
+
+/**
+ * limits-test client
+ * @alias module:limits-test
+ */
+class LimitsTest {
+
+  /**
+   * Create a new client object.
+   * @param {Object} options - Options for constructing a client object.
+   * @param {string} [options.address] - URL where the server is located. Must provide
+   * this or the discovery argument
+   * @param {bool} [options.discovery] - Use clever-discovery to locate the server. Must provide
+   * this or the address argument
+   * @param {number} [options.timeout] - The timeout to use for all client requests,
+   * in milliseconds. This can be overridden on a per-request basis. Default is 5000ms.
+   * @param {bool} [options.keepalive] - Set keepalive to true for client requests. This sets the
+   * forever: true attribute in request. Defaults to true.
+   * @param {module:limits-test.RetryPolicies} [options.retryPolicy=RetryPolicies.Single] - The logic to
+   * determine which requests to retry, as well as how many times to retry.
+   * @param {module:kayvee.Logger} [options.logger=logger.New("limits-test-wagclient")] - The Kayvee
+   * logger to use in the client.
+   * @param {Object} [options.circuit] - Options for constructing the client's circuit breaker.
+   * @param {bool} [options.circuit.forceClosed] - When set to true the circuit will always be closed. Default: true.
+   * @param {number} [options.circuit.maxConcurrentRequests] - the maximum number of concurrent requests
+   * the client can make at the same time. Default: 100.
+   * @param {number} [options.circuit.requestVolumeThreshold] - The minimum number of requests needed
+   * before a circuit can be tripped due to health. Default: 20.
+   * @param {number} [options.circuit.sleepWindow] - how long, in milliseconds, to wait after a circuit opens
+   * before testing for recovery. Default: 5000.
+   * @param {number} [options.circuit.errorPercentThreshold] - the threshold to place on the rolling error
+   * rate. Once the error rate exceeds this percentage, the circuit opens.
+   * Default: 90.
+   * @param {object} [options.asynclocalstore] a request scoped async store 
+   * @param {function} [options.credentialsProvider] - Called with the name of a security scheme
+   * before requests to operations that require it. Returns (or resolves to) an object with a
+   * token (apiKey and oauth2 schemes) or a username and password (basic schemes), or undefined
+   * if there are no credentials for the scheme.
+   */
+  constructor(options) {
+    options = options || {};
+
+    if (options.discovery) {
+      try {
+        this.address = discovery(options.serviceName || "limits-test", "http").url();
+      } catch (e) {
+        this.address = discovery(options.serviceName || "limits-test", "default").url();
+      }
+    } else if (options.address) {
+      this.address = options.address;
+    } else {
+      throw new Error("Cannot initialize limits-test without discovery or address");
+    }
+    if (options.keepalive !== undefined) {
+      this.keepalive = options.keepalive;
+    } else {
+      this.keepalive = true;
+    }
+    if (options.timeout) {
+      this.timeout = options.timeout;
+    } else {
+      this.timeout = 5000;
+    }
+    if (options.retryPolicy) {
+      this.retryPolicy = options.retryPolicy;
+    }
+    if (options.logger) {
+      this.logger = options.logger;
+    } else {
+      this.logger = new kayvee.logger((options.serviceName || "limits-test") + "-wagclient");
+    }
+    if (options.asynclocalstore) {
+      this.asynclocalstore = options.asynclocalstore;
+    }
+    if (options.credentialsProvider) {
+      this.credentialsProvider = options.credentialsProvider;
+    }
+
+
+    const circuitOptions = Object.assign({}, defaultCircuitOptions, options.circuit);
+    // hystrix implements a caching mechanism, we don't want this or we can't trust that clients
+    // are initialized with the values passed in. 
+    commandFactory.resetCache();
+    circuitFactory.resetCache();
+    metricsFactory.resetCache();
+    this._hystrixCommand = commandFactory.getOrCreate(options.serviceName || "limits-test").
+      errorHandler(this._hystrixCommandErrorHandler).
+      circuitBreakerForceClosed(circuitOptions.forceClosed).
+      requestVolumeRejectionThreshold(circuitOptions.maxConcurrentRequests).
+      circuitBreakerRequestVolumeThreshold(circuitOptions.requestVolumeThreshold).
+      circuitBreakerSleepWindowInMilliseconds(circuitOptions.sleepWindow).
+      circuitBreakerErrorThresholdPercentage(circuitOptions.errorPercentThreshold).
+      timeout(0).
+      statisticalWindowLength(10000).
+      statisticalWindowNumberOfBuckets(10).
+      run(this._hystrixCommandRun).
+      context(this).
+      build();
+
+    this._logCircuitStateInterval = setInterval(() => this._logCircuitState(), circuitOptions.logIntervalMs);
+  }
+
+  /**
+  * Releases handles used in client
+  */
+  close() {
+    clearInterval(this._logCircuitStateInterval);
+  }
+
+  _hystrixCommandErrorHandler(err) {
+    // to avoid counting 4XXs as errors, only count an error if it comes from the request library
+    if (err._fromRequest === true) {
+      return err;
+    }
+    return false;
+  }
+
+  _hystrixCommandRun(method, args) {
+    return method.apply(this, args);
+  }
+
+  _logCircuitState(logger) {
+    // code below heavily borrows from hystrix's internal HystrixSSEStream.js logic
+    const metrics = this._hystrixCommand.metrics;
+    const healthCounts = metrics.getHealthCounts()
+    const circuitBreaker = this._hystrixCommand.circuitBreaker;
+    this.logger.infoD("limits-test", {
+      "requestCount":                    healthCounts.totalCount,
+      "errorCount":                      healthCounts.errorCount,
+      "errorPercentage":                 healthCounts.errorPercentage,
+      "isCircuitBreakerOpen":            circuitBreaker.isOpen(),
+      "rollingCountFailure":             metrics.getRollingCount(RollingNumberEvent.FAILURE),
+      "rollingCountShortCircuited":      metrics.getRollingCount(RollingNumberEvent.SHORT_CIRCUITED),
+      "rollingCountSuccess":             metrics.getRollingCount(RollingNumberEvent.SUCCESS),
+      "rollingCountTimeout":             metrics.getRollingCount(RollingNumberEvent.TIMEOUT),
+      "currentConcurrentExecutionCount": metrics.getCurrentExecutionCount(),
+      "latencyTotalMean":                metrics.getExecutionTime("mean") || 0,
+    });
+  }
+
+  /**
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {Map<string, string | number>} [options.baggage] - A request-specific baggage to be propagated
+   * @param {module:limits-test.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {Object.<string, string>} [options.headers] - Additional headers to send with the request
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {undefined}
+   * @reject {module:limits-test.Errors.BadRequest}
+   * @reject {module:limits-test.Errors.InternalError}
+   * @reject {Error}
+   */
+  limitedByAuthenticatedCaller(options, cb) {
+    let callback = cb;
+    if (!cb && typeof options === "function") {
+      callback = options;
+    }
+    return applyCallback(this._hystrixCommand.execute(this._limitedByAuthenticatedCaller, arguments), callback);
+  }
+
+  _limitedByAuthenticatedCaller(options, cb) {
+    const params = {};
+
+    if (!cb && typeof options === "function") {
+      options = undefined;
+    }
+
+    return resolveCredentials(this.credentialsProvider, [["api_key"]]).then(credentials => new Promise((resolve, reject) => {
+      if (!options) {
+        options = {};
+      }
+  
+      const optionsBaggage = options.baggage || new Map();
+
+      const storeContext = this.asynclocalstore?.get("context") || new Map();
+
+      const combinedContext = new Map([...storeContext, ...optionsBaggage]);
+
+      const timeout = options.timeout || this.timeout;
+
+      let headers = {};
+
+      // Merge custom headers from options if provided
+      headers = {...(options.headers || {})};
+
+      // Convert combinedContext into a string using parseForBaggage
+      headers["baggage"] = parseForBaggage(combinedContext);
+
+      headers["Canonical-Resource"] = "limitedByAuthenticatedCaller";
+      headers[versionHeader] = version;
+      Object.assign(headers, credentials.headers);
+
+      const query = {};
+      Object.assign(query, credentials.query);
+
+      const requestOptions = {
+        method: "GET",
+        uri: this.address + "/v1/by-authenticated-caller",
+        gzip: true,
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            reject(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolve();
+              break;
+
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+          }
+        });
+      }());
+    }));
+  }
+
+  /**
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {Map<string, string | number>} [options.baggage] - A request-specific baggage to be propagated
+   * @param {module:limits-test.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {Object.<string, string>} [options.headers] - Additional headers to send with the request
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {undefined}
+   * @reject {module:limits-test.Errors.BadRequest}
+   * @reject {module:limits-test.Errors.InternalError}
+   * @reject {Error}
+   */
+  limitedByCaller(options, cb) {
+    let callback = cb;
+    if (!cb && typeof options === "function") {
+      callback = options;
+    }
+    return applyCallback(this._hystrixCommand.execute(this._limitedByCaller, arguments), callback);
+  }
+
+  _limitedByCaller(options, cb) {
+    const params = {};
+
+    if (!cb && typeof options === "function") {
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      if (!options) {
+        options = {};
+      }
+  
+      const optionsBaggage = options.baggage || new Map();
+
+      const storeContext = this.asynclocalstore?.get("context") || new Map();
+
+      const combinedContext = new Map([...storeContext, ...optionsBaggage]);
+
+      const timeout = options.timeout || this.timeout;
+
+      let headers = {};
+
+      // Merge custom headers from options if provided
+      headers = {...(options.headers || {})};
+
+      // Convert combinedContext into a string using parseForBaggage
+      headers["baggage"] = parseForBaggage(combinedContext);
+
+      headers["Canonical-Resource"] = "limitedByCaller";
+      headers[versionHeader] = version;
+
+      const query = {};
+
+      const requestOptions = {
+        method: "GET",
+        uri: this.address + "/v1/by-caller",
+        gzip: true,
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            reject(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolve();
+              break;
+
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {Map<string, string | number>} [options.baggage] - A request-specific baggage to be propagated
+   * @param {module:limits-test.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {Object.<string, string>} [options.headers] - Additional headers to send with the request
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {undefined}
+   * @reject {module:limits-test.Errors.BadRequest}
+   * @reject {module:limits-test.Errors.TooManyRequests}
+   * @reject {module:limits-test.Errors.InternalError}
+   * @reject {Error}
+   */
+  limitedByHeader(options, cb) {
+    let callback = cb;
+    if (!cb && typeof options === "function") {
+      callback = options;
+    }
+    return applyCallback(this._hystrixCommand.execute(this._limitedByHeader, arguments), callback);
+  }
+
+  _limitedByHeader(options, cb) {
+    const params = {};
+
+    if (!cb && typeof options === "function") {
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      if (!options) {
+        options = {};
+      }
+  
+      const optionsBaggage = options.baggage || new Map();
+
+      const storeContext = this.asynclocalstore?.get("context") || new Map();
+
+      const combinedContext = new Map([...storeContext, ...optionsBaggage]);
+
+      const timeout = options.timeout || this.timeout;
+
+      let headers = {};
+
+      // Merge custom headers from options if provided
+      headers = {...(options.headers || {})};
+
+      // Convert combinedContext into a string using parseForBaggage
+      headers["baggage"] = parseForBaggage(combinedContext);
+
+      headers["Canonical-Resource"] = "limitedByHeader";
+      headers[versionHeader] = version;
+
+      const query = {};
+
+      const requestOptions = {
+        method: "GET",
+        uri: this.address + "/v1/by-header",
+        gzip: true,
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            reject(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolve();
+              break;
+
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 429:
+              var err = new Errors.TooManyRequests(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {Map<string, string | number>} [options.baggage] - A request-specific baggage to be propagated
+   * @param {module:limits-test.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {Object.<string, string>} [options.headers] - Additional headers to send with the request
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {undefined}
+   * @reject {module:limits-test.Errors.BadRequest}
+   * @reject {module:limits-test.Errors.InternalError}
+   * @reject {Error}
+   */
+  limitedByIP(options, cb) {
+    let callback = cb;
+    if (!cb && typeof options === "function") {
+      callback = options;
+    }
+    return applyCallback(this._hystrixCommand.execute(this._limitedByIP, arguments), callback);
+  }
+
+  _limitedByIP(options, cb) {
+    const params = {};
+
+    if (!cb && typeof options === "function") {
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      if (!options) {
+        options = {};
+      }
+  
+      const optionsBaggage = options.baggage || new Map();
+
+      const storeContext = this.asynclocalstore?.get("context") || new Map();
+
+      const combinedContext = new Map([...storeContext, ...optionsBaggage]);
+
+      const timeout = options.timeout || this.timeout;
+
+      let headers = {};
+
+      // Merge custom headers from options if provided
+      headers = {...(options.headers || {})};
+
+      // Convert combinedContext into a string using parseForBaggage
+      headers["baggage"] = parseForBaggage(combinedContext);
+
+      headers["Canonical-Resource"] = "limitedByIP";
+      headers[versionHeader] = version;
+
+      const query = {};
+
+      const requestOptions = {
+        method: "GET",
+        uri: this.address + "/v1/by-ip",
+        gzip: true,
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            reject(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolve();
+              break;
+
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param item
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {Map<string, string | number>} [options.baggage] - A request-specific baggage to be propagated
+   * @param {module:limits-test.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {Object.<string, string>} [options.headers] - Additional headers to send with the request
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:limits-test.Errors.BadRequest}
+   * @reject {module:limits-test.Errors.PayloadTooLarge}
+   * @reject {module:limits-test.Errors.InternalError}
+   * @reject {Error}
+   */
+  createItem(item, options, cb) {
+    let callback = cb;
+    if (!cb && typeof options === "function") {
+      callback = options;
+    }
+    return applyCallback(this._hystrixCommand.execute(this._createItem, arguments), callback);
+  }
+
+  _createItem(item, options, cb) {
+    const params = {};
+    params["item"] = item;
+
+    if (!cb && typeof options === "function") {
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      if (!options) {
+        options = {};
+      }
+  
+      const optionsBaggage = options.baggage || new Map();
+
+      const storeContext = this.asynclocalstore?.get("context") || new Map();
+
+      const combinedContext = new Map([...storeContext, ...optionsBaggage]);
+
+      const timeout = options.timeout || this.timeout;
+
+      let headers = {};
+
+      // Merge custom headers from options if provided
+      headers = {...(options.headers || {})};
+
+      // Convert combinedContext into a string using parseForBaggage
+      headers["baggage"] = parseForBaggage(combinedContext);
+
+      headers["Canonical-Resource"] = "createItem";
+      headers[versionHeader] = version;
+
+      const query = {};
+
+      const requestOptions = {
+        method: "POST",
+        uri: this.address + "/v1/items",
+        gzip: true,
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+
+      requestOptions.body = params.item;
+
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            reject(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolve(body);
+              break;
+
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 413:
+              var err = new Errors.PayloadTooLarge(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param note
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {Map<string, string | number>} [options.baggage] - A request-specific baggage to be propagated
+   * @param {module:limits-test.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {Object.<string, string>} [options.headers] - Additional headers to send with the request
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:limits-test.Errors.BadRequest}
+   * @reject {module:limits-test.Errors.InternalError}
+   * @reject {Error}
+   */
+  createNote(note, options, cb) {
+    let callback = cb;
+    if (!cb && typeof options === "function") {
+      callback = options;
+    }
+    return applyCallback(this._hystrixCommand.execute(this._createNote, arguments), callback);
+  }
+
+  _createNote(note, options, cb) {
+    const params = {};
+    params["note"] = note;
+
+    if (!cb && typeof options === "function") {
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      if (!options) {
+        options = {};
+      }
+  
+      const optionsBaggage = options.baggage || new Map();
+
+      const storeContext = this.asynclocalstore?.get("context") || new Map();
+
+      const combinedContext = new Map([...storeContext, ...optionsBaggage]);
+
+      const timeout = options.timeout || this.timeout;
+
+      let headers = {};
+
+      // Merge custom headers from options if provided
+      headers = {...(options.headers || {})};
+
+      // Convert combinedContext into a string using parseForBaggage
+      headers["baggage"] = parseForBaggage(combinedContext);
+
+      headers["Canonical-Resource"] = "createNote";
+      headers[versionHeader] = version;
+
+      const query = {};
+
+      const requestOptions = {
+        method: "POST",
+        uri: this.address + "/v1/notes",
+        gzip: true,
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+
+      requestOptions.body = params.note;
+
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            reject(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolve(body);
+              break;
+
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {number} sleep - How long to take, in milliseconds
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {Map<string, string | number>} [options.baggage] - A request-specific baggage to be propagated
+   * @param {module:limits-test.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {Object.<string, string>} [options.headers] - Additional headers to send with the request
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {undefined}
+   * @reject {module:limits-test.Errors.BadRequest}
+   * @reject {module:limits-test.Errors.InternalError}
+   * @reject {Error}
+   */
+  sleep(sleep, options, cb) {
+    let callback = cb;
+    if (!cb && typeof options === "function") {
+      callback = options;
+    }
+    return applyCallback(this._hystrixCommand.execute(this._sleep, arguments), callback);
+  }
+
+  _sleep(sleep, options, cb) {
+    const params = {};
+    params["sleep"] = sleep;
+
+    if (!cb && typeof options === "function") {
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      if (!options) {
+        options = {};
+      }
+  
+      const optionsBaggage = options.baggage || new Map();
+
+      const storeContext = this.asynclocalstore?.get("context") || new Map();
+
+      const combinedContext = new Map([...storeContext, ...optionsBaggage]);
+
+      const timeout = options.timeout || this.timeout;
+
+      let headers = {};
+
+      // Merge custom headers from options if provided
+      headers = {...(options.headers || {})};
+
+      // Convert combinedContext into a string using parseForBaggage
+      headers["baggage"] = parseForBaggage(combinedContext);
+
+      headers["Canonical-Resource"] = "sleep";
+      headers[versionHeader] = version;
+
+      const query = {};
+      query["sleep"] = params.sleep;
+
+
+      const requestOptions = {
+        method: "GET",
+        uri: this.address + "/v1/sleep",
+        gzip: true,
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            reject(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolve();
+              break;
+
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {number} sleep - How long to take, in milliseconds
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {Map<string, string | number>} [options.baggage] - A request-specific baggage to be propagated
+   * @param {module:limits-test.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {Object.<string, string>} [options.headers] - Additional headers to send with the request
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {undefined}
+   * @reject {module:limits-test.Errors.BadRequest}
+   * @reject {module:limits-test.Errors.InternalError}
+   * @reject {module:limits-test.Errors.GatewayTimeout}
+   * @reject {Error}
+   */
+  slow(sleep, options, cb) {
+    let callback = cb;
+    if (!cb && typeof options === "function") {
+      callback = options;
+    }
+    return applyCallback(this._hystrixCommand.execute(this._slow, arguments), callback);
+  }
+
+  _slow(sleep, options, cb) {
+    const params = {};
+    params["sleep"] = sleep;
+
+    if (!cb && typeof options === "function") {
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      if (!options) {
+        options = {};
+      }
+  
+      const optionsBaggage = options.baggage || new Map();
+
+      const storeContext = this.asynclocalstore?.get("context") || new Map();
+
+      const combinedContext = new Map([...storeContext, ...optionsBaggage]);
+
+      const timeout = options.timeout || this.timeout;
+
+      let headers = {};
+
+      // Merge custom headers from options if provided
+      headers = {...(options.headers || {})};
+
+      // Convert combinedContext into a string using parseForBaggage
+      headers["baggage"] = parseForBaggage(combinedContext);
+
+      headers["Canonical-Resource"] = "slow";
+      headers[versionHeader] = version;
+
+      const query = {};
+      query["sleep"] = params.sleep;
+
+
+      const requestOptions = {
+        method: "GET",
+        uri: this.address + "/v1/slow",
+        gzip: true,
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            reject(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolve();
+              break;
+
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 504:
+              var err = new Errors.GatewayTimeout(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {Map<string, string | number>} [options.baggage] - A request-specific baggage to be propagated
+   * @param {module:limits-test.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {Object.<string, string>} [options.headers] - Additional headers to send with the request
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {undefined}
+   * @reject {module:limits-test.Errors.BadRequest}
+   * @reject {module:limits-test.Errors.InternalError}
+   * @reject {Error}
+   */
+  unlimited(options, cb) {
+    let callback = cb;
+    if (!cb && typeof options === "function") {
+      callback = options;
+    }
+    return applyCallback(this._hystrixCommand.execute(this._unlimited, arguments), callback);
+  }
+
+  _unlimited(options, cb) {
+    const params = {};
+
+    if (!cb && typeof options === "function") {
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      if (!options) {
+        options = {};
+      }
+  
+      const optionsBaggage = options.baggage || new Map();
+
+      const storeContext = this.asynclocalstore?.get("context") || new Map();
+
+      const combinedContext = new Map([...storeContext, ...optionsBaggage]);
+
+      const timeout = options.timeout || this.timeout;
+
+      let headers = {};
+
+      // Merge custom headers from options if provided
+      headers = {...(options.headers || {})};
+
+      // Convert combinedContext into a string using parseForBaggage
+      headers["baggage"] = parseForBaggage(combinedContext);
+
+      headers["Canonical-Resource"] = "unlimited";
+      headers[versionHeader] = version;
+
+      const query = {};
+
+      const requestOptions = {
+        method: "GET",
+        uri: this.address + "/v1/unlimited",
+        gzip: true,
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            reject(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolve();
+              break;
+
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              reject(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+};
+
+module.exports = LimitsTest;
+
+/**
+ * Retry policies available to use.
+ * @alias module:limits-test.RetryPolicies
+ */
+module.exports.RetryPolicies = {
+  Single: singleRetryPolicy,
+  Exponential: exponentialRetryPolicy,
+  None: noRetryPolicy,
+};
+
+/**
+ * Errors returned by methods.
+ * @alias module:limits-test.Errors
+ */
+module.exports.Errors = Errors;
+
+module.exports.DefaultCircuitOptions = defaultCircuitOptions;
+
+const version = "9.0.0";
+const versionHeader = "X-Client-Version";
+module.exports.Version = version;
+module.exports.VersionHeader = versionHeader;
@@ -0,0 +1,82 @@
+module.exports.Errors = {};
+
+/**
+ * BadRequest
+ * @extends Error
+ * @memberof module:limits-test
+ * @alias module:limits-test.Errors.BadRequest
+ * @property {string} message
+ */
+module.exports.Errors.BadRequest = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+  }
+};
+
+/**
+ * InternalError
+ * @extends Error
+ * @memberof module:limits-test
+ * @alias module:limits-test.Errors.InternalError
+ * @property {string} message
+ */
+module.exports.Errors.InternalError = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+  }
+};
+
+/**
+ * TooManyRequests
+ * @extends Error
+ * @memberof module:limits-test
+ * @alias module:limits-test.Errors.TooManyRequests
+ * @property {string} message
+ */
+module.exports.Errors.TooManyRequests = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+  }
+};
+
+/**
+ * PayloadTooLarge
+ * @extends Error
+ * @memberof module:limits-test
+ * @alias module:limits-test.Errors.PayloadTooLarge
+ * @property {string} message
+ */
+module.exports.Errors.PayloadTooLarge = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+  }
+};
+
+/**
+ * GatewayTimeout
+ * @extends Error
+ * @memberof module:limits-test
+ * @alias module:limits-test.Errors.GatewayTimeout
+ * @property {string} message
+ */
+module.exports.Errors.GatewayTimeout = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+  }
+};
+
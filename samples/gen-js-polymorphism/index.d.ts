@@ -0,0 +1,145 @@
+import { Logger } from "kayvee";
+
+type Callback<R> = (err: Error, result: R) => void;
+type ArrayInner<R> = R extends (infer T)[] ? T : never;
+
+interface RetryPolicy {
+  backoffs(): number[];
+  retry(requestOptions: {method: string}, err: Error, res: {statusCode: number}): boolean;
+}
+
+interface RequestOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  retryPolicy?: RetryPolicy;
+  headers?: { [key: string]: string };
+}
+
+interface IterResult<R> {
+  map<T>(f: (r: R) => T, cb?: Callback<T[]>): Promise<T[]>;
+  toArray(cb?: Callback<R[]>): Promise<R[]>;
+  forEach(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+  forEachAsync(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+}
+
+interface CircuitOptions {
+  forceClosed?: boolean;
+  maxConcurrentRequests?: number;
+  requestVolumeThreshold?: number;
+  sleepWindow?: number;
+  errorPercentThreshold?: number;
+}
+
+interface GenericOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  keepalive?: boolean;
+  retryPolicy?: RetryPolicy;
+  logger?: Logger;
+  circuit?: CircuitOptions;
+  serviceName?: string;
+  asynclocalstore?: object;
+}
+
+interface DiscoveryOptions {
+  discovery: true;
+  address?: undefined;
+}
+
+interface AddressOptions {
+  discovery?: false;
+  address: string;
+}
+
+type PolymorphismTestOptions = (DiscoveryOptions | AddressOptions) & GenericOptions;
+
+import models = PolymorphismTest.Models
+
+declare class PolymorphismTest {
+  constructor(options: PolymorphismTestOptions);
+
+  close(): void;
+  
+  listEvents(options?: RequestOptions, cb?: Callback<models.Event[]>): Promise<models.Event[]>
+  
+  createEvent(event: models.Event, options?: RequestOptions, cb?: Callback<models.Event>): Promise<models.Event>
+  
+  putEvent(params: models.PutEventParams, options?: RequestOptions, cb?: Callback<models.PutEventResponse>): Promise<models.PutEventResponse>
+  
+  getFeed(options?: RequestOptions, cb?: Callback<models.Feed>): Promise<models.Feed>
+  
+}
+
+declare namespace PolymorphismTest {
+  const RetryPolicies: {
+    Single: RetryPolicy;
+    Exponential: RetryPolicy;
+    None: RetryPolicy;
+  }
+
+  const DefaultCircuitOptions: CircuitOptions;
+
+  namespace Errors {
+    interface ErrorBody {
+      message: string;
+      [key: string]: any;
+    }
+
+    
+    class BadRequest {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class InternalError {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+  }
+
+  namespace Models {
+    
+    type Click = EventBase & {
+  count?: number;
+  element: string;
+} & {
+  kind: "Click";
+};
+    
+    type Event = Click | Purchase;
+    
+    type EventBase = {
+  id: string;
+  kind: string;
+};
+    
+    type Feed = {
+  events?: Event[];
+  latest?: Event;
+};
+    
+    type Purchase = EventBase & {
+  amount: number;
+} & {
+  kind: "Purchase";
+};
+    
+    type PutEventParams = {
+  id: string;
+  event: Event;
+};
+    
+    type PutEventResponse = { statusCode: 200; body: Event } | { statusCode: 201; body: Event };
+    
+    type UnknownResponse = {
+  body?: string;
+  statusCode?: number;
+};
+    
+  }
+}
+
+export = PolymorphismTest;
@@ -0,0 +1,166 @@
+import { Logger } from "kayvee";
+
+type Callback<R> = (err: Error, result: R) => void;
+type ArrayInner<R> = R extends (infer T)[] ? T : never;
+
+interface RetryPolicy {
+  backoffs(): number[];
+  retry(requestOptions: {method: string}, err: Error, res: {statusCode: number}): boolean;
+}
+
+interface RequestOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  retryPolicy?: RetryPolicy;
+  headers?: { [key: string]: string };
+}
+
+interface IterResult<R> {
+  map<T>(f: (r: R) => T, cb?: Callback<T[]>): Promise<T[]>;
+  toArray(cb?: Callback<R[]>): Promise<R[]>;
+  forEach(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+  forEachAsync(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+}
+
+interface CircuitOptions {
+  forceClosed?: boolean;
+  maxConcurrentRequests?: number;
+  requestVolumeThreshold?: number;
+  sleepWindow?: number;
+  errorPercentThreshold?: number;
+}
+
+interface GenericOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  keepalive?: boolean;
+  retryPolicy?: RetryPolicy;
+  logger?: Logger;
+  circuit?: CircuitOptions;
+  serviceName?: string;
+  asynclocalstore?: object;
+}
+
+interface DiscoveryOptions {
+  discovery: true;
+  address?: undefined;
+}
+
+interface AddressOptions {
+  discovery?: false;
+  address: string;
+}
+
+type ProblemsTestOptions = (DiscoveryOptions | AddressOptions) & GenericOptions;
+
+import models = ProblemsTest.Models
+
+declare class ProblemsTest {
+  constructor(options: ProblemsTestOptions);
+
+  close(): void;
+  
+  createWidget(widget: models.Widget, options?: RequestOptions, cb?: Callback<models.Widget>): Promise<models.Widget>
+  
+  getWidget(params: models.GetWidgetParams, options?: RequestOptions, cb?: Callback<models.Widget>): Promise<models.Widget>
+  
+}
+
+declare namespace ProblemsTest {
+  const RetryPolicies: {
+    Single: RetryPolicy;
+    Exponential: RetryPolicy;
+    None: RetryPolicy;
+  }
+
+  const DefaultCircuitOptions: CircuitOptions;
+
+  namespace Errors {
+    interface ErrorBody {
+      message: string;
+      [key: string]: any;
+    }
+
+    
+    class BadRequest {
+  detail?: string;
+  instance?: string;
+  "invalid-params"?: {
+  name: string;
+  reason: string;
+}[];
+  invalidParams?: {
+  name: string;
+  reason: string;
+}[];
+  message?: string;
+  status?: number;
+  title?: string;
+  type?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class InternalError {
+  detail?: string;
+  instance?: string;
+  "invalid-params"?: {
+  name: string;
+  reason: string;
+}[];
+  invalidParams?: {
+  name: string;
+  reason: string;
+}[];
+  message?: string;
+  status?: number;
+  title?: string;
+  type?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class NotFound {
+  detail?: string;
+  id?: number;
+  instance?: string;
+  "invalid-params"?: {
+  name: string;
+  reason: string;
+}[];
+  invalidParams?: {
+  name: string;
+  reason: string;
+}[];
+  message?: string;
+  status?: number;
+  title?: string;
+  type?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+  }
+
+  namespace Models {
+    
+    type GetWidgetParams = {
+  id: number;
+  color?: ("red" | "blue");
+};
+    
+    type UnknownResponse = {
+  body?: string;
+  statusCode?: number;
+};
+    
+    type Widget = {
+  id?: number;
+  name: string;
+  size?: number;
+};
+    
+  }
+}
+
+export = ProblemsTest;
@@ -0,0 +1,81 @@
+module.exports.Errors = {};
+
+/**
+ * BadRequest
+ * @extends Error
+ * @memberof module:problems-test
+ * @alias module:problems-test.Errors.BadRequest
+ * @property {string} detail
+ * @property {string} instance
+ * @property {Object[]} invalid-params
+ * @property {Object[]} invalidParams
+ * @property {string} message
+ * @property {number} status
+ * @property {string} title
+ * @property {string} type
+ */
+module.exports.Errors.BadRequest = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+    if (body["invalid-params"] !== undefined) {
+      this.invalidParams = body["invalid-params"];
+    }
+  }
+};
+
+/**
+ * InternalError
+ * @extends Error
+ * @memberof module:problems-test
+ * @alias module:problems-test.Errors.InternalError
+ * @property {string} detail
+ * @property {string} instance
+ * @property {Object[]} invalid-params
+ * @property {Object[]} invalidParams
+ * @property {string} message
+ * @property {number} status
+ * @property {string} title
+ * @property {string} type
+ */
+module.exports.Errors.InternalError = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+    if (body["invalid-params"] !== undefined) {
+      this.invalidParams = body["invalid-params"];
+    }
+  }
+};
+
+/**
+ * NotFound
+ * @extends Error
+ * @memberof module:problems-test
+ * @alias module:problems-test.Errors.NotFound
+ * @property {string} detail
+ * @property {number} id
+ * @property {string} instance
+ * @property {Object[]} invalid-params
+ * @property {Object[]} invalidParams
+ * @property {string} message
+ * @property {number} status
+ * @property {string} title
+ * @property {string} type
+ */
+module.exports.Errors.NotFound = class extends Error {
+  constructor(body) {
+    super(body.message);
+    for (const k of Object.keys(body)) {
+      this[k] = body[k];
+    }
+    if (body["invalid-params"] !== undefined) {
+      this.invalidParams = body["invalid-params"];
+    }
+  }
+};
+
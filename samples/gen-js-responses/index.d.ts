@@ -0,0 +1,140 @@
+import { Logger } from "kayvee";
+
+type Callback<R> = (err: Error, result: R) => void;
+type ArrayInner<R> = R extends (infer T)[] ? T : never;
+
+interface RetryPolicy {
+  backoffs(): number[];
+  retry(requestOptions: {method: string}, err: Error, res: {statusCode: number}): boolean;
+}
+
+interface RequestOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  retryPolicy?: RetryPolicy;
+  headers?: { [key: string]: string };
+}
+
+interface IterResult<R> {
+  map<T>(f: (r: R) => T, cb?: Callback<T[]>): Promise<T[]>;
+  toArray(cb?: Callback<R[]>): Promise<R[]>;
+  forEach(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+  forEachAsync(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+}
+
+interface CircuitOptions {
+  forceClosed?: boolean;
+  maxConcurrentRequests?: number;
+  requestVolumeThreshold?: number;
+  sleepWindow?: number;
+  errorPercentThreshold?: number;
+}
+
+interface GenericOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  keepalive?: boolean;
+  retryPolicy?: RetryPolicy;
+  logger?: Logger;
+  circuit?: CircuitOptions;
+  serviceName?: string;
+  asynclocalstore?: object;
+}
+
+interface DiscoveryOptions {
+  discovery: true;
+  address?: undefined;
+}
+
+interface AddressOptions {
+  discovery?: false;
+  address: string;
+}
+
+type ResponsesTestOptions = (DiscoveryOptions | AddressOptions) & GenericOptions;
+
+import models = ResponsesTest.Models
+
+declare class ResponsesTest {
+  constructor(options: ResponsesTestOptions);
+
+  close(): void;
+  
+  deleteBook(id: string, options?: RequestOptions, cb?: Callback<models.DeleteBookOutput>): Promise<models.DeleteBookOutput>
+  
+  getBook(id: string, options?: RequestOptions, cb?: Callback<models.GetBookOutput>): Promise<models.GetBookOutput>
+  
+  upsertBook(params: models.UpsertBookParams, options?: RequestOptions, cb?: Callback<models.UpsertBookResponse>): Promise<models.UpsertBookResponse>
+  
+  listJobs(options?: RequestOptions, cb?: Callback<models.ListJobsResponse>): Promise<models.ListJobsResponse>
+  
+  getJob(id: string, options?: RequestOptions, cb?: Callback<models.GetJobResponse>): Promise<models.GetJobResponse>
+  
+}
+
+declare namespace ResponsesTest {
+  const RetryPolicies: {
+    Single: RetryPolicy;
+    Exponential: RetryPolicy;
+    None: RetryPolicy;
+  }
+
+  const DefaultCircuitOptions: CircuitOptions;
+
+  namespace Errors {
+    interface ErrorBody {
+      message: string;
+      [key: string]: any;
+    }
+
+    
+    class BadRequest {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class InternalError {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+  }
+
+  namespace Models {
+    
+    type Book = {
+  id?: string;
+  title?: string;
+};
+    
+    type DeleteBookOutput = { headers: { XDeletedCount?: number } };
+    
+    type GetBookOutput = { body: Book; headers: { ETag?: string; XCacheHit?: boolean; XPopularity?: number; XRateLimitRemaining?: number } };
+    
+    type GetJobResponse = { statusCode: 200; body: Job } | { statusCode: 202 } | { statusCode: 204 };
+    
+    type Job = {
+  id?: string;
+  result?: string;
+};
+    
+    type ListJobsResponse = { statusCode: 200; body: Job[] } | { statusCode: 204 };
+    
+    type UnknownResponse = {
+  body?: string;
+  statusCode?: number;
+};
+    
+    type UpsertBookParams = {
+  id: string;
+  book: Book;
+};
+    
+    type UpsertBookResponse = { statusCode: 200; body: Book; headers: { ETag?: string; Location?: string } } | { statusCode: 201; body: Book; headers: { ETag?: string; Location?: string } };
+    
+  }
+}
+
+export = ResponsesTest;
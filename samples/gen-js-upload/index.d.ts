@@ -0,0 +1,141 @@
+import { Logger } from "kayvee";
+
+type Callback<R> = (err: Error, result: R) => void;
+type ArrayInner<R> = R extends (infer T)[] ? T : never;
+
+interface RetryPolicy {
+  backoffs(): number[];
+  retry(requestOptions: {method: string}, err: Error, res: {statusCode: number}): boolean;
+}
+
+interface RequestOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  retryPolicy?: RetryPolicy;
+  headers?: { [key: string]: string };
+}
+
+interface IterResult<R> {
+  map<T>(f: (r: R) => T, cb?: Callback<T[]>): Promise<T[]>;
+  toArray(cb?: Callback<R[]>): Promise<R[]>;
+  forEach(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+  forEachAsync(f: (r: R) => void, cb?: Callback<void>): Promise<void>;
+}
+
+interface CircuitOptions {
+  forceClosed?: boolean;
+  maxConcurrentRequests?: number;
+  requestVolumeThreshold?: number;
+  sleepWindow?: number;
+  errorPercentThreshold?: number;
+}
+
+interface GenericOptions {
+  timeout?: number;
+  baggage?: Map<string, string | number>;
+  keepalive?: boolean;
+  retryPolicy?: RetryPolicy;
+  logger?: Logger;
+  circuit?: CircuitOptions;
+  serviceName?: string;
+  asynclocalstore?: object;
+}
+
+interface DiscoveryOptions {
+  discovery: true;
+  address?: undefined;
+}
+
+interface AddressOptions {
+  discovery?: false;
+  address: string;
+}
+
+type UploadTestOptions = (DiscoveryOptions | AddressOptions) & GenericOptions;
+
+import models = UploadTest.Models
+
+declare class UploadTest {
+  constructor(options: UploadTestOptions);
+
+  close(): void;
+  
+  uploadDocument(params: models.UploadDocumentParams, options?: RequestOptions, cb?: Callback<models.Document>): Promise<models.Document>
+  
+  addComment(params: models.AddCommentParams, options?: RequestOptions, cb?: Callback<models.Comment>): Promise<models.Comment>
+  
+}
+
+declare namespace UploadTest {
+  const RetryPolicies: {
+    Single: RetryPolicy;
+    Exponential: RetryPolicy;
+    None: RetryPolicy;
+  }
+
+  const DefaultCircuitOptions: CircuitOptions;
+
+  namespace Errors {
+    interface ErrorBody {
+      message: string;
+      [key: string]: any;
+    }
+
+    
+    class BadRequest {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+    class InternalError {
+  message?: string;
+
+  constructor(body: ErrorBody);
+}
+    
+  }
+
+  namespace Models {
+    
+    type AddCommentParams = {
+  id: string;
+  author: string;
+  body?: string;
+  mentions?: string[];
+};
+    
+    type Comment = {
+  author?: string;
+  body?: string;
+  documentID?: string;
+  mentions?: string[];
+};
+    
+    type Document = {
+  contents?: string;
+  pages?: number;
+  source?: string;
+  tags?: string[];
+  thumbnailSize?: number;
+  title?: string;
+};
+    
+    type UnknownResponse = {
+  body?: string;
+  statusCode?: number;
+};
+    
+    type UploadDocumentParams = {
+  file: Buffer | NodeJS.ReadableStream;
+  thumbnail?: Buffer | NodeJS.ReadableStream;
+  title: string;
+  pages?: number;
+  tags?: string[];
+  XRequestSource?: string;
+};
+    
+  }
+}
+
+export = UploadTest;
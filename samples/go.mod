@@ -6,6 +6,10 @@ require (
 	github.com/Clever/go-process-metrics v0.4.0
 	github.com/Clever/kayvee-go/v7 v7.10.0
 	github.com/Clever/wag/logging/wagclientlogger v0.0.0-20230110184825-edb52117e67a
+	github.com/Clever/wag/samples/gen-go-arrays/client/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-arrays/models/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-auth/client/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-auth/models/v9 v9.0.0-00010101000000-000000000000
 	github.com/Clever/wag/samples/gen-go-basic/client/v9 v9.0.0-00010101000000-000000000000
 	github.com/Clever/wag/samples/gen-go-basic/models/v9 v9.0.0-00010101000000-000000000000
 	github.com/Clever/wag/samples/gen-go-blog/models/v9 v9.0.0-00010101000000-000000000000
@@ -15,9 +19,21 @@ require (
 	github.com/Clever/wag/samples/gen-go-deprecated/models/v9 v9.0.0-00010101000000-000000000000
 	github.com/Clever/wag/samples/gen-go-errors/client/v9 v9.0.0-00010101000000-000000000000
 	github.com/Clever/wag/samples/gen-go-errors/models/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-inline/client/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-inline/models/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-limits/client/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-limits/models/v9 v9.0.0-00010101000000-000000000000
 	github.com/Clever/wag/samples/gen-go-nils/client/v9 v9.0.0-00010101000000-000000000000
 	github.com/Clever/wag/samples/gen-go-nils/models/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-polymorphism/client/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-polymorphism/models/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-problems/client/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-problems/models/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-responses/client/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-responses/models/v9 v9.0.0-00010101000000-000000000000
 	github.com/Clever/wag/samples/gen-go-strings/models/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-upload/client/v9 v9.0.0-00010101000000-000000000000
+	github.com/Clever/wag/samples/gen-go-upload/models/v9 v9.0.0-00010101000000-000000000000
 	github.com/Clever/wag/v9 v9.0.0-00010101000000-000000000000
 	github.com/afex/hystrix-go v0.0.0-20180502004556-fa1af6a1f4f5
 	github.com/aws/aws-sdk-go-v2 v1.36.3
@@ -26,6 +42,8 @@ require (
 	github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression v1.7.82
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.43.1
 	github.com/go-errors/errors v1.1.1
+	github.com/go-openapi/errors v0.20.2
+	github.com/go-openapi/runtime v0.24.1
 	github.com/go-openapi/strfmt v0.21.3
 	github.com/go-openapi/swag v0.22.3
 	github.com/golang/mock v1.6.0
@@ -66,11 +84,9 @@ require (
 	github.com/go-logr/logr v1.2.3 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/go-openapi/analysis v0.21.4 // indirect
-	github.com/go-openapi/errors v0.20.2 // indirect
 	github.com/go-openapi/jsonpointer v0.19.6 // indirect
 	github.com/go-openapi/jsonreference v0.20.2 // indirect
 	github.com/go-openapi/loads v0.21.2 // indirect
-	github.com/go-openapi/runtime v0.24.1 // indirect
 	github.com/go-openapi/spec v0.20.7 // indirect
 	github.com/go-openapi/validate v0.22.0 // indirect
 	github.com/golang/protobuf v1.5.2 // indirect
@@ -102,6 +118,22 @@ replace github.com/go-openapi/validate => github.com/go-openapi/validate v0.0.0-
 
 replace github.com/go-openapi/errors => github.com/go-openapi/errors v0.0.0-20180515155515-b2b2befaf267 // pre-modules tag 0.15.0x
 
+replace github.com/Clever/wag/samples/gen-go-auth/models/v9 => ./gen-go-auth/models
+
+replace github.com/Clever/wag/samples/gen-go-upload/models/v9 => ./gen-go-upload/models
+
+replace github.com/Clever/wag/samples/gen-go-arrays/models/v9 => ./gen-go-arrays/models
+
+replace github.com/Clever/wag/samples/gen-go-responses/models/v9 => ./gen-go-responses/models
+
+replace github.com/Clever/wag/samples/gen-go-inline/models/v9 => ./gen-go-inline/models
+
+replace github.com/Clever/wag/samples/gen-go-polymorphism/models/v9 => ./gen-go-polymorphism/models
+
+replace github.com/Clever/wag/samples/gen-go-problems/models/v9 => ./gen-go-problems/models
+
+replace github.com/Clever/wag/samples/gen-go-limits/models/v9 => ./gen-go-limits/models
+
 replace github.com/Clever/wag/samples/gen-go-strings/models/v9 => ./gen-go-strings/models
 
 replace github.com/Clever/wag/samples/gen-go-basic/models/v9 => ./gen-go-basic/models
@@ -122,6 +154,22 @@ replace github.com/Clever/wag/samples/gen-go-errors/models/v9 => ./gen-go-errors
 
 replace github.com/Clever/wag/samples/gen-go-nils/models/v9 => ./gen-go-nils/models
 
+replace github.com/Clever/wag/samples/gen-go-auth/client/v9 => ./gen-go-auth/client
+
+replace github.com/Clever/wag/samples/gen-go-upload/client/v9 => ./gen-go-upload/client
+
+replace github.com/Clever/wag/samples/gen-go-arrays/client/v9 => ./gen-go-arrays/client
+
+replace github.com/Clever/wag/samples/gen-go-responses/client/v9 => ./gen-go-responses/client
+
+replace github.com/Clever/wag/samples/gen-go-inline/client/v9 => ./gen-go-inline/client
+
+replace github.com/Clever/wag/samples/gen-go-polymorphism/client/v9 => ./gen-go-polymorphism/client
+
+replace github.com/Clever/wag/samples/gen-go-problems/client/v9 => ./gen-go-problems/client
+
+replace github.com/Clever/wag/samples/gen-go-limits/client/v9 => ./gen-go-limits/client
+
 replace github.com/Clever/wag/samples/gen-go-strings/client/v9 => ./gen-go-strings/client
 
 replace github.com/Clever/wag/samples/gen-go-basic/client/v9 => ./gen-go-basic/client
@@ -0,0 +1,94 @@
+package test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-arrays/client/v9"
+	"github.com/Clever/wag/samples/gen-go-arrays/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-arrays/server"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ArraysController struct{}
+
+func (c *ArraysController) GetBooks(ctx context.Context, i *models.GetBooksInput) (*models.BookQuery, error) {
+	return &models.BookQuery{
+		Ids:     i.Ids,
+		Authors: i.Authors,
+		Ratings: i.Ratings,
+		Flags:   i.Flags,
+		Years:   i.Years,
+		Tags:    i.Tags,
+	}, nil
+}
+
+func setupArraysServer() *httptest.Server {
+	s := server.New(&ArraysController{}, "")
+	return httptest.NewServer(s.Handler)
+}
+
+func TestArrayQueryParamsRoundTrip(t *testing.T) {
+	testServer := setupArraysServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	input := &models.GetBooksInput{
+		Ids:     []int64{1, 2, 3},
+		Authors: []string{"Ursula K. Le Guin", "Octavia Butler"},
+		Ratings: []float32{4.5, 3},
+		Flags:   []bool{true, false},
+		Years:   []int32{1969, 1979},
+		Tags:    []string{"sci-fi", "classic"},
+	}
+	path, err := input.Path()
+	require.NoError(t, err)
+	assert.Contains(t, path, "ids=1%2C2%2C3")
+	assert.Contains(t, path, "authors=Ursula+K.+Le+Guin%7COctavia+Butler")
+	assert.Contains(t, path, "flags=true%09false")
+	assert.Contains(t, path, "years=1969&years=1979")
+
+	query, err := c.GetBooks(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, &models.BookQuery{
+		Ids:     []int64{1, 2, 3},
+		Authors: []string{"Ursula K. Le Guin", "Octavia Butler"},
+		Ratings: []float32{4.5, 3},
+		Flags:   []bool{true, false},
+		Years:   []int32{1969, 1979},
+		Tags:    []string{"sci-fi", "classic"},
+	}, query)
+}
+
+func TestArrayQueryParamsEmpty(t *testing.T) {
+	testServer := setupArraysServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	query, err := c.GetBooks(context.Background(), &models.GetBooksInput{})
+	require.NoError(t, err)
+	assert.Equal(t, &models.BookQuery{}, query)
+}
+
+func TestArrayQueryParamsRaw(t *testing.T) {
+	testServer := setupArraysServer()
+	defer testServer.Close()
+
+	resp, err := http.Get(testServer.URL + "/v1/books?ids=4,5&ratings=1.5%202&years=2001&years=2002")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"ids":[4,5],"authors":null,"ratings":[1.5,2],"flags":null,"years":[2001,2002],"tags":null}`, string(body))
+
+	resp, err = http.Get(testServer.URL + "/v1/books?ids=4,five")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,213 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-auth/client/v9"
+	"github.com/Clever/wag/samples/gen-go-auth/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-auth/server"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type callerCtxKey struct{}
+
+type AuthController struct {
+	// calls records the scheme and scopes of every call to Authenticate.
+	calls []string
+}
+
+func (c *AuthController) Authenticate(ctx context.Context, op string, creds server.Credentials, scopes []string) (context.Context, error) {
+	c.calls = append(c.calls, fmt.Sprintf("%s %s %v", op, creds.Scheme, scopes))
+	switch creds.Scheme {
+	case server.SecuritySchemeBasic:
+		if creds.Username != "user" || creds.Password != "pass" {
+			return ctx, errors.New("bad username or password")
+		}
+		return context.WithValue(ctx, callerCtxKey{}, creds.Username), nil
+	case server.SecuritySchemeAPIKey, server.SecuritySchemeQueryKey, server.SecuritySchemeOauth:
+		if creds.Token == "readonly" && op == "createWidget" {
+			return ctx, &models.Forbidden{Message: "token can't create widgets"}
+		}
+		if creds.Token != "secret" && creds.Token != "readonly" {
+			return ctx, errors.New("bad token")
+		}
+		return context.WithValue(ctx, callerCtxKey{}, creds.Token), nil
+	}
+	return ctx, errors.New("unknown scheme")
+}
+
+func (c *AuthController) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+func (c *AuthController) GetWidgets(ctx context.Context) ([]models.Widget, error) {
+	return []models.Widget{{Name: ctx.Value(callerCtxKey{}).(string)}}, nil
+}
+
+func (c *AuthController) CreateWidget(ctx context.Context, i *models.Widget) (*models.Widget, error) {
+	return &models.Widget{Name: i.Name + " by " + ctx.Value(callerCtxKey{}).(string)}, nil
+}
+
+func (c *AuthController) GetWidget(ctx context.Context, name string) (*models.Widget, error) {
+	caller, ok := ctx.Value(callerCtxKey{}).(string)
+	if !ok {
+		caller = "anonymous"
+	}
+	return &models.Widget{Name: name + " for " + caller}, nil
+}
+
+func staticCredentials(creds map[client.SecurityScheme]*client.Credentials) client.CredentialsProvider {
+	return func(ctx context.Context, scheme client.SecurityScheme) (*client.Credentials, error) {
+		return creds[scheme], nil
+	}
+}
+
+func setupAuthServer() (*httptest.Server, *AuthController) {
+	controller := &AuthController{}
+	s := server.New(controller, "")
+	return httptest.NewServer(s.Handler), controller
+}
+
+func TestAuthNoSecurity(t *testing.T) {
+	testServer, controller := setupAuthServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	require.NoError(t, c.HealthCheck(context.Background()))
+	assert.Empty(t, controller.calls)
+}
+
+func TestAuthMissingCredentials(t *testing.T) {
+	testServer, controller := setupAuthServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	_, err := c.GetWidgets(context.Background())
+	require.Error(t, err)
+	unknown, ok := err.(models.UnknownResponse)
+	require.True(t, ok, "expected UnknownResponse, got %T", err)
+	assert.Equal(t, int64(http.StatusUnauthorized), unknown.StatusCode)
+	assert.Contains(t, unknown.Body, server.ErrMissingCredentials.Error())
+	assert.Empty(t, controller.calls)
+}
+
+func TestAuthAPIKey(t *testing.T) {
+	testServer, controller := setupAuthServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetCredentialsProvider(staticCredentials(map[client.SecurityScheme]*client.Credentials{
+		client.SecuritySchemeAPIKey: {Token: "secret"},
+	}))
+
+	widgets, err := c.GetWidgets(context.Background())
+	require.NoError(t, err)
+	require.Len(t, widgets, 1)
+	assert.Equal(t, "secret", widgets[0].Name)
+	assert.Equal(t, []string{"getWidgets api_key []"}, controller.calls)
+}
+
+func TestAuthBearerScopes(t *testing.T) {
+	testServer, controller := setupAuthServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetCredentialsProvider(staticCredentials(map[client.SecurityScheme]*client.Credentials{
+		client.SecuritySchemeOauth: {Token: "secret"},
+	}))
+
+	_, err := c.GetWidgets(context.Background())
+	require.NoError(t, err)
+	widget, err := c.CreateWidget(context.Background(), &models.Widget{Name: "gear"})
+	require.NoError(t, err)
+	assert.Equal(t, "gear by secret", widget.Name)
+	assert.Equal(t, []string{
+		"getWidgets oauth [read:widgets]",
+		"createWidget oauth [write:widgets]",
+	}, controller.calls)
+}
+
+func TestAuthMultipleSchemes(t *testing.T) {
+	testServer, controller := setupAuthServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetCredentialsProvider(staticCredentials(map[client.SecurityScheme]*client.Credentials{
+		client.SecuritySchemeBasic:    {Username: "user", Password: "pass"},
+		client.SecuritySchemeQueryKey: {Token: "secret"},
+	}))
+
+	widget, err := c.CreateWidget(context.Background(), &models.Widget{Name: "gear"})
+	require.NoError(t, err)
+	// The controller sees the context returned by the last scheme that was checked
+	assert.Equal(t, "gear by secret", widget.Name)
+	assert.Equal(t, []string{
+		"createWidget basic []",
+		"createWidget query_key []",
+	}, controller.calls)
+}
+
+func TestAuthErrors(t *testing.T) {
+	testServer, _ := setupAuthServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	c.SetCredentialsProvider(staticCredentials(map[client.SecurityScheme]*client.Credentials{
+		client.SecuritySchemeOauth: {Token: "readonly"},
+	}))
+	_, err := c.CreateWidget(context.Background(), &models.Widget{Name: "gear"})
+	require.Error(t, err)
+	assert.Equal(t, &models.Forbidden{Message: "token can't create widgets"}, err)
+
+	c.SetCredentialsProvider(staticCredentials(map[client.SecurityScheme]*client.Credentials{
+		client.SecuritySchemeAPIKey: {Token: "wrong"},
+	}))
+	_, err = c.GetWidgets(context.Background())
+	require.Error(t, err)
+	unknown, ok := err.(models.UnknownResponse)
+	require.True(t, ok, "expected UnknownResponse, got %T", err)
+	assert.Equal(t, int64(http.StatusUnauthorized), unknown.StatusCode)
+}
+
+func TestAuthCredentialsProviderError(t *testing.T) {
+	testServer, _ := setupAuthServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	providerErr := errors.New("token refresh failed")
+	c.SetCredentialsProvider(func(ctx context.Context, scheme client.SecurityScheme) (*client.Credentials, error) {
+		return nil, providerErr
+	})
+	_, err := c.GetWidgets(context.Background())
+	assert.Equal(t, providerErr, err)
+}
+
+func TestAuthOptional(t *testing.T) {
+	testServer, controller := setupAuthServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	widget, err := c.GetWidget(context.Background(), "gear")
+	require.NoError(t, err)
+	assert.Equal(t, "gear for anonymous", widget.Name)
+	assert.Empty(t, controller.calls)
+
+	// The empty requirement is listed first, but requests with credentials are still authenticated
+	c.SetCredentialsProvider(staticCredentials(map[client.SecurityScheme]*client.Credentials{
+		client.SecuritySchemeAPIKey: {Token: "secret"},
+	}))
+	widget, err = c.GetWidget(context.Background(), "gear")
+	require.NoError(t, err)
+	assert.Equal(t, "gear for secret", widget.Name)
+	assert.Equal(t, []string{"getWidget api_key []"}, controller.calls)
+
+	c.SetCredentialsProvider(staticCredentials(map[client.SecurityScheme]*client.Credentials{
+		client.SecuritySchemeAPIKey: {Token: "wrong"},
+	}))
+	_, err = c.GetWidget(context.Background(), "gear")
+	require.Error(t, err)
+}
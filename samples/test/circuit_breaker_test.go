@@ -0,0 +1,165 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Clever/wag/logging/wagclientlogger"
+	"github.com/Clever/wag/samples/gen-go-basic/client/v9"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingLogger records the messages it logs.
+type recordingLogger struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (l *recordingLogger) Log(level wagclientlogger.LogLevel, message string, pairs map[string]interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, message)
+}
+
+// newFlakyServer returns a server that responds with a 500 while failing is true, and the number of
+// requests it has received.
+func newFlakyServer(failing *atomic.Bool) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message": "browned out"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})), &requests
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	var failing atomic.Bool
+	testServer, requests := newFlakyServer(&failing)
+	defer testServer.Close()
+
+	logger := &recordingLogger{}
+	c := client.New(testServer.URL, logger, &http.DefaultTransport)
+	c.SetRetryPolicy(client.NoRetryPolicy{})
+	var changes []string
+	c.SetCircuitBreaker(client.CircuitBreakerOptions{
+		RequestVolumeThreshold: 4,
+		ErrorPercentThreshold:  50,
+		SleepWindow:            50 * time.Millisecond,
+		OnStateChange: func(circuit string, from, to client.CircuitState) {
+			changes = append(changes, circuit+": "+from.String()+" -> "+to.String())
+		},
+	})
+	ctx := context.Background()
+
+	failing.Store(true)
+	for i := 0; i < 4; i++ {
+		err := c.HealthCheck(ctx)
+		require.Error(t, err)
+		assert.False(t, errors.As(err, &client.ErrCircuitOpen{}))
+	}
+	var open client.ErrCircuitOpen
+	require.True(t, errors.As(c.HealthCheck(ctx), &open))
+	assert.Equal(t, "swagger-test", open.Circuit)
+	assert.EqualValues(t, 4, requests.Load(), "requests shouldn't be made while the circuit is open")
+
+	// A failed probe opens the circuit again
+	time.Sleep(50 * time.Millisecond)
+	require.Error(t, c.HealthCheck(ctx))
+	assert.True(t, errors.As(c.HealthCheck(ctx), &open))
+	assert.EqualValues(t, 5, requests.Load())
+
+	// A successful probe closes it
+	failing.Store(false)
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, c.HealthCheck(ctx))
+	require.NoError(t, c.HealthCheck(ctx))
+	assert.EqualValues(t, 7, requests.Load())
+
+	assert.Equal(t, []string{
+		"swagger-test: closed -> open",
+		"swagger-test: open -> half-open",
+		"swagger-test: half-open -> open",
+		"swagger-test: open -> half-open",
+		"swagger-test: half-open -> closed",
+	}, changes)
+	assert.Contains(t, logger.messages, "client-circuit-state-changed")
+}
+
+func TestCircuitBreakerCanceledProbe(t *testing.T) {
+	var failing atomic.Bool
+	failing.Store(true)
+	testServer, _ := newFlakyServer(&failing)
+	defer testServer.Close()
+
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetRetryPolicy(client.NoRetryPolicy{})
+	var changes []string
+	c.SetCircuitBreaker(client.CircuitBreakerOptions{
+		RequestVolumeThreshold: 1,
+		SleepWindow:            50 * time.Millisecond,
+		OnStateChange: func(circuit string, from, to client.CircuitState) {
+			changes = append(changes, from.String()+" -> "+to.String())
+		},
+	})
+	ctx := context.Background()
+	require.Error(t, c.HealthCheck(ctx))
+
+	// A canceled probe neither closes the circuit nor keeps other probes out
+	time.Sleep(50 * time.Millisecond)
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, c.HealthCheck(canceled), context.Canceled)
+	assert.Equal(t, []string{"closed -> open", "open -> half-open"}, changes)
+
+	err := c.HealthCheck(ctx)
+	require.Error(t, err)
+	assert.False(t, errors.As(err, &client.ErrCircuitOpen{}), "the canceled probe should have been released")
+	assert.Equal(t, []string{"closed -> open", "open -> half-open", "half-open -> open"}, changes)
+}
+
+func TestOperationCircuitBreaker(t *testing.T) {
+	var failing atomic.Bool
+	failing.Store(true)
+	testServer, requests := newFlakyServer(&failing)
+	defer testServer.Close()
+	ctx := context.Background()
+
+	// The circuit breaker is off by default
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetRetryPolicy(client.NoRetryPolicy{})
+	for i := 0; i < 30; i++ {
+		require.Error(t, c.HealthCheck(ctx))
+	}
+	assert.EqualValues(t, 30, requests.Load())
+
+	// Operations can turn off the client's circuit breaker
+	c.SetCircuitBreaker(client.CircuitBreakerOptions{RequestVolumeThreshold: 1})
+	c.SetOperationCircuitBreaker("healthCheck", client.CircuitBreakerOptions{ForceClosed: true})
+	for i := 0; i < 5; i++ {
+		err := c.HealthCheck(ctx)
+		require.Error(t, err)
+		assert.False(t, errors.As(err, &client.ErrCircuitOpen{}))
+	}
+	assert.EqualValues(t, 35, requests.Load())
+
+	// or have their own
+	c.SetOperationCircuitBreaker("healthCheck", client.CircuitBreakerOptions{RequestVolumeThreshold: 2})
+	require.Error(t, c.HealthCheck(ctx))
+	require.Error(t, c.HealthCheck(ctx))
+	var open client.ErrCircuitOpen
+	require.True(t, errors.As(c.HealthCheck(ctx), &open))
+	assert.Equal(t, "healthCheck", open.Circuit)
+	assert.EqualValues(t, 37, requests.Load())
+}
@@ -0,0 +1,79 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-basic/client/v9"
+	"github.com/Clever/wag/samples/gen-go-basic/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-basic/server"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientMiddleware(t *testing.T) {
+	controller := &ControllerImpl{books: map[int64]*models.Book{}, maxID: 0, pageSize: 1}
+	testServer := httptest.NewServer(server.New(controller, "").Handler)
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetRetryPolicy(client.NoRetryPolicy{})
+
+	type call struct {
+		middleware string
+		operation  string
+		input      interface{}
+		output     interface{}
+		err        error
+	}
+	var calls []call
+	record := func(name string) client.Middleware {
+		return func(next client.Doer) client.Doer {
+			return client.DoerFunc(func(hc *http.Client, r *http.Request) (*http.Response, error) {
+				operation := client.OperationFromContext(r.Context())
+				require.NotNil(t, operation)
+				assert.Equal(t, operation.Name, client.OperationName(r.Context()))
+				operation.OnResult(func(output interface{}, err error) {
+					calls = append(calls, call{name, operation.Name, operation.Input, output, err})
+				})
+				return next.Do(hc, r)
+			})
+		}
+	}
+	c.AddMiddleware(record("first"), record("second"))
+
+	book := &models.Book{ID: 1, Name: "Frankenstein"}
+	_, err := c.CreateBook(context.Background(), book)
+	require.NoError(t, err)
+	_, err = c.GetBookByID(context.Background(), &models.GetBookByIDInput{BookID: 400})
+	require.Error(t, err)
+	require.NoError(t, c.HealthCheck(context.Background()))
+
+	require.Len(t, calls, 6)
+	assert.Equal(t, call{"first", "createBook", book, book, nil}, calls[0])
+	assert.Equal(t, "second", calls[1].middleware)
+	assert.Equal(t, call{"first", "getBookByID", &models.GetBookByIDInput{BookID: 400}, nil, err}, calls[2])
+	assert.Equal(t, call{"first", "healthCheck", nil, nil, nil}, calls[4])
+
+	// Middleware can respond without making the request, e.g. from a cache
+	c.AddMiddleware(func(next client.Doer) client.Doer {
+		return client.DoerFunc(func(hc *http.Client, r *http.Request) (*http.Response, error) {
+			if client.OperationName(r.Context()) != "getBookByID" {
+				return next.Do(hc, r)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(bytes.NewBufferString(`{"id": 2, "name": "Dracula"}`)),
+			}, nil
+		})
+	})
+	cached, err := c.GetBookByID(context.Background(), &models.GetBookByIDInput{BookID: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "Dracula", cached.Name)
+	assert.Equal(t, cached, calls[len(calls)-1].output)
+}
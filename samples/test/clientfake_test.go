@@ -0,0 +1,199 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-basic/client/v9"
+	"github.com/Clever/wag/samples/gen-go-basic/client/v9/clientfake"
+	"github.com/Clever/wag/samples/gen-go-basic/models/v9"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bookTitle is code under test that depends on the client.
+func bookTitle(ctx context.Context, c client.Client, id int64) (string, error) {
+	book, err := c.GetBookByID(ctx, &models.GetBookByIDInput{BookID: id})
+	if err != nil {
+		return "", err
+	}
+	return book.Name, nil
+}
+
+func TestClientFakeQueue(t *testing.T) {
+	fake := &clientfake.Fake{}
+	fake.QueueGetBookByID(&models.Book{ID: 1, Name: "Dune"}, nil)
+	fake.QueueGetBookByID(nil, models.Error{Code: 404, Message: "not found"})
+
+	title, err := bookTitle(context.Background(), fake, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Dune", title)
+
+	_, err = bookTitle(context.Background(), fake, 2)
+	assert.Equal(t, models.Error{Code: 404, Message: "not found"}, err)
+
+	// once the queue is used up, calls fail
+	_, err = bookTitle(context.Background(), fake, 3)
+	assert.True(t, errors.Is(err, clientfake.ErrNotStubbed))
+	assert.Contains(t, err.Error(), "GetBookByID")
+
+	calls := fake.GetBookByIDCalls()
+	require.Len(t, calls, 3)
+	assert.Equal(t, &models.GetBookByIDInput{BookID: 2}, calls[1].Input)
+	assert.Empty(t, fake.GetBooksCalls())
+}
+
+func TestClientFakeStub(t *testing.T) {
+	fake := &clientfake.Fake{}
+	fake.QueueGetBookByID2(&models.Book{ID: 1, Name: "queued"}, nil)
+	fake.StubGetBookByID2(func(ctx context.Context, id string) (*models.Book, error) {
+		return &models.Book{Name: "stubbed " + id}, nil
+	})
+
+	book, err := fake.GetBookByID2(context.Background(), "a")
+	require.NoError(t, err)
+	assert.Equal(t, "queued", book.Name)
+	book, err = fake.GetBookByID2(context.Background(), "b")
+	require.NoError(t, err)
+	assert.Equal(t, "stubbed b", book.Name)
+	assert.Equal(t, "b", fake.GetBookByID2Calls()[1].Input)
+
+	// operations without a success type only return errors
+	assert.True(t, errors.Is(fake.HealthCheck(context.Background()), clientfake.ErrNotStubbed))
+	fake.QueueHealthCheck(nil)
+	assert.NoError(t, fake.HealthCheck(context.Background()))
+	assert.Len(t, fake.HealthCheckCalls(), 2)
+}
+
+func TestClientFakeIterQueuedPages(t *testing.T) {
+	fake := &clientfake.Fake{}
+	fake.QueueGetBooks([]models.Book{{ID: 1}, {ID: 2}}, nil)
+	fake.QueueGetBooks([]models.Book{{ID: 3}}, nil)
+
+	iter, err := fake.NewGetBooksIter(context.Background(), &models.GetBooksInput{})
+	require.NoError(t, err)
+	ids := []int64{}
+	var book models.Book
+	for iter.Next(&book) {
+		ids = append(ids, book.ID)
+	}
+	require.NoError(t, iter.Err())
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+	assert.Len(t, fake.GetBooksCalls(), 2)
+}
+
+func TestClientFakeIterStubbedPages(t *testing.T) {
+	fake := &clientfake.Fake{}
+	pages := [][]*models.Author{{{ID: "a"}, {ID: "b"}}, {{ID: "c"}}, {}}
+	fake.StubGetAuthors(func(ctx context.Context, i *models.GetAuthorsInput) (*models.AuthorsResponse, error) {
+		page := pages[0]
+		pages = pages[1:]
+		return &models.AuthorsResponse{AuthorSet: &models.AuthorSet{Results: page}}, nil
+	})
+
+	iter, err := fake.NewGetAuthorsIter(context.Background(), &models.GetAuthorsInput{})
+	require.NoError(t, err)
+	ids := []string{}
+	var author models.Author
+	for iter.Next(&author) {
+		ids = append(ids, author.ID)
+	}
+	require.NoError(t, iter.Err())
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+	assert.Empty(t, pages)
+}
+
+func TestClientFakeIterError(t *testing.T) {
+	fake := &clientfake.Fake{}
+	fake.QueueGetBooks([]models.Book{{ID: 1}}, nil)
+	fake.QueueGetBooks(nil, &models.InternalError{Message: "oops"})
+	fake.QueueGetBooks([]models.Book{{ID: 2}}, nil)
+
+	iter, err := fake.NewGetBooksIter(context.Background(), &models.GetBooksInput{})
+	require.NoError(t, err)
+	var book models.Book
+	assert.True(t, iter.Next(&book))
+	assert.False(t, iter.Next(&book))
+	assert.Equal(t, &models.InternalError{Message: "oops"}, iter.Err())
+}
+
+func TestClientFakeSeq(t *testing.T) {
+	fake := &clientfake.Fake{}
+	fake.QueueGetBooks([]models.Book{{ID: 1}, {ID: 2}}, nil)
+	fake.QueueGetBooks([]models.Book{{ID: 3}}, nil)
+	fake.QueueGetBooks(nil, &models.InternalError{Message: "oops"})
+
+	sizes := []int{}
+	for page, err := range fake.GetBooksPages(context.Background(), &models.GetBooksInput{}) {
+		require.NoError(t, err)
+		sizes = append(sizes, len(page))
+		if len(sizes) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{2, 1}, sizes)
+
+	books, err := client.All(fake.GetBooksSeq(context.Background(), &models.GetBooksInput{}))
+	assert.Empty(t, books)
+	assert.Equal(t, &models.InternalError{Message: "oops"}, err)
+}
+
+func TestClientFakeIterCursor(t *testing.T) {
+	fake := &clientfake.Fake{}
+	fake.QueueGetBooks([]models.Book{{ID: 1}}, nil)
+	fake.QueueGetBooks([]models.Book{{ID: 2}}, nil)
+
+	iter, err := fake.NewGetBooksIter(context.Background(), &models.GetBooksInput{})
+	require.NoError(t, err)
+	var book models.Book
+	require.True(t, iter.Next(&book))
+	assert.Equal(t, "2", iter.Cursor())
+
+	resumed, err := fake.NewGetBooksIterFromCursor(context.Background(), &models.GetBooksInput{}, iter.Cursor())
+	require.NoError(t, err)
+	require.True(t, resumed.Next(&book))
+	assert.Equal(t, int64(2), book.ID)
+	assert.False(t, resumed.Next(&book))
+	assert.Equal(t, "", resumed.Cursor())
+
+	done, err := fake.NewGetBooksIterFromCursor(context.Background(), &models.GetBooksInput{}, "")
+	require.NoError(t, err)
+	assert.False(t, done.Next(&book))
+}
+
+func TestClientFakeIterPageStub(t *testing.T) {
+	fake := &clientfake.Fake{}
+	// The stub returns the same non-empty page until the third, which is the last
+	fake.StubGetBooksPage(func(ctx context.Context, i *models.GetBooksInput, page int) ([]models.Book, bool, error) {
+		return []models.Book{{ID: int64(page)}}, page < 3, nil
+	})
+
+	iter, err := fake.NewGetBooksIter(context.Background(), &models.GetBooksInput{})
+	require.NoError(t, err)
+	var book models.Book
+	require.True(t, iter.Next(&book))
+	assert.Equal(t, int64(1), book.ID)
+	cursor := iter.Cursor()
+	assert.Equal(t, "2", cursor)
+
+	// Iterators resume from the page number in the cursor
+	resumed, err := fake.NewGetBooksIterFromCursor(context.Background(), &models.GetBooksInput{}, cursor)
+	require.NoError(t, err)
+	ids := []int64{}
+	for resumed.Next(&book) {
+		ids = append(ids, book.ID)
+	}
+	require.NoError(t, resumed.Err())
+	assert.Equal(t, []int64{2, 3}, ids)
+	assert.Equal(t, "", resumed.Cursor())
+	assert.Len(t, fake.GetBooksCalls(), 3)
+
+	books, err := client.All(fake.GetBooksSeq(context.Background(), &models.GetBooksInput{}))
+	require.NoError(t, err)
+	assert.Len(t, books, 3)
+
+	_, err = fake.NewGetBooksIterFromCursor(context.Background(), &models.GetBooksInput{}, "/v1/books")
+	assert.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Clever/wag/samples/gen-go-basic/client/v9"
+	"github.com/Clever/wag/samples/gen-go-basic/models/v9"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPagedClient returns a client for a server with books 1 to n and a page size of pageSize,
+// and the number of requests for pages of books the client has made.
+func setupPagedClient(t *testing.T, n, pageSize int) (*client.WagClient, *atomic.Int32) {
+	s, controller := setupServer()
+	t.Cleanup(s.Close)
+	controller.pageSize = pageSize
+	c := client.New(s.URL, wcl, &http.DefaultTransport)
+	for id := int64(1); id <= int64(n); id++ {
+		_, err := c.CreateBook(context.Background(), &models.Book{ID: id})
+		require.NoError(t, err)
+	}
+	var requests atomic.Int32
+	c.AddMiddleware(func(next client.Doer) client.Doer {
+		return client.DoerFunc(func(hc *http.Client, r *http.Request) (*http.Response, error) {
+			if client.OperationName(r.Context()) == "getBooks" {
+				requests.Add(1)
+			}
+			return next.Do(hc, r)
+		})
+	})
+	return c, &requests
+}
+
+func TestIteratorCursor(t *testing.T) {
+	c, _ := setupPagedClient(t, 3, 2)
+	ctx := context.Background()
+	input := &models.GetBooksInput{}
+
+	iter, err := c.NewGetBooksIter(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(iter.Cursor(), "/v1/books?"))
+	assert.NotContains(t, iter.Cursor(), "startingAfter")
+	var book models.Book
+	require.True(t, iter.Next(&book))
+	require.True(t, iter.Next(&book))
+	assert.Equal(t, int64(2), book.ID)
+	cursor := iter.Cursor()
+	assert.Contains(t, cursor, "startingAfter=2")
+
+	// Resume after the first page
+	resumed, err := c.NewGetBooksIterFromCursor(ctx, input, cursor)
+	require.NoError(t, err)
+	ids := []int64{}
+	for resumed.Next(&book) {
+		ids = append(ids, book.ID)
+	}
+	require.NoError(t, resumed.Err())
+	assert.Equal(t, []int64{3}, ids)
+	assert.Equal(t, "", resumed.Cursor())
+
+	done, err := c.NewGetBooksIterFromCursor(ctx, input, "")
+	require.NoError(t, err)
+	assert.False(t, done.Next(&book))
+	assert.NoError(t, done.Err())
+}
+
+func TestIteratorPrefetch(t *testing.T) {
+	for _, depth := range []int{1, 2} {
+		c, requests := setupPagedClient(t, 4, 1)
+		c.SetPagePrefetch(depth)
+
+		iter, err := c.NewGetBooksIter(context.Background(), &models.GetBooksInput{})
+		require.NoError(t, err)
+		var book models.Book
+		require.True(t, iter.Next(&book))
+		assert.Equal(t, int64(1), book.ID)
+		// The pages after the current one are fetched before they're needed, up to the depth
+		assert.Eventually(t, func() bool { return requests.Load() == int32(1+depth) },
+			time.Second, time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+		assert.EqualValues(t, 1+depth, requests.Load())
+		assert.Contains(t, iter.Cursor(), "startingAfter=1")
+
+		ids := []int64{book.ID}
+		for iter.Next(&book) {
+			ids = append(ids, book.ID)
+		}
+		require.NoError(t, iter.Err())
+		assert.Equal(t, []int64{1, 2, 3, 4}, ids)
+		// The last page is empty
+		assert.EqualValues(t, 5, requests.Load())
+	}
+}
+
+func TestIteratorClose(t *testing.T) {
+	c, requests := setupPagedClient(t, 4, 1)
+	c.SetPagePrefetch(1)
+	goroutines := runtime.NumGoroutine()
+
+	iter, err := c.NewGetBooksIter(context.Background(), &models.GetBooksInput{})
+	require.NoError(t, err)
+	var book models.Book
+	require.True(t, iter.Next(&book))
+	assert.Eventually(t, func() bool { return requests.Load() == 2 }, time.Second, time.Millisecond)
+
+	// Closing an iterator that isn't iterated to the end stops prefetching
+	iter.Close()
+	assert.Eventually(t, func() bool { return runtime.NumGoroutine() <= goroutines },
+		time.Second, time.Millisecond, "the prefetching goroutine should exit")
+	time.Sleep(20 * time.Millisecond)
+	assert.EqualValues(t, 2, requests.Load())
+}
+
+func TestSeqPrefetch(t *testing.T) {
+	c, requests := setupPagedClient(t, 4, 1)
+	c.SetPagePrefetch(1)
+	ctx := context.Background()
+
+	books, err := client.All(c.GetBooksSeq(ctx, &models.GetBooksInput{}))
+	require.NoError(t, err)
+	assert.Len(t, books, 4)
+
+	// Breaking out of the loop stops prefetching
+	requests.Store(0)
+	for book, err := range c.GetBooksSeq(ctx, &models.GetBooksInput{}) {
+		require.NoError(t, err)
+		if book.ID == 1 {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	assert.LessOrEqual(t, requests.Load(), int32(2))
+}
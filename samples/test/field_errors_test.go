@@ -0,0 +1,72 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-basic/client/v9"
+	"github.com/Clever/wag/samples/gen-go-basic/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-basic/server"
+	openapierrors "github.com/go-openapi/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBadRequestFieldErrors(t *testing.T) {
+	controller := &ControllerImpl{books: map[int64]*models.Book{}}
+	testServer := httptest.NewServer(server.New(controller, "").Handler)
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetRetryPolicy(client.NoRetryPolicy{})
+
+	for _, test := range []struct {
+		name string
+		call func() error
+		path string
+		code int32
+	}{
+		{
+			name: "parameter",
+			call: func() error {
+				_, err := c.GetBookByID(context.Background(), &models.GetBookByIDInput{BookID: 1})
+				return err
+			},
+			path: "book_id",
+			code: openapierrors.MinFailCode,
+		},
+		{
+			name: "body field",
+			call: func() error {
+				_, err := c.CreateBook(context.Background(), &models.Book{ID: 2, Genre: "romance"})
+				return err
+			},
+			path: "genre",
+			code: openapierrors.EnumFailCode,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.call()
+			require.IsType(t, &models.BadRequest{}, err)
+			badRequest := err.(*models.BadRequest)
+			require.Len(t, badRequest.Errors, 1)
+			assert.Equal(t, test.path, badRequest.Errors[0].Path)
+			assert.Equal(t, test.code, badRequest.Errors[0].Code)
+			assert.NotEmpty(t, badRequest.Errors[0].Message)
+		})
+	}
+
+	// parameters that can't be parsed are included too
+	resp, err := http.Get(testServer.URL + "/v1/books?available=notabool")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	var badRequest models.BadRequest
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&badRequest))
+	require.Len(t, badRequest.Errors, 1)
+	assert.Equal(t, "available", badRequest.Errors[0].Path)
+	assert.Equal(t, int32(openapierrors.InvalidTypeCode), badRequest.Errors[0].Code)
+}
@@ -0,0 +1,91 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Clever/wag/samples/gen-go-inline/client/v9"
+	"github.com/Clever/wag/samples/gen-go-inline/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-inline/server"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type InlineController struct {
+	things []*models.Thing
+}
+
+func (c *InlineController) ListThings(ctx context.Context) ([]models.ListThingsOKBodyItem, error) {
+	summaries := []models.ListThingsOKBodyItem{}
+	for _, thing := range c.things {
+		summaries = append(summaries, models.ListThingsOKBodyItem{ID: thing.ID, Name: thing.Name})
+	}
+	return summaries, nil
+}
+
+func (c *InlineController) CreateThing(ctx context.Context, i *models.CreateThingRequestBody) (*models.Thing, error) {
+	thing := &models.Thing{
+		ID:       "thing-1",
+		Name:     *i.Name,
+		Metadata: i.Metadata,
+		History:  []*models.ThingHistoryItem{{Event: "created", Count: 1}},
+		Labels:   models.ThingLabels{"new": {Color: "green"}},
+	}
+	c.things = append(c.things, thing)
+	return thing, nil
+}
+
+func (c *InlineController) GetThing(ctx context.Context, id string) (*models.GetThingOKBody, error) {
+	for _, thing := range c.things {
+		if thing.ID == id {
+			return &models.GetThingOKBody{Thing: thing, FetchedAt: strfmt.DateTime(time.Unix(0, 0).UTC())}, nil
+		}
+	}
+	return nil, models.BadRequest{Message: "unknown thing"}
+}
+
+func TestInlineSchemas(t *testing.T) {
+	s := server.New(&InlineController{}, "")
+	testServer := httptest.NewServer(s.Handler)
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	name := "widget"
+	metadata := &models.ThingMetadata{Owner: &models.ThingMetadataOwner{Name: "Ada", Email: "ada@example.com"}}
+	thing, err := c.CreateThing(context.Background(), &models.CreateThingRequestBody{Name: &name, Metadata: metadata})
+	require.NoError(t, err)
+	expected := &models.Thing{
+		ID:       "thing-1",
+		Name:     "widget",
+		Metadata: metadata,
+		History:  []*models.ThingHistoryItem{{Event: "created", Count: 1}},
+		Labels:   models.ThingLabels{"new": {Color: "green"}},
+	}
+	assert.Equal(t, expected, thing)
+
+	body, err := c.GetThing(context.Background(), "thing-1")
+	require.NoError(t, err)
+	assert.Equal(t, expected, body.Thing)
+	assert.Equal(t, strfmt.DateTime(time.Unix(0, 0).UTC()).String(), body.FetchedAt.String())
+
+	summaries, err := c.ListThings(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []models.ListThingsOKBodyItem{{ID: "thing-1", Name: "widget"}}, summaries)
+}
+
+func TestInlineRequestBodyValidation(t *testing.T) {
+	s := server.New(&InlineController{}, "")
+	testServer := httptest.NewServer(s.Handler)
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	// the required name of the inline body is validated like any other model
+	_, err := c.CreateThing(context.Background(), &models.CreateThingRequestBody{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "name")
+}
@@ -0,0 +1,313 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Clever/kayvee-go/v7/logger"
+	"github.com/Clever/wag/samples/gen-go-limits/client/v9"
+	"github.com/Clever/wag/samples/gen-go-limits/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-limits/server"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type LimitsController struct{}
+
+func (c *LimitsController) Authenticate(ctx context.Context, op string, creds server.Credentials, scopes []string) (context.Context, error) {
+	if creds.Token == "bad" {
+		return ctx, errors.New("bad token")
+	}
+	return server.WithCallerID(ctx, creds.Token), nil
+}
+
+func (c *LimitsController) LimitedByAuthenticatedCaller(ctx context.Context) error { return nil }
+func (c *LimitsController) LimitedByCaller(ctx context.Context) error              { return nil }
+func (c *LimitsController) LimitedByHeader(ctx context.Context) error              { return nil }
+func (c *LimitsController) LimitedByIP(ctx context.Context) error                  { return nil }
+func (c *LimitsController) Unlimited(ctx context.Context) error                    { return nil }
+
+func (c *LimitsController) CreateItem(ctx context.Context, i *models.Item) (*models.Item, error) {
+	return i, nil
+}
+
+func (c *LimitsController) CreateNote(ctx context.Context, i *models.Item) (*models.Item, error) {
+	return i, nil
+}
+
+func (c *LimitsController) Sleep(ctx context.Context, i *models.SleepInput) error {
+	return sleep(ctx, i.Sleep)
+}
+
+func (c *LimitsController) Slow(ctx context.Context, i *models.SlowInput) error {
+	if _, ok := ctx.Deadline(); !ok {
+		return errors.New("no deadline")
+	}
+	if i.Sleep == panicAfterTimeout {
+		<-ctx.Done()
+		time.Sleep(50 * time.Millisecond)
+		panic("panic after timeout")
+	}
+	return sleep(ctx, i.Sleep)
+}
+
+// panicAfterTimeout is the sleep that makes Slow panic after the request has timed out.
+const panicAfterTimeout = -2
+
+// sleep sleeps for a number of milliseconds, or panics if it's negative.
+func sleep(ctx context.Context, ms int64) error {
+	if ms < 0 {
+		panic("negative sleep")
+	}
+	select {
+	case <-time.After(time.Duration(ms) * time.Millisecond):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func setupLimitsServer(t *testing.T, s *server.Server) (*httptest.Server, *client.WagClient) {
+	testServer := httptest.NewServer(s.Handler)
+	t.Cleanup(testServer.Close)
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetRetryPolicy(client.NoRetryPolicy{})
+	return testServer, c
+}
+
+func getWithHeader(t *testing.T, url, header, value string) *http.Response {
+	req, err := http.NewRequest("GET", url, nil)
+	require.NoError(t, err)
+	req.Header.Set(header, value)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	return resp
+}
+
+func TestRateLimitByIP(t *testing.T) {
+	testServer, c := setupLimitsServer(t, server.New(&LimitsController{}, ""))
+	ctx := context.Background()
+
+	require.NoError(t, c.LimitedByIP(ctx))
+	require.NoError(t, c.LimitedByIP(ctx))
+	err := c.LimitedByIP(ctx)
+	require.Error(t, err)
+	assert.Equal(t, int64(http.StatusTooManyRequests), err.(models.UnknownResponse).StatusCode)
+	assert.JSONEq(t, `{"message": "rate limit exceeded"}`, err.(models.UnknownResponse).Body)
+
+	// two requests a minute refill a token every 30 seconds
+	resp, err := http.Get(testServer.URL + "/v1/by-ip")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "30", resp.Header.Get("Retry-After"))
+
+	// operations are limited separately
+	for i := 0; i < 5; i++ {
+		require.NoError(t, c.Unlimited(ctx))
+	}
+}
+
+func TestRateLimitByHeader(t *testing.T) {
+	testServer, c := setupLimitsServer(t, server.New(&LimitsController{}, ""))
+
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, http.StatusOK, getWithHeader(t, testServer.URL+"/v1/by-header", "X-Client-ID", "a").StatusCode)
+	}
+	resp := getWithHeader(t, testServer.URL+"/v1/by-header", "X-Client-ID", "a")
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "3600", resp.Header.Get("Retry-After"))
+	assert.Equal(t, http.StatusOK, getWithHeader(t, testServer.URL+"/v1/by-header", "X-Client-ID", "b").StatusCode)
+
+	// requests without the header are limited by their IP, and get the 429 type of the operation
+	require.NoError(t, c.LimitedByHeader(context.Background()))
+	require.NoError(t, c.LimitedByHeader(context.Background()))
+	err := c.LimitedByHeader(context.Background())
+	assert.Equal(t, &models.TooManyRequests{Message: "rate limit exceeded"}, err)
+}
+
+func TestRateLimitByCaller(t *testing.T) {
+	callerID := func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if caller := r.Header.Get("X-Caller"); caller != "" {
+				r = r.WithContext(server.WithCallerID(r.Context(), caller))
+			}
+			h.ServeHTTP(w, r)
+		})
+	}
+	testServer, _ := setupLimitsServer(t, server.NewWithMiddleware(&LimitsController{}, "",
+		[]func(http.Handler) http.Handler{callerID}))
+
+	assert.Equal(t, http.StatusOK, getWithHeader(t, testServer.URL+"/v1/by-caller", "X-Caller", "a").StatusCode)
+	assert.Equal(t, http.StatusTooManyRequests, getWithHeader(t, testServer.URL+"/v1/by-caller", "X-Caller", "a").StatusCode)
+	assert.Equal(t, http.StatusOK, getWithHeader(t, testServer.URL+"/v1/by-caller", "X-Caller", "b").StatusCode)
+}
+
+func TestRateLimitByAuthenticatedCaller(t *testing.T) {
+	testServer, _ := setupLimitsServer(t, server.New(&LimitsController{}, ""))
+	url := testServer.URL + "/v1/by-authenticated-caller"
+
+	// The caller ID that Authenticate sets is available to the rate limit
+	assert.Equal(t, http.StatusOK, getWithHeader(t, url, "X-API-Key", "a").StatusCode)
+	assert.Equal(t, http.StatusTooManyRequests, getWithHeader(t, url, "X-API-Key", "a").StatusCode)
+	assert.Equal(t, http.StatusOK, getWithHeader(t, url, "X-API-Key", "b").StatusCode)
+	// Requests that fail authentication don't take tokens
+	assert.Equal(t, http.StatusUnauthorized, getWithHeader(t, url, "X-API-Key", "bad").StatusCode)
+	assert.Equal(t, http.StatusOK, getWithHeader(t, url, "X-API-Key", "c").StatusCode)
+}
+
+func TestRateLimitTrustedProxies(t *testing.T) {
+	testServer, _ := setupLimitsServer(t, server.New(&LimitsController{}, "",
+		server.TrustedProxies(netip.MustParsePrefix("127.0.0.0/8"), netip.MustParsePrefix("10.0.0.0/8"))))
+	url := testServer.URL + "/v1/by-ip"
+
+	// Requests from a trusted proxy are limited by the last untrusted address they were forwarded for
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, http.StatusOK, getWithHeader(t, url, "X-Forwarded-For", "203.0.113.1, 10.0.0.1").StatusCode)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, getWithHeader(t, url, "X-Forwarded-For", "203.0.113.1").StatusCode)
+	// Clients can't pick their address by prepending to the header
+	assert.Equal(t, http.StatusTooManyRequests, getWithHeader(t, url, "X-Forwarded-For", "192.0.2.1, 203.0.113.1").StatusCode)
+	assert.Equal(t, http.StatusOK, getWithHeader(t, url, "X-Forwarded-For", "203.0.113.2").StatusCode)
+
+	// Without trusted proxies the header is ignored
+	testServer, _ = setupLimitsServer(t, server.New(&LimitsController{}, ""))
+	url = testServer.URL + "/v1/by-ip"
+	assert.Equal(t, http.StatusOK, getWithHeader(t, url, "X-Forwarded-For", "203.0.113.1").StatusCode)
+	assert.Equal(t, http.StatusOK, getWithHeader(t, url, "X-Forwarded-For", "203.0.113.2").StatusCode)
+	assert.Equal(t, http.StatusTooManyRequests, getWithHeader(t, url, "X-Forwarded-For", "203.0.113.3").StatusCode)
+}
+
+type rateLimitStore struct {
+	keys []string
+	err  error
+}
+
+func (s *rateLimitStore) Take(ctx context.Context, key string, limit server.RateLimit) (bool, time.Duration, error) {
+	s.keys = append(s.keys, key)
+	return false, time.Second, s.err
+}
+
+func TestRateLimitStore(t *testing.T) {
+	store := &rateLimitStore{}
+	_, c := setupLimitsServer(t, server.New(&LimitsController{}, "", server.StoreRateLimits(store)))
+	err := c.LimitedByIP(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, int64(http.StatusTooManyRequests), err.(models.UnknownResponse).StatusCode)
+	assert.Equal(t, []string{"limitedByIP:ip:127.0.0.1"}, store.keys)
+
+	// requests are allowed when the store fails
+	store.err = errors.New("store unavailable")
+	require.NoError(t, c.LimitedByIP(context.Background()))
+
+	// a nil store turns rate limiting off
+	_, c = setupLimitsServer(t, server.New(&LimitsController{}, "", server.StoreRateLimits(nil)))
+	for i := 0; i < 5; i++ {
+		require.NoError(t, c.LimitedByIP(context.Background()))
+	}
+}
+
+func TestOperationTimeout(t *testing.T) {
+	_, c := setupLimitsServer(t, server.New(&LimitsController{}, ""))
+
+	require.NoError(t, c.Slow(context.Background(), &models.SlowInput{Sleep: 0}))
+	start := time.Now()
+	err := c.Slow(context.Background(), &models.SlowInput{Sleep: 1000})
+	assert.Equal(t, &models.GatewayTimeout{Message: "request timed out"}, err)
+	assert.Less(t, time.Since(start), time.Second)
+
+	// panics in handlers with a timeout reach PanicMiddleware like other panics, instead of
+	// crashing the process from the handler's goroutine
+	err = c.Slow(context.Background(), &models.SlowInput{Sleep: -1})
+	require.Error(t, err)
+	assert.NotEqual(t, &models.GatewayTimeout{Message: "request timed out"}, err)
+
+	// operations without x-timeout don't time out
+	require.NoError(t, c.Sleep(context.Background(), &models.SleepInput{Sleep: 200}))
+}
+
+// syncBuffer is a bytes.Buffer that's safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestPanicAfterTimeout(t *testing.T) {
+	logs := &syncBuffer{}
+	logTo := func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			logger.FromContext(r.Context()).SetOutput(logs)
+			h.ServeHTTP(w, r)
+		})
+	}
+	_, c := setupLimitsServer(t, server.NewWithMiddleware(&LimitsController{}, "",
+		[]func(http.Handler) http.Handler{logTo}))
+
+	// Panics after the request has timed out are logged, since there's no response to fail
+	err := c.Slow(context.Background(), &models.SlowInput{Sleep: panicAfterTimeout})
+	assert.Equal(t, &models.GatewayTimeout{Message: "request timed out"}, err)
+	assert.Eventually(t, func() bool {
+		return strings.Contains(logs.String(), `"title":"panic-after-timeout"`) &&
+			strings.Contains(logs.String(), "panic after timeout")
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestDefaultTimeout(t *testing.T) {
+	_, c := setupLimitsServer(t, server.New(&LimitsController{}, "", server.Timeout(100*time.Millisecond)))
+
+	require.NoError(t, c.Sleep(context.Background(), &models.SleepInput{Sleep: 0}))
+	err := c.Sleep(context.Background(), &models.SleepInput{Sleep: 1000})
+	require.Error(t, err)
+	assert.Equal(t, int64(http.StatusServiceUnavailable), err.(models.UnknownResponse).StatusCode)
+	assert.JSONEq(t, `{"message": "request timed out"}`, err.(models.UnknownResponse).Body)
+}
+
+func TestOperationMaxBodyBytes(t *testing.T) {
+	_, c := setupLimitsServer(t, server.New(&LimitsController{}, ""))
+
+	item, err := c.CreateItem(context.Background(), &models.Item{Name: "small"})
+	require.NoError(t, err)
+	assert.Equal(t, "small", item.Name)
+	_, err = c.CreateItem(context.Background(), &models.Item{Name: strings.Repeat("a", 64)})
+	assert.Equal(t, &models.PayloadTooLarge{Message: "http: request body too large"}, err)
+
+	// operations without x-max-body-bytes don't have a maximum
+	_, err = c.CreateNote(context.Background(), &models.Item{Name: strings.Repeat("a", 1<<20)})
+	require.NoError(t, err)
+}
+
+func TestDefaultMaxBodyBytes(t *testing.T) {
+	_, c := setupLimitsServer(t, server.New(&LimitsController{}, "", server.MaxBodyBytes(128)))
+
+	_, err := c.CreateNote(context.Background(), &models.Item{Name: "small"})
+	require.NoError(t, err)
+	_, err = c.CreateNote(context.Background(), &models.Item{Name: strings.Repeat("a", 128)})
+	require.Error(t, err)
+	assert.Equal(t, int64(http.StatusRequestEntityTooLarge), err.(models.UnknownResponse).StatusCode)
+
+	// x-max-body-bytes takes precedence
+	_, err = c.CreateItem(context.Background(), &models.Item{Name: strings.Repeat("a", 64)})
+	assert.IsType(t, &models.PayloadTooLarge{}, err)
+}
@@ -0,0 +1,136 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-polymorphism/client/v9"
+	"github.com/Clever/wag/samples/gen-go-polymorphism/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-polymorphism/server"
+	"github.com/go-openapi/swag"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type PolymorphismController struct {
+	events []models.Event
+}
+
+func (c *PolymorphismController) ListEvents(ctx context.Context) ([]models.Event, error) {
+	return c.events, nil
+}
+
+func (c *PolymorphismController) CreateEvent(ctx context.Context, i models.Event) (models.Event, error) {
+	c.events = append(c.events, i)
+	return i, nil
+}
+
+func (c *PolymorphismController) PutEvent(ctx context.Context, i *models.PutEventInput) (*models.PutEventResponse, error) {
+	i.Event.SetID(swag.String(i.ID))
+	for index, event := range c.events {
+		if *event.ID() == i.ID {
+			c.events[index] = i.Event
+			return &models.PutEventResponse{StatusCode: 200, OK: i.Event}, nil
+		}
+	}
+	c.events = append(c.events, i.Event)
+	return &models.PutEventResponse{StatusCode: 201, Created: i.Event}, nil
+}
+
+func (c *PolymorphismController) GetFeed(ctx context.Context) (*models.Feed, error) {
+	feed := &models.Feed{}
+	feed.SetEvents(c.events)
+	if len(c.events) > 0 {
+		feed.SetLatest(c.events[len(c.events)-1])
+	}
+	return feed, nil
+}
+
+func newClick(id, element string) *models.Click {
+	click := &models.Click{Element: swag.String(element), Count: 2}
+	click.SetID(swag.String(id))
+	return click
+}
+
+func newPurchase(id string, amount float64) *models.Purchase {
+	purchase := &models.Purchase{Amount: swag.Float64(amount)}
+	purchase.SetID(swag.String(id))
+	return purchase
+}
+
+func TestPolymorphicTypes(t *testing.T) {
+	s := server.New(&PolymorphismController{}, "")
+	testServer := httptest.NewServer(s.Handler)
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	event, err := c.CreateEvent(context.Background(), newClick("click-1", "button"))
+	require.NoError(t, err)
+	assert.Equal(t, newClick("click-1", "button"), event)
+
+	event, err = c.CreateEvent(context.Background(), newPurchase("purchase-1", 9.99))
+	require.NoError(t, err)
+	assert.Equal(t, newPurchase("purchase-1", 9.99), event)
+
+	events, err := c.ListEvents(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []models.Event{newClick("click-1", "button"), newPurchase("purchase-1", 9.99)}, events)
+
+	feed, err := c.GetFeed(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, events, feed.Events())
+	assert.Equal(t, newPurchase("purchase-1", 9.99), feed.Latest())
+}
+
+func TestPolymorphicInput(t *testing.T) {
+	s := server.New(&PolymorphismController{}, "")
+	testServer := httptest.NewServer(s.Handler)
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	resp, err := c.PutEvent(context.Background(), &models.PutEventInput{
+		ID: "event-1", Event: newClick("", "link"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.PutEventResponse{StatusCode: 201, Created: newClick("event-1", "link")}, resp)
+
+	resp, err = c.PutEvent(context.Background(), &models.PutEventInput{
+		ID: "event-1", Event: newPurchase("", 5),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.PutEventResponse{StatusCode: 200, OK: newPurchase("event-1", 5)}, resp)
+}
+
+func TestPolymorphicInputValidation(t *testing.T) {
+	s := server.New(&PolymorphismController{}, "")
+	testServer := httptest.NewServer(s.Handler)
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	// each variant is validated against its own definition
+	click := &models.Click{}
+	click.SetID(swag.String("click-1"))
+	_, err := c.CreateEvent(context.Background(), click)
+	require.Error(t, err)
+	assert.IsType(t, &models.BadRequest{}, err)
+	assert.Contains(t, err.Error(), "element")
+
+	_, err = c.CreateEvent(context.Background(), newPurchase("purchase-1", -1))
+	require.Error(t, err)
+	assert.IsType(t, &models.BadRequest{}, err)
+	assert.Contains(t, err.Error(), "amount")
+
+	for _, body := range []string{
+		`{"kind": "Refund", "id": "refund-1"}`,
+		`{"id": "event-1"}`,
+	} {
+		httpResp, err := http.Post(testServer.URL+"/v1/events", "application/json", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		httpResp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, httpResp.StatusCode, body)
+	}
+}
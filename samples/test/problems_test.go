@@ -0,0 +1,138 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-problems/client/v9"
+	"github.com/Clever/wag/samples/gen-go-problems/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-problems/server"
+	"github.com/go-openapi/swag"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ProblemsController struct {
+	widgets map[int64]*models.Widget
+}
+
+func (c *ProblemsController) CreateWidget(ctx context.Context, i *models.Widget) (*models.Widget, error) {
+	c.widgets[i.ID] = i
+	return i, nil
+}
+
+func (c *ProblemsController) GetWidget(ctx context.Context, i *models.GetWidgetInput) (*models.Widget, error) {
+	widget, ok := c.widgets[i.ID]
+	if !ok {
+		return nil, &models.NotFound{Message: "no such widget", ID: i.ID}
+	}
+	return widget, nil
+}
+
+func setupProblemsServer(t *testing.T) (*httptest.Server, *client.WagClient) {
+	controller := &ProblemsController{widgets: map[int64]*models.Widget{}}
+	testServer := httptest.NewServer(server.New(controller, "").Handler)
+	t.Cleanup(testServer.Close)
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetRetryPolicy(client.NoRetryPolicy{})
+	return testServer, c
+}
+
+func TestProblemDetailsForControllerErrors(t *testing.T) {
+	_, c := setupProblemsServer(t)
+
+	_, err := c.GetWidget(context.Background(), &models.GetWidgetInput{ID: 3})
+	var problem *models.ProblemDetails
+	require.True(t, errors.As(err, &problem))
+	assert.Equal(t, "about:blank", problem.Type)
+	assert.Equal(t, "Not Found", problem.Title)
+	assert.Equal(t, 404, problem.Status)
+	assert.Equal(t, "no such widget", problem.Detail)
+	assert.Equal(t, "/v1/widgets/3", problem.Instance)
+	assert.Empty(t, problem.InvalidParams)
+	assert.Equal(t, "no such widget", err.Error())
+
+	// the error model is decoded from the same body
+	var notFound *models.NotFound
+	require.True(t, errors.As(err, &notFound))
+	assert.Equal(t, &models.NotFound{Message: "no such widget", ID: 3}, notFound)
+}
+
+func TestProblemDetailsForInvalidParams(t *testing.T) {
+	_, c := setupProblemsServer(t)
+
+	for _, test := range []struct {
+		name   string
+		call   func() error
+		params []string
+	}{
+		{
+			name: "path parameter",
+			call: func() error {
+				_, err := c.GetWidget(context.Background(), &models.GetWidgetInput{ID: 0})
+				return err
+			},
+			params: []string{"id"},
+		},
+		{
+			name: "query parameter",
+			call: func() error {
+				_, err := c.GetWidget(context.Background(), &models.GetWidgetInput{ID: 1, Color: swag.String("green")})
+				return err
+			},
+			params: []string{"color"},
+		},
+		{
+			name: "body fields",
+			call: func() error {
+				_, err := c.CreateWidget(context.Background(), &models.Widget{ID: 1, Size: 11})
+				return err
+			},
+			params: []string{"name", "size"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.call()
+			var problem *models.ProblemDetails
+			require.True(t, errors.As(err, &problem))
+			assert.Equal(t, 400, problem.Status)
+			assert.Equal(t, "Bad Request", problem.Title)
+			var badRequest *models.BadRequest
+			require.True(t, errors.As(err, &badRequest))
+			assert.Equal(t, problem.Detail, badRequest.Message)
+
+			names := []string{}
+			for _, param := range problem.InvalidParams {
+				names = append(names, param.Name)
+				assert.NotEmpty(t, param.Reason)
+			}
+			assert.ElementsMatch(t, test.params, names)
+		})
+	}
+}
+
+func TestProblemDetailsResponse(t *testing.T) {
+	testServer, _ := setupProblemsServer(t)
+
+	// parameters that can't be parsed are named too
+	resp, err := http.Get(testServer.URL + "/v1/widgets/abc")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "application/problem+json", resp.Header.Get("Content-Type"))
+
+	var body map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "about:blank", body["type"])
+	assert.Equal(t, "Bad Request", body["title"])
+	assert.Equal(t, float64(400), body["status"])
+	assert.Equal(t, "/v1/widgets/abc", body["instance"])
+	assert.Equal(t, body["message"], body["detail"])
+	require.Len(t, body["invalid-params"], 1)
+	assert.Equal(t, "id", body["invalid-params"].([]interface{})[0].(map[string]interface{})["name"])
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-basic/client/v9"
+	"github.com/Clever/wag/samples/gen-go-basic/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-basic/server"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseValidation(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		isLocal   string
+		newServer func(c server.Controller) *server.Server
+		rejected  bool
+	}{
+		{
+			name:      "off by default",
+			newServer: func(c server.Controller) *server.Server { return server.New(c, "") },
+		},
+		{
+			name:      "on locally",
+			isLocal:   "true",
+			newServer: func(c server.Controller) *server.Server { return server.New(c, "") },
+			rejected:  true,
+		},
+		{
+			name:    "turned off locally",
+			isLocal: "true",
+			newServer: func(c server.Controller) *server.Server {
+				return server.New(c, "", server.ValidateResponses(server.ResponseValidationOff))
+			},
+		},
+		{
+			name: "log",
+			newServer: func(c server.Controller) *server.Server {
+				return server.New(c, "", server.ValidateResponses(server.LogInvalidResponses))
+			},
+		},
+		{
+			name: "reject",
+			newServer: func(c server.Controller) *server.Server {
+				return server.New(c, "", server.ValidateResponses(server.RejectInvalidResponses))
+			},
+			rejected: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("_IS_LOCAL", test.isLocal)
+			// romance isn't one of the genres of the Book definition
+			controller := &ControllerImpl{
+				books:    map[int64]*models.Book{2: {ID: 2, Genre: "romance"}},
+				maxID:    2,
+				pageSize: 100,
+			}
+			testServer := httptest.NewServer(test.newServer(controller).Handler)
+			defer testServer.Close()
+			c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+			c.SetRetryPolicy(client.NoRetryPolicy{})
+
+			book, err := c.GetBookByID(context.Background(), &models.GetBookByIDInput{BookID: 2})
+			books, listErr := c.GetBooks(context.Background(), &models.GetBooksInput{})
+			if !test.rejected {
+				require.NoError(t, err)
+				assert.Equal(t, "romance", book.Genre)
+				require.NoError(t, listErr)
+				assert.Len(t, books, 1)
+				return
+			}
+
+			require.IsType(t, &models.InternalError{}, err)
+			assert.Contains(t, err.Error(), "getBookByID returned an invalid response")
+			assert.Contains(t, err.Error(), "genre")
+			require.IsType(t, &models.InternalError{}, listErr)
+			assert.Contains(t, listErr.Error(), "getBooks returned an invalid response: item 0")
+		})
+	}
+}
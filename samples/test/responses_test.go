@@ -0,0 +1,245 @@
+package test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-responses/client/v9"
+	"github.com/Clever/wag/samples/gen-go-responses/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-responses/server"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ResponsesController struct {
+	books map[string]*models.Book
+	jobs  []models.Job
+	// jobResponse is returned by GetJob, when set.
+	jobResponse *models.GetJobResponse
+}
+
+func (c *ResponsesController) UpsertBook(ctx context.Context, i *models.UpsertBookInput) (*models.UpsertBookResponse, error) {
+	book := &models.Book{ID: i.ID, Title: i.Book.Title}
+	_, exists := c.books[i.ID]
+	c.books[i.ID] = book
+	if exists {
+		return &models.UpsertBookResponse{StatusCode: http.StatusOK, OK: book, ETag: `"` + book.Title + `"`}, nil
+	}
+	return &models.UpsertBookResponse{
+		StatusCode: http.StatusCreated,
+		Created:    book,
+		ETag:       `"` + book.Title + `"`,
+		Location:   "/v1/books/" + i.ID,
+	}, nil
+}
+
+func (c *ResponsesController) GetBook(ctx context.Context, id string) (*models.GetBookOutput, error) {
+	book, ok := c.books[id]
+	if !ok {
+		return nil, nil
+	}
+	remaining := int32(0)
+	popularity := 0.75
+	cacheHit := true
+	return &models.GetBookOutput{
+		Body:                book,
+		ETag:                `"` + book.Title + `"`,
+		XRateLimitRemaining: &remaining,
+		XPopularity:         &popularity,
+		XCacheHit:           &cacheHit,
+	}, nil
+}
+
+func (c *ResponsesController) DeleteBook(ctx context.Context, id string) (*models.DeleteBookOutput, error) {
+	count := int64(0)
+	if _, ok := c.books[id]; ok {
+		count = 1
+	}
+	delete(c.books, id)
+	return &models.DeleteBookOutput{XDeletedCount: &count}, nil
+}
+
+func (c *ResponsesController) ListJobs(ctx context.Context) (*models.ListJobsResponse, error) {
+	if c.jobs == nil {
+		return &models.ListJobsResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	return &models.ListJobsResponse{StatusCode: http.StatusOK, OK: c.jobs}, nil
+}
+
+func (c *ResponsesController) GetJob(ctx context.Context, id string) (*models.GetJobResponse, error) {
+	switch id {
+	case "done":
+		return &models.GetJobResponse{StatusCode: http.StatusOK, OK: &models.Job{ID: id, Result: "42"}}, nil
+	case "running":
+		return &models.GetJobResponse{StatusCode: http.StatusAccepted}, nil
+	case "cancelled":
+		return &models.GetJobResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	return c.jobResponse, nil
+}
+
+func setupResponsesServer(controller *ResponsesController) *httptest.Server {
+	s := server.New(controller, "")
+	return httptest.NewServer(s.Handler)
+}
+
+func TestMultipleSuccessResponsesWithBodies(t *testing.T) {
+	testServer := setupResponsesServer(&ResponsesController{books: map[string]*models.Book{}})
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	resp, err := c.UpsertBook(context.Background(), &models.UpsertBookInput{ID: "1", Book: &models.Book{Title: "Kindred"}})
+	require.NoError(t, err)
+	assert.Equal(t, &models.UpsertBookResponse{
+		StatusCode: http.StatusCreated,
+		Created:    &models.Book{ID: "1", Title: "Kindred"},
+		ETag:       `"Kindred"`,
+		Location:   "/v1/books/1",
+	}, resp)
+
+	resp, err = c.UpsertBook(context.Background(), &models.UpsertBookInput{ID: "1", Book: &models.Book{Title: "Dawn"}})
+	require.NoError(t, err)
+	assert.Equal(t, &models.UpsertBookResponse{
+		StatusCode: http.StatusOK,
+		OK:         &models.Book{ID: "1", Title: "Dawn"},
+		ETag:       `"Dawn"`,
+	}, resp)
+}
+
+func TestMultipleSuccessResponsesWithoutBodies(t *testing.T) {
+	testServer := setupResponsesServer(&ResponsesController{})
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	resp, err := c.GetJob(context.Background(), "done")
+	require.NoError(t, err)
+	assert.Equal(t, &models.GetJobResponse{StatusCode: http.StatusOK, OK: &models.Job{ID: "done", Result: "42"}}, resp)
+
+	resp, err = c.GetJob(context.Background(), "running")
+	require.NoError(t, err)
+	assert.Equal(t, &models.GetJobResponse{StatusCode: http.StatusAccepted}, resp)
+
+	resp, err = c.GetJob(context.Background(), "cancelled")
+	require.NoError(t, err)
+	assert.Equal(t, &models.GetJobResponse{StatusCode: http.StatusNoContent}, resp)
+}
+
+func TestMultipleSuccessResponsesArrays(t *testing.T) {
+	controller := &ResponsesController{}
+	testServer := setupResponsesServer(controller)
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	resp, err := c.ListJobs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &models.ListJobsResponse{StatusCode: http.StatusNoContent}, resp)
+
+	controller.jobs = []models.Job{}
+	resp, err = c.ListJobs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &models.ListJobsResponse{StatusCode: http.StatusOK, OK: []models.Job{}}, resp)
+
+	controller.jobs = []models.Job{{ID: "a"}, {ID: "b"}}
+	resp, err = c.ListJobs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &models.ListJobsResponse{StatusCode: http.StatusOK, OK: []models.Job{{ID: "a"}, {ID: "b"}}}, resp)
+}
+
+func TestMultipleSuccessResponsesRaw(t *testing.T) {
+	testServer := setupResponsesServer(&ResponsesController{})
+	defer testServer.Close()
+
+	resp, err := http.Get(testServer.URL + "/v1/jobs/running")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Empty(t, body)
+	assert.Empty(t, resp.Header.Get("Content-Type"))
+}
+
+func TestMultipleSuccessResponsesInvalid(t *testing.T) {
+	controller := &ResponsesController{}
+	testServer := setupResponsesServer(controller)
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	_, err := c.GetJob(context.Background(), "other")
+	assert.Equal(t, &models.InternalError{Message: "getJob returned a nil response"}, err)
+
+	controller.jobResponse = &models.GetJobResponse{StatusCode: http.StatusCreated}
+	_, err = c.GetJob(context.Background(), "other")
+	assert.Equal(t, &models.InternalError{Message: "getJob returned a response with unexpected status code 201"}, err)
+}
+
+func TestResponseHeaders(t *testing.T) {
+	testServer := setupResponsesServer(&ResponsesController{books: map[string]*models.Book{
+		"1": {ID: "1", Title: "Kindred"},
+	}})
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	remaining := int32(0)
+	popularity := 0.75
+	cacheHit := true
+	output, err := c.GetBook(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, &models.GetBookOutput{
+		Body:                &models.Book{ID: "1", Title: "Kindred"},
+		ETag:                `"Kindred"`,
+		XRateLimitRemaining: &remaining,
+		XPopularity:         &popularity,
+		XCacheHit:           &cacheHit,
+	}, output)
+
+	deleted := int64(1)
+	deleteOutput, err := c.DeleteBook(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, &models.DeleteBookOutput{XDeletedCount: &deleted}, deleteOutput)
+
+	// A nil output is a null body without headers
+	output, err = c.GetBook(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, &models.GetBookOutput{Body: &models.Book{}}, output)
+}
+
+func TestResponseHeadersRaw(t *testing.T) {
+	testServer := setupResponsesServer(&ResponsesController{books: map[string]*models.Book{
+		"1": {ID: "1", Title: "Kindred"},
+	}})
+	defer testServer.Close()
+
+	resp, err := http.Get(testServer.URL + "/v1/books/1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `"Kindred"`, resp.Header.Get("ETag"))
+	assert.Equal(t, "0", resp.Header.Get("X-Rate-Limit-Remaining"))
+	assert.Equal(t, "true", resp.Header.Get("X-Cache-Hit"))
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	resp, err = http.Get(testServer.URL + "/v1/books/2")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("ETag"))
+	assert.Empty(t, resp.Header.Get("X-Rate-Limit-Remaining"))
+}
+
+func TestInvalidResponseHeader(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Deleted-Count", "many")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	_, err := c.DeleteBook(context.Background(), "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid X-Deleted-Count header")
+}
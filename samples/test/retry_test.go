@@ -0,0 +1,170 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Clever/wag/samples/gen-go-basic/client/v9"
+	"github.com/Clever/wag/samples/gen-go-basic/models/v9"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransportErrorRetries(t *testing.T) {
+	var requests atomic.Int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			// Close the connection without a response
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetRetryPolicy(client.ExponentialRetryPolicy{})
+
+	require.NoError(t, c.HealthCheck(context.Background()))
+	assert.EqualValues(t, 2, requests.Load())
+
+	// Requests that aren't idempotent aren't retried
+	requests.Store(0)
+	_, err := c.CreateBook(context.Background(), &models.Book{})
+	require.Error(t, err)
+	assert.EqualValues(t, 1, requests.Load())
+}
+
+func TestRetryAfter(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		retryAfter func() string
+	}{
+		{
+			name:       "seconds",
+			retryAfter: func() string { return "1" },
+		},
+		{
+			name: "date",
+			retryAfter: func() string {
+				return time.Now().Add(2 * time.Second).UTC().Format(http.TimeFormat)
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var requests atomic.Int32
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) == 1 {
+					w.Header().Set("Retry-After", test.retryAfter())
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte("{}"))
+			}))
+			defer testServer.Close()
+			c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+			// 429s with a Retry-After header are retried whatever the method
+			start := time.Now()
+			_, err := c.CreateBook(context.Background(), &models.Book{})
+			require.NoError(t, err)
+			assert.EqualValues(t, 2, requests.Load())
+			assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+		})
+	}
+}
+
+// maxRetryAfterPolicy is the default retry policy with a lower limit on Retry-After waits.
+type maxRetryAfterPolicy struct {
+	client.SingleRetryPolicy
+	max time.Duration
+}
+
+func (p maxRetryAfterPolicy) MaxRetryAfter() time.Duration {
+	return p.max
+}
+
+func TestRetryTooManyRequests(t *testing.T) {
+	var requests atomic.Int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetRetryPolicy(client.ExponentialRetryPolicy{})
+
+	// 429s without a Retry-After header are only retried for idempotent requests
+	require.Error(t, c.HealthCheck(context.Background()))
+	assert.EqualValues(t, 6, requests.Load())
+
+	requests.Store(0)
+	_, err := c.CreateBook(context.Background(), &models.Book{})
+	require.Error(t, err)
+	assert.EqualValues(t, 1, requests.Load())
+}
+
+func TestRetryAfterMax(t *testing.T) {
+	var requests, retryAfter atomic.Int32
+	retryAfter.Store(60)
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Load())))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetTimeout(0)
+
+	// Responses that ask for a longer wait than the maximum are returned without a retry
+	start := time.Now()
+	require.Error(t, c.HealthCheck(context.Background()))
+	assert.EqualValues(t, 1, requests.Load())
+	assert.Less(t, time.Since(start), time.Second)
+
+	// Policies can set their own maximum
+	requests.Store(0)
+	retryAfter.Store(2)
+	c.SetRetryPolicy(maxRetryAfterPolicy{max: time.Second})
+	start = time.Now()
+	require.Error(t, c.HealthCheck(context.Background()))
+	assert.EqualValues(t, 1, requests.Load())
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestRetryContext(t *testing.T) {
+	var requests atomic.Int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	// Requests aren't retried after the deadline
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.Error(t, c.HealthCheck(ctx))
+	assert.EqualValues(t, 1, requests.Load())
+	assert.Less(t, time.Since(start), time.Second)
+
+	// or after the context is canceled
+	c.SetTimeout(0)
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start = time.Now()
+	err := c.HealthCheck(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.EqualValues(t, 2, requests.Load())
+	assert.Less(t, time.Since(start), time.Second)
+}
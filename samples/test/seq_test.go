@@ -0,0 +1,109 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-basic/client/v9"
+	"github.com/Clever/wag/samples/gen-go-basic/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-basic/server"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeq(t *testing.T) {
+	s, controller := setupServer()
+	defer s.Close()
+	controller.pageSize = 2
+	c := client.New(s.URL, wcl, &http.DefaultTransport)
+	ctx := context.Background()
+	for id := int64(1); id <= 3; id++ {
+		_, err := c.CreateBook(ctx, &models.Book{ID: id})
+		require.NoError(t, err)
+	}
+	requests := 0
+	c.AddMiddleware(func(next client.Doer) client.Doer {
+		return client.DoerFunc(func(hc *http.Client, r *http.Request) (*http.Response, error) {
+			requests++
+			return next.Do(hc, r)
+		})
+	})
+
+	ids := []int64{}
+	for book, err := range c.GetBooksSeq(ctx, &models.GetBooksInput{}) {
+		require.NoError(t, err)
+		ids = append(ids, book.ID)
+	}
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+	assert.Equal(t, 2, requests)
+
+	sizes := []int{}
+	for page, err := range c.GetBooksPages(ctx, &models.GetBooksInput{}) {
+		require.NoError(t, err)
+		sizes = append(sizes, len(page))
+	}
+	assert.Equal(t, []int{2, 1}, sizes)
+
+	// Breaking out of the loop stops fetching pages
+	requests = 0
+	for book, err := range c.GetBooksSeq(ctx, &models.GetBooksInput{}) {
+		require.NoError(t, err)
+		if book.ID == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 1, requests)
+
+	requests = 0
+	books, err := client.Collect(c.GetBooksSeq(ctx, &models.GetBooksInput{}), 1)
+	require.NoError(t, err)
+	require.Len(t, books, 1)
+	assert.Equal(t, int64(1), books[0].ID)
+	assert.Equal(t, 1, requests)
+
+	books, err = client.All(c.GetBooksSeq(ctx, &models.GetBooksInput{}))
+	require.NoError(t, err)
+	assert.Len(t, books, 3)
+
+	// Resources of operations whose pages are arrays of pointers are yielded as is
+	controller.authors = []*models.Author{{ID: "a"}, {ID: "b"}}
+	authors, err := client.All(c.GetAuthorsSeq(ctx, &models.GetAuthorsInput{}))
+	require.NoError(t, err)
+	assert.Equal(t, controller.authors, authors)
+}
+
+func TestSeqError(t *testing.T) {
+	controller := IterFailTest{sampleController: &ControllerImpl{
+		books:    map[int64]*models.Book{1: {ID: 1}, 2: {ID: 2}},
+		maxID:    2,
+		pageSize: 1,
+	}}
+	testServer := httptest.NewServer(server.New(&controller, "").Handler)
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+	c.SetRetryPolicy(client.NoRetryPolicy{})
+
+	var errs []error
+	for book, err := range c.GetBooksSeq(context.Background(), &models.GetBooksInput{}) {
+		if err != nil {
+			assert.Nil(t, book)
+			errs = append(errs, err)
+			continue
+		}
+		controller.fail = true
+	}
+	require.Len(t, errs, 1)
+	assert.Equal(t, "fail", errs[0].Error())
+
+	controller.fail = false
+	books, err := client.Collect(c.GetBooksSeq(context.Background(), &models.GetBooksInput{}), 0)
+	require.NoError(t, err)
+	assert.Len(t, books, 2)
+	controller.fail = true
+	books, err = client.All(c.GetBooksSeq(context.Background(), &models.GetBooksInput{}))
+	assert.Error(t, err)
+	assert.Empty(t, books)
+}
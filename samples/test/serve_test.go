@@ -0,0 +1,121 @@
+package test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/Clever/wag/samples/v9/gen-go-basic/server"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeOptions(t *testing.T) {
+	t.Setenv("_IS_LOCAL", "true")
+	// Keep SIGUSR1 from killing the process if it arrives before the server handles it
+	ignored := make(chan os.Signal, 1)
+	signal.Notify(ignored, syscall.SIGUSR1)
+	defer signal.Stop(ignored)
+
+	// Borrow the certificate httptest uses for 127.0.0.1
+	tlsServer := httptest.NewTLSServer(http.NotFoundHandler())
+	tlsConfig := tlsServer.TLS.Clone()
+	// httptest only offers HTTP/1.1, so let the server pick the protocols it offers
+	tlsConfig.NextProtos = nil
+	tlsClient := tlsServer.Client()
+	tlsServer.Close()
+
+	h2cTransport := &http.Transport{Protocols: new(http.Protocols)}
+	h2cTransport.Protocols.SetUnencryptedHTTP2(true)
+	h2Transport := tlsClient.Transport.(*http.Transport).Clone()
+	h2Transport.ForceAttemptHTTP2 = true
+
+	controller := &ControllerImpl{}
+	for _, test := range []struct {
+		name      string
+		newServer func(l net.Listener, onShutdown func()) *server.Server
+		scheme    string
+		client    *http.Client
+		proto     string
+	}{
+		{
+			name: "listener",
+			newServer: func(l net.Listener, onShutdown func()) *server.Server {
+				return server.New(controller, "", server.Listener(l), server.PprofAddr(""),
+					server.ShutdownSignals(syscall.SIGUSR1), server.OnShutdown(onShutdown))
+			},
+			scheme: "http",
+			client: http.DefaultClient,
+			proto:  "HTTP/1.1",
+		},
+		{
+			name: "tls",
+			newServer: func(l net.Listener, onShutdown func()) *server.Server {
+				return server.New(controller, "", server.Listener(l), server.TLSConfig(tlsConfig),
+					server.PprofAddr(""), server.ShutdownSignals(syscall.SIGUSR1), server.OnShutdown(onShutdown))
+			},
+			scheme: "https",
+			client: tlsClient,
+			proto:  "HTTP/1.1",
+		},
+		{
+			name: "h2c",
+			newServer: func(l net.Listener, onShutdown func()) *server.Server {
+				return server.New(controller, "", server.Listener(l), server.H2C(), server.PprofAddr(""),
+					server.ShutdownSignals(syscall.SIGUSR1), server.ShutdownTimeout(time.Second),
+					server.OnShutdown(onShutdown))
+			},
+			scheme: "http",
+			client: &http.Client{Transport: h2cTransport},
+			proto:  "HTTP/2.0",
+		},
+		{
+			// h2c doesn't turn off HTTP/2 over TLS
+			name: "h2c with tls",
+			newServer: func(l net.Listener, onShutdown func()) *server.Server {
+				return server.New(controller, "", server.Listener(l), server.TLSConfig(tlsConfig), server.H2C(),
+					server.PprofAddr(""), server.ShutdownSignals(syscall.SIGUSR1), server.ShutdownTimeout(time.Second),
+					server.OnShutdown(onShutdown))
+			},
+			scheme: "https",
+			client: &http.Client{Transport: h2Transport},
+			proto:  "HTTP/2.0",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			shutdownStarted := make(chan struct{})
+			s := test.newServer(l, func() { close(shutdownStarted) })
+			served := make(chan error, 1)
+			go func() {
+				served <- s.Serve()
+			}()
+
+			resp, err := test.client.Get(test.scheme + "://" + l.Addr().String() + "/v1/health/check")
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, test.proto, resp.Proto)
+
+			require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGUSR1))
+			select {
+			case err := <-served:
+				require.NoError(t, err)
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "the server didn't shut down")
+			}
+			select {
+			case <-shutdownStarted:
+			default:
+				assert.Fail(t, "the shutdown hook wasn't called")
+			}
+		})
+	}
+}
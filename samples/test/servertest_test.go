@@ -0,0 +1,92 @@
+package test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-basic/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-basic/server"
+	"github.com/Clever/wag/samples/v9/gen-go-basic/servertest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerTest(t *testing.T) {
+	controller := &ControllerImpl{books: make(map[int64]*models.Book), pageSize: 1}
+	s := servertest.New(t, controller)
+
+	_, err := s.Client.CreateBook(context.Background(), &models.Book{ID: 1, Name: "Dune"})
+	require.NoError(t, err)
+	_, err = s.Client.CreateBook(context.Background(), &models.Book{ID: 2, Name: "Emma"})
+	require.NoError(t, err)
+
+	book, err := s.Client.GetBookByID(context.Background(), &models.GetBookByIDInput{BookID: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "Emma", book.Name)
+
+	// errors are mapped to their models
+	_, err = s.Client.GetBookByID(context.Background(), &models.GetBookByIDInput{BookID: 400})
+	assert.Equal(t, &models.BadRequest{Message: "My 400 failure"}, err)
+
+	// paging follows the next page header of the server
+	iter, err := s.Client.NewGetBooksIter(context.Background(), &models.GetBooksInput{})
+	require.NoError(t, err)
+	names := []string{}
+	for iter.Next(book) {
+		names = append(names, book.Name)
+	}
+	require.NoError(t, iter.Err())
+	assert.Equal(t, []string{"Dune", "Emma"}, names)
+}
+
+func TestServerTestHooks(t *testing.T) {
+	controller := &ControllerImpl{books: make(map[int64]*models.Book), pageSize: 100}
+	requests, responses := []string{}, []string{}
+	middlewareCalls := 0
+	s := servertest.New(t, controller,
+		servertest.WithMiddleware(func(h http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				middlewareCalls++
+				assert.Empty(t, r.Header.Get("X-Hooked"))
+				h.ServeHTTP(w, r)
+			})
+		}),
+		servertest.OnRequest(func(r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+			// the hooks get a copy of the request
+			r.Header.Set("X-Hooked", "true")
+		}),
+		servertest.OnResponse(func(r *http.Response) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			responses = append(responses, r.Status+" "+string(body))
+		}),
+	)
+
+	book, err := s.Client.CreateBook(context.Background(), &models.Book{ID: 3, Name: "Ulysses"})
+	require.NoError(t, err)
+	// the client still reads the bodies the hooks read
+	assert.Equal(t, "Ulysses", book.Name)
+
+	require.Len(t, requests, 1)
+	assert.Contains(t, requests[0], `POST /v1/books {`)
+	assert.Contains(t, requests[0], `"name":"Ulysses"`)
+	require.Len(t, responses, 1)
+	assert.Contains(t, responses[0], `200 OK {`)
+	assert.Equal(t, 1, middlewareCalls)
+}
+
+func TestServerTestServerOptions(t *testing.T) {
+	controller := &ControllerImpl{books: make(map[int64]*models.Book)}
+	s := servertest.New(t, controller, servertest.WithServerOptions(server.ServeSpec()))
+
+	resp, err := http.Get(s.HTTPServer.URL + "/v1/swagger.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
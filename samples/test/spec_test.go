@@ -0,0 +1,70 @@
+package test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Clever/wag/samples/v9/gen-go-inline/server"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, url string) (*http.Response, string) {
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(body)
+}
+
+func TestServeSpec(t *testing.T) {
+	s := server.New(&InlineController{}, "", server.ServeSpec())
+	testServer := httptest.NewServer(s.Handler)
+	defer testServer.Close()
+
+	resp, body := get(t, testServer.URL+"/v1/swagger.json")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	var spec struct {
+		BasePath    string                 `json:"basePath"`
+		Paths       map[string]interface{} `json:"paths"`
+		Definitions map[string]interface{} `json:"definitions"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(body), &spec))
+	assert.Equal(t, "/v1", spec.BasePath)
+	assert.Contains(t, spec.Paths, "/things/{id}")
+	// the spec is the one the code was generated from, with inline objects in definitions
+	assert.Contains(t, spec.Definitions, "ThingMetadataOwner")
+
+	resp, body = get(t, testServer.URL+"/v1/swagger.yml")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/yaml", resp.Header.Get("Content-Type"))
+	assert.Contains(t, body, "basePath: /v1\n")
+	assert.Contains(t, body, "  ThingMetadataOwner:\n")
+
+	resp, body = get(t, testServer.URL+"/v1/docs")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/html; charset=utf-8", resp.Header.Get("Content-Type"))
+	assert.Contains(t, body, `<details class="item" id="op-createThing">`)
+	assert.Contains(t, body, `<details class="item" id="model-ThingMetadataOwner">`)
+
+	// operations still work
+	resp, _ = get(t, testServer.URL+"/v1/things")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestServeSpecIsOptional(t *testing.T) {
+	s := server.New(&InlineController{}, "")
+	testServer := httptest.NewServer(s.Handler)
+	defer testServer.Close()
+
+	for _, path := range []string{"/v1/swagger.json", "/v1/swagger.yml", "/v1/docs"} {
+		resp, _ := get(t, testServer.URL+path)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode, path)
+	}
+}
@@ -0,0 +1,179 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Clever/wag/samples/gen-go-upload/client/v9"
+	"github.com/Clever/wag/samples/gen-go-upload/models/v9"
+	"github.com/Clever/wag/samples/v9/gen-go-upload/server"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type UploadController struct{}
+
+func (c *UploadController) UploadDocument(ctx context.Context, i *models.UploadDocumentInput) (*models.Document, error) {
+	contents, err := ioutil.ReadAll(i.File)
+	if err != nil {
+		return nil, err
+	}
+	doc := &models.Document{
+		Title:    i.Title,
+		Contents: string(contents),
+		Tags:     i.Tags,
+		Source:   i.XRequestSource,
+	}
+	if i.Pages != nil {
+		doc.Pages = *i.Pages
+	}
+	if i.Thumbnail != nil {
+		thumbnail, err := ioutil.ReadAll(i.Thumbnail)
+		if err != nil {
+			return nil, err
+		}
+		doc.ThumbnailSize = int64(len(thumbnail))
+	}
+	return doc, nil
+}
+
+func (c *UploadController) AddComment(ctx context.Context, i *models.AddCommentInput) (*models.Comment, error) {
+	comment := &models.Comment{
+		DocumentID: i.ID,
+		Author:     i.Author,
+		Mentions:   i.Mentions,
+	}
+	if i.Body != nil {
+		comment.Body = *i.Body
+	}
+	return comment, nil
+}
+
+func setupUploadServer() *httptest.Server {
+	s := server.New(&UploadController{}, "")
+	return httptest.NewServer(s.Handler)
+}
+
+func TestUploadFile(t *testing.T) {
+	testServer := setupUploadServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	pages := int64(3)
+	doc, err := c.UploadDocument(context.Background(), &models.UploadDocumentInput{
+		File:           ioutil.NopCloser(strings.NewReader("hello, world")),
+		Thumbnail:      ioutil.NopCloser(bytes.NewReader([]byte{1, 2, 3, 4})),
+		Title:          "greeting",
+		Pages:          &pages,
+		Tags:           []string{"a", "b"},
+		XRequestSource: "test",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.Document{
+		Title:         "greeting",
+		Contents:      "hello, world",
+		ThumbnailSize: 4,
+		Pages:         3,
+		Tags:          []string{"a", "b"},
+		Source:        "test",
+	}, doc)
+}
+
+func TestUploadOSFile(t *testing.T) {
+	testServer := setupUploadServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	path := filepath.Join(t.TempDir(), "report.csv")
+	require.NoError(t, ioutil.WriteFile(path, []byte("a,b\n1,2\n"), 0644))
+	f, err := os.Open(path)
+	require.NoError(t, err)
+
+	doc, err := c.UploadDocument(context.Background(), &models.UploadDocumentInput{
+		File:  f,
+		Title: "report",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "a,b\n1,2\n", doc.Contents)
+	assert.Equal(t, int64(0), doc.ThumbnailSize)
+
+	// The client closes files once they're sent
+	_, err = f.Read(make([]byte, 1))
+	assert.Error(t, err)
+}
+
+func TestUploadValidation(t *testing.T) {
+	testServer := setupUploadServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	_, err := c.UploadDocument(context.Background(), &models.UploadDocumentInput{
+		Title: "no file",
+	})
+	assert.Equal(t, &models.BadRequest{Message: "form file 'file' must be specified"}, err)
+
+	_, err = c.UploadDocument(context.Background(), &models.UploadDocumentInput{
+		File:  ioutil.NopCloser(strings.NewReader("contents")),
+		Title: "a title that is longer than twenty characters",
+	})
+	require.Error(t, err)
+	_, ok := err.(*models.BadRequest)
+	assert.True(t, ok, "expected BadRequest, got %T", err)
+}
+
+func TestUploadRawMultipart(t *testing.T) {
+	testServer := setupUploadServer()
+	defer testServer.Close()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", "notes.txt")
+	require.NoError(t, err)
+	_, err = io.WriteString(part, "some notes")
+	require.NoError(t, err)
+	require.NoError(t, form.WriteField("title", "notes"))
+	require.NoError(t, form.WriteField("pages", "not a number"))
+	require.NoError(t, form.Close())
+
+	resp, err := http.Post(testServer.URL+"/v1/documents", form.FormDataContentType(), &body)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestURLEncodedForm(t *testing.T) {
+	testServer := setupUploadServer()
+	defer testServer.Close()
+	c := client.New(testServer.URL, wcl, &http.DefaultTransport)
+
+	body := "looks good"
+	comment, err := c.AddComment(context.Background(), &models.AddCommentInput{
+		ID:       "doc1",
+		Author:   "ada",
+		Body:     &body,
+		Mentions: []string{"grace", "alan"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.Comment{
+		DocumentID: "doc1",
+		Author:     "ada",
+		Body:       "looks good",
+		Mentions:   []string{"grace", "alan"},
+	}, comment)
+
+	resp, err := http.PostForm(testServer.URL+"/v1/documents/doc1/comments", url.Values{"body": {"anonymous"}})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
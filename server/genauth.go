@@ -0,0 +1,143 @@
+package server
+
+import (
+	"github.com/go-openapi/spec"
+
+	"github.com/Clever/wag/v9/swagger"
+	"github.com/Clever/wag/v9/templates"
+)
+
+type authFileTemplate struct {
+	ImportStatements string
+	Schemes          []swagger.SecurityScheme
+}
+
+var authTemplateStr = `
+package server
+
+// Code auto-generated. Do not edit.
+
+{{.ImportStatements}}
+
+var _ = strings.EqualFold
+
+// SecurityScheme is the name of a security scheme in the swagger spec's securityDefinitions.
+type SecurityScheme string
+
+// The security schemes defined in the swagger spec.
+const (
+	{{- range .Schemes}}
+	{{.ConstName}} SecurityScheme = "{{.Name}}"
+	{{- end}}
+)
+
+// Credentials are the credentials a request presented for a security scheme.
+type Credentials struct {
+	// Scheme is the security scheme the credentials were presented for.
+	Scheme SecurityScheme
+	// Token is the key for apiKey schemes and the bearer token for oauth2 schemes.
+	Token string
+	// Username and Password are set for basic schemes.
+	Username string
+	Password string
+}
+
+// ErrMissingCredentials is returned with a 401 when a request doesn't present the credentials
+// for any of the operation's security requirements.
+var ErrMissingCredentials = errors.New("missing credentials")
+
+type securityRequirement struct {
+	scheme SecurityScheme
+	scopes []string
+}
+
+// unauthorized is the response body for requests that fail authentication.
+type unauthorized struct {
+	Message string ` + "`json:\"message\"`" + `
+}
+
+// authenticate checks a request against an operation's security requirements. The requirements
+// are alternatives: the first one whose credentials are all present in the request is passed to
+// the Authenticator, one scheme at a time. An empty requirement allows anonymous requests, but
+// only once the requirements with schemes have been tried, so requests that present credentials
+// are still authenticated wherever the empty requirement is listed.
+func authenticate(ctx context.Context, a Authenticator, r *http.Request, op string, requirements [][]securityRequirement) (context.Context, error) {
+	anonymous := false
+	for _, requirement := range requirements {
+		if len(requirement) == 0 {
+			anonymous = true
+			continue
+		}
+		creds := make([]Credentials, 0, len(requirement))
+		for _, req := range requirement {
+			c, ok := credentialsFor(r, req.scheme)
+			if !ok {
+				break
+			}
+			creds = append(creds, c)
+		}
+		if len(creds) != len(requirement) {
+			continue
+		}
+
+		var err error
+		for i, req := range requirement {
+			ctx, err = a.Authenticate(ctx, op, creds[i], req.scopes)
+			if err != nil {
+				return ctx, err
+			}
+		}
+		return ctx, nil
+	}
+	if anonymous {
+		return ctx, nil
+	}
+	return ctx, ErrMissingCredentials
+}
+
+// credentialsFor returns the credentials the request presented for a security scheme.
+func credentialsFor(r *http.Request, scheme SecurityScheme) (Credentials, bool) {
+	switch scheme {
+	{{- range .Schemes}}
+	case {{.ConstName}}:
+		{{- if eq .Type "basic"}}
+		username, password, ok := r.BasicAuth()
+		if !ok {
+			return Credentials{}, false
+		}
+		return Credentials{Scheme: scheme, Username: username, Password: password}, true
+		{{- else if eq .Type "oauth2"}}
+		auth := r.Header.Get("Authorization")
+		if len(auth) < len("Bearer ") || !strings.EqualFold(auth[:len("Bearer ")], "Bearer ") {
+			return Credentials{}, false
+		}
+		return Credentials{Scheme: scheme, Token: auth[len("Bearer "):]}, true
+		{{- else}}
+		{{- if eq .In "query"}}
+		token := r.URL.Query().Get("{{.ParamName}}")
+		{{- else}}
+		token := r.Header.Get("{{.ParamName}}")
+		{{- end}}
+		if token == "" {
+			return Credentials{}, false
+		}
+		return Credentials{Scheme: scheme, Token: token}, true
+		{{- end}}
+	{{- end}}
+	}
+	return Credentials{}, false
+}
+`
+
+func generateAuth(basePath string, s *spec.Swagger) error {
+	authCode, err := templates.WriteTemplate(authTemplateStr, authFileTemplate{
+		ImportStatements: swagger.ImportStatements([]string{"context", "errors", "net/http", "strings"}),
+		Schemes:          swagger.SecuritySchemes(s),
+	})
+	if err != nil {
+		return err
+	}
+	g := swagger.Generator{BasePath: basePath}
+	g.Print(authCode)
+	return g.WriteFile("server/auth.go")
+}
@@ -0,0 +1,344 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+
+	"github.com/Clever/wag/v9/swagger"
+)
+
+type docsTemplate struct {
+	Title       string
+	Version     string
+	Description string
+	SpecPath    string
+	Operations  []docsOperation
+	Models      []docsModel
+}
+
+type docsOperation struct {
+	ID          string
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Deprecated  bool
+	Parameters  []docsField
+	// Example is an example of the body of the request, if the operation has one.
+	Example   string
+	Responses []docsResponse
+}
+
+type docsField struct {
+	Name        string
+	In          string
+	Type        template.HTML
+	Required    bool
+	Description string
+}
+
+type docsResponse struct {
+	Status      string
+	Description string
+	Type        template.HTML
+	Example     string
+}
+
+type docsModel struct {
+	Name        string
+	Description string
+	Extends     []string
+	Variants    []string
+	Type        template.HTML
+	Properties  []docsField
+	Example     string
+}
+
+// generateDocs returns an HTML page that documents the operations and models of a spec. It
+// doesn't load anything, so it works offline.
+func generateDocs(s *spec.Swagger) ([]byte, error) {
+	d := docsTemplate{
+		Title:       s.Info.Title,
+		Version:     s.Info.Version,
+		Description: s.Info.Description,
+		SpecPath:    specPath(s),
+	}
+
+	for _, pathKey := range swagger.SortedPathItemKeys(s.Paths.Paths) {
+		ops := swagger.PathItemOperations(s.Paths.Paths[pathKey])
+		for _, method := range swagger.SortedOperationsKeys(ops) {
+			op := ops[method]
+			docsOp := docsOperation{
+				ID:          op.ID,
+				Method:      method,
+				Path:        path.Join("/", s.BasePath, pathKey),
+				Summary:     op.Summary,
+				Description: op.Description,
+				Deprecated:  op.Deprecated,
+			}
+			for _, param := range op.Parameters {
+				field := docsField{
+					Name:        param.Name,
+					In:          param.In,
+					Required:    param.Required,
+					Description: param.Description,
+				}
+				if param.Schema != nil {
+					field.Type = schemaTypeHTML(param.Schema)
+					docsOp.Example = exampleJSON(s, param.Schema)
+				} else {
+					field.Type = simpleTypeHTML(param.SimpleSchema, param.Enum)
+				}
+				docsOp.Parameters = append(docsOp.Parameters, field)
+			}
+			for _, statusCode := range swagger.SortedStatusCodeKeys(op.Responses.StatusCodeResponses) {
+				response := op.Responses.StatusCodeResponses[statusCode]
+				if strings.HasPrefix(response.Ref.String(), "#/responses/") {
+					response = s.Responses[strings.TrimPrefix(response.Ref.String(), "#/responses/")]
+				}
+				docsResponse := docsResponse{
+					Status:      strconv.Itoa(statusCode),
+					Description: response.Description,
+				}
+				if response.Schema != nil {
+					docsResponse.Type = schemaTypeHTML(response.Schema)
+					docsResponse.Example = exampleJSON(s, response.Schema)
+				}
+				docsOp.Responses = append(docsOp.Responses, docsResponse)
+			}
+			d.Operations = append(d.Operations, docsOp)
+		}
+	}
+
+	names := []string{}
+	for name := range s.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		def := s.Definitions[name]
+		model := docsModel{
+			Name:        name,
+			Description: def.Description,
+			Example:     exampleJSON(s, spec.RefSchema("#/definitions/"+name)),
+		}
+		if def.Discriminator != "" {
+			model.Variants = swagger.Subtypes(s, name)
+		}
+		schemas := []spec.Schema{def}
+		for _, sub := range def.AllOf {
+			if sub.Ref.String() != "" {
+				model.Extends = append(model.Extends, strings.TrimPrefix(sub.Ref.String(), "#/definitions/"))
+			} else {
+				schemas = append(schemas, sub)
+			}
+		}
+		for _, schema := range schemas {
+			for _, property := range swagger.SortedSchemaProperties(schema) {
+				propertySchema := schema.Properties[property]
+				model.Properties = append(model.Properties, docsField{
+					Name:        property,
+					Type:        schemaTypeHTML(&propertySchema),
+					Required:    contains(schema.Required, property),
+					Description: propertySchema.Description,
+				})
+			}
+		}
+		if len(def.Properties) == 0 && len(def.AllOf) == 0 {
+			model.Type = schemaTypeHTML(&def)
+		}
+		d.Models = append(d.Models, model)
+	}
+
+	var buf bytes.Buffer
+	if err := docsTemplateHTML.Execute(&buf, d); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func contains(slice []string, value string) bool {
+	for _, v := range slice {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaTypeHTML describes the type of a schema, with links to the models it refers to.
+func schemaTypeHTML(schema *spec.Schema) template.HTML {
+	if schema.Ref.String() != "" {
+		name := template.HTMLEscapeString(strings.TrimPrefix(schema.Ref.String(), "#/definitions/"))
+		return template.HTML(fmt.Sprintf(`<a href="#model-%s">%s</a>`, name, name))
+	}
+	if len(schema.AllOf) > 0 {
+		types := []string{}
+		for _, sub := range schema.AllOf {
+			sub := sub
+			types = append(types, string(schemaTypeHTML(&sub)))
+		}
+		return template.HTML(strings.Join(types, " &amp; "))
+	}
+	if schema.Items != nil && schema.Items.Schema != nil {
+		return "array of " + schemaTypeHTML(schema.Items.Schema)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		return "map of " + schemaTypeHTML(schema.AdditionalProperties.Schema)
+	}
+	typeName := "any"
+	if len(schema.Type) > 0 {
+		typeName = schema.Type[0]
+	} else if len(schema.Properties) > 0 {
+		typeName = "object"
+	}
+	return primitiveTypeHTML(typeName, schema.Format, schema.Enum)
+}
+
+// simpleTypeHTML describes the type of a parameter that isn't in the body.
+func simpleTypeHTML(schema spec.SimpleSchema, enum []interface{}) template.HTML {
+	if schema.Type == "array" && schema.Items != nil {
+		return "array of " + simpleTypeHTML(schema.Items.SimpleSchema, schema.Items.Enum)
+	}
+	return primitiveTypeHTML(schema.Type, schema.Format, enum)
+}
+
+func primitiveTypeHTML(typeName, format string, enum []interface{}) template.HTML {
+	description := typeName
+	if format != "" {
+		description += " (" + format + ")"
+	}
+	if len(enum) > 0 {
+		values := []string{}
+		for _, value := range enum {
+			encoded, _ := json.Marshal(value)
+			values = append(values, string(encoded))
+		}
+		description += ", one of " + strings.Join(values, ", ")
+	}
+	return template.HTML(template.HTMLEscapeString(description))
+}
+
+// exampleJSON returns an example of a value of a schema as indented JSON.
+func exampleJSON(s *spec.Swagger, schema *spec.Schema) string {
+	example, err := json.MarshalIndent(swagger.Example(s, *schema), "", "  ")
+	if err != nil {
+		return ""
+	}
+	return string(example)
+}
+
+var docsTemplateHTML = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}} {{.Version}}</title>
+<style>
+  body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 0; color: #222; }
+  header { background: #1f2a44; color: #fff; padding: 16px 32px; }
+  header h1 { margin: 0 0 4px 0; font-size: 24px; }
+  header a { color: #c8d3f0; }
+  main { padding: 16px 32px; max-width: 1100px; }
+  #filter { width: 100%; padding: 8px; font-size: 15px; margin: 8px 0 16px 0; box-sizing: border-box; }
+  details { border: 1px solid #dde; border-radius: 4px; margin: 8px 0; padding: 8px 12px; }
+  summary { cursor: pointer; font-family: Menlo, Consolas, monospace; }
+  .method { display: inline-block; min-width: 64px; font-weight: bold; }
+  .GET { color: #1a7f37; } .POST { color: #0550ae; } .PUT, .PATCH { color: #9a6700; } .DELETE { color: #cf222e; }
+  .deprecated { text-decoration: line-through; }
+  table { border-collapse: collapse; margin: 8px 0; width: 100%; }
+  th, td { text-align: left; border-bottom: 1px solid #eee; padding: 4px 8px; vertical-align: top; }
+  pre { background: #f6f8fa; padding: 8px; overflow-x: auto; }
+  .required { color: #cf222e; }
+</style>
+</head>
+<body>
+<header>
+  <h1>{{.Title}}</h1>
+  <div>Version {{.Version}} &middot; <a href="{{.SpecPath}}.json">swagger.json</a> &middot; <a href="{{.SpecPath}}.yml">swagger.yml</a></div>
+  {{if .Description}}<p>{{.Description}}</p>{{end}}
+</header>
+<main>
+<input id="filter" type="search" placeholder="Filter operations and models">
+
+<h2>Operations</h2>
+{{range .Operations}}
+<details class="item" id="op-{{.ID}}">
+  <summary{{if .Deprecated}} class="deprecated"{{end}}><span class="method {{.Method}}">{{.Method}}</span> {{.Path}} &mdash; {{.ID}}</summary>
+  {{if .Summary}}<p><strong>{{.Summary}}</strong></p>{{end}}
+  {{if .Description}}<p>{{.Description}}</p>{{end}}
+  {{if .Deprecated}}<p>This operation is deprecated.</p>{{end}}
+  {{if .Parameters}}
+  <h4>Parameters</h4>
+  <table>
+    <tr><th>Name</th><th>In</th><th>Type</th><th>Description</th></tr>
+    {{range .Parameters}}
+    <tr><td>{{.Name}}{{if .Required}} <span class="required">*</span>{{end}}</td><td>{{.In}}</td><td>{{.Type}}</td><td>{{.Description}}</td></tr>
+    {{end}}
+  </table>
+  {{end}}
+  {{if .Example}}
+  <h4>Example request body</h4>
+  <pre>{{.Example}}</pre>
+  {{end}}
+  <h4>Responses</h4>
+  <table>
+    <tr><th>Status</th><th>Type</th><th>Description</th></tr>
+    {{range .Responses}}
+    <tr><td>{{.Status}}</td><td>{{.Type}}</td><td>{{.Description}}{{if .Example}}<pre>{{.Example}}</pre>{{end}}</td></tr>
+    {{end}}
+  </table>
+</details>
+{{end}}
+
+<h2>Models</h2>
+{{range .Models}}
+<details class="item" id="model-{{.Name}}">
+  <summary>{{.Name}}</summary>
+  {{if .Description}}<p>{{.Description}}</p>{{end}}
+  {{if .Extends}}<p>Extends {{range $i, $e := .Extends}}{{if $i}}, {{end}}<a href="#model-{{$e}}">{{$e}}</a>{{end}}</p>{{end}}
+  {{if .Variants}}<p>One of {{range $i, $v := .Variants}}{{if $i}}, {{end}}<a href="#model-{{$v}}">{{$v}}</a>{{end}}</p>{{end}}
+  {{if .Type}}<p>Type: {{.Type}}</p>{{end}}
+  {{if .Properties}}
+  <table>
+    <tr><th>Property</th><th>Type</th><th>Description</th></tr>
+    {{range .Properties}}
+    <tr><td>{{.Name}}{{if .Required}} <span class="required">*</span>{{end}}</td><td>{{.Type}}</td><td>{{.Description}}</td></tr>
+    {{end}}
+  </table>
+  {{end}}
+  <h4>Example</h4>
+  <pre>{{.Example}}</pre>
+</details>
+{{end}}
+</main>
+<script>
+  // open the operation or model linked to, and filter the list as you type
+  function openHash() {
+    var item = document.getElementById(decodeURIComponent(location.hash.slice(1)));
+    if (item && item.tagName === "DETAILS") {
+      item.open = true;
+    }
+  }
+  window.addEventListener("hashchange", openHash);
+  openHash();
+  document.getElementById("filter").addEventListener("input", function (event) {
+    var query = event.target.value.toLowerCase();
+    var items = document.querySelectorAll(".item");
+    for (var i = 0; i < items.length; i++) {
+      var text = items[i].querySelector("summary").textContent.toLowerCase();
+      items[i].style.display = text.indexOf(query) === -1 ? "none" : "";
+    }
+  });
+</script>
+</body>
+</html>
+`))
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/spec"
+
+	clients "github.com/Clever/wag/v9/clients/go"
+	"github.com/Clever/wag/v9/swagger"
+)
+
+// routerLimits returns the requestLimits of an operation in the router, and the status code and
+// body of the response to requests that time out. The response uses the type the operation
+// defines for a 504 response, or else for a 503 response, if any.
+func routerLimits(s *spec.Swagger, op *spec.Operation) (string, string, string, error) {
+	timeout, err := swagger.OperationTimeout(op)
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid x-timeout for %s: %s", op.ID, err)
+	}
+	maxBodyBytes, err := swagger.OperationMaxBodyBytes(op)
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid x-max-body-bytes for %s: %s", op.ID, err)
+	}
+	var fields []string
+	if timeout > 0 {
+		fields = append(fields, "timeout: "+durationCode(timeout))
+	}
+	if maxBodyBytes > 0 {
+		fields = append(fields, fmt.Sprintf("maxBodyBytes: %d", maxBodyBytes))
+	}
+	limits := "requestLimits{" + strings.Join(fields, ", ") + "}"
+
+	codeToType := swagger.CodeToTypeMap(s, op, false)
+	if typ := codeToType[504]; typ != "" {
+		return limits, "http.StatusGatewayTimeout", typ + `{Message: "request timed out"}`, nil
+	}
+	if typ := codeToType[503]; typ != "" {
+		return limits, "http.StatusServiceUnavailable", typ + `{Message: "request timed out"}`, nil
+	}
+	return limits, "http.StatusServiceUnavailable", "requestTimedOut", nil
+}
+
+// requestTooLarge returns the body of the response to requests to an operation whose body is
+// larger than the maximum, or "" if the handler doesn't read the body. The response uses the type
+// the operation defines for a 413 response, if any. tooLarge is the *http.MaxBytesError.
+func requestTooLarge(s *spec.Swagger, op *spec.Operation) string {
+	readsBody := false
+	for _, param := range op.Parameters {
+		if param.In == "formData" || (param.In == "body" && !clients.IsBinaryParam(param, s.Definitions)) {
+			readsBody = true
+		}
+	}
+	if !readsBody {
+		return ""
+	}
+	if typ := swagger.CodeToTypeMap(s, op, false)[413]; typ != "" {
+		return typ + "{Message: tooLarge.Error()}"
+	}
+	return `map[string]string{"message": tooLarge.Error()}`
+}
@@ -0,0 +1,330 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/spec"
+
+	"github.com/Clever/wag/v9/swagger"
+	"github.com/Clever/wag/v9/templates"
+)
+
+type rateLimitFileTemplate struct {
+	ImportStatements string
+}
+
+var rateLimitTemplateStr = `
+package server
+
+// Code auto-generated. Do not edit.
+
+{{.ImportStatements}}
+
+// RateLimit is the x-rate-limit of an operation. Each key gets a bucket of Burst tokens that
+// refills with Requests tokens every Interval, and each request takes a token.
+type RateLimit struct {
+	Requests int
+	Interval time.Duration
+	Burst    int
+}
+
+// RateLimitStore keeps the token buckets of rate limits. Keys start with the operation ID.
+type RateLimitStore interface {
+	// Take takes a token from the bucket of a key. If the bucket is empty it returns false and how
+	// long until the bucket has a token.
+	Take(ctx context.Context, key string, limit RateLimit) (bool, time.Duration, error)
+}
+
+// rateLimitConfig is the configuration of rate limits in the server.
+type rateLimitConfig struct {
+	store          RateLimitStore
+	trustedProxies []netip.Prefix
+}
+
+// StoreRateLimits sets the store for the token buckets of rate limits. The default store keeps
+// them in memory, so each instance of the service limits its requests separately. Pass a store
+// that's shared between instances (e.g. one backed by Redis) to limit the requests to all of them,
+// or nil to turn off rate limiting. Requests are allowed if the store returns an error.
+func StoreRateLimits(store RateLimitStore) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.rateLimits.store = store
+	}
+}
+
+// TrustedProxies sets the addresses of the proxies in front of the service, e.g. its load
+// balancers. Rate limits keyed by IP limit requests from them by the client IP in their
+// X-Forwarded-For header: the last address in it that isn't a trusted proxy. By default no proxies
+// are trusted, so requests are limited by their remote address, which behind a proxy is the
+// proxy's.
+func TrustedProxies(prefixes ...netip.Prefix) func(*serverConfig) {
+	return func(c *serverConfig) {
+		c.rateLimits.trustedProxies = prefixes
+	}
+}
+
+type callerIDKey struct{}
+
+// WithCallerID returns a context that carries the ID of the caller of a request, which rate
+// limits keyed by caller use. Return it from Authenticate, or set it in middleware for operations
+// without security requirements. Requests without a caller ID are limited by their client IP.
+func WithCallerID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, callerIDKey{}, id)
+}
+
+// CallerID returns the ID of the caller set with WithCallerID, or "" if there isn't one.
+func CallerID(ctx context.Context) string {
+	id, _ := ctx.Value(callerIDKey{}).(string)
+	return id
+}
+
+// NewMemoryRateLimitStore returns a RateLimitStore that keeps token buckets in memory. Buckets
+// are removed once they refill.
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{buckets: map[string]*tokenBucket{}}
+}
+
+type memoryRateLimitStore struct {
+	mu         sync.Mutex
+	buckets    map[string]*tokenBucket
+	lastPruned time.Time
+}
+
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Time
+}
+
+// Take implements RateLimitStore.
+func (m *memoryRateLimitStore) Take(ctx context.Context, key string, limit RateLimit) (bool, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	m.prune(now)
+
+	// tokens per nanosecond
+	rate := float64(limit.Requests) / float64(limit.Interval)
+	bucket, ok := m.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(limit.Burst), updated: now}
+		m.buckets[key] = bucket
+	}
+	bucket.tokens = math.Min(float64(limit.Burst), bucket.tokens+rate*float64(now.Sub(bucket.updated)))
+	bucket.updated = now
+
+	taken := bucket.tokens >= 1
+	if taken {
+		bucket.tokens--
+	}
+	bucket.full = now.Add(time.Duration((float64(limit.Burst) - bucket.tokens) / rate))
+	if !taken {
+		return false, time.Duration((1 - bucket.tokens) / rate), nil
+	}
+	return true, 0, nil
+}
+
+// prune removes the buckets that have refilled, at most once a minute.
+func (m *memoryRateLimitStore) prune(now time.Time) {
+	if now.Sub(m.lastPruned) < time.Minute {
+		return
+	}
+	m.lastPruned = now
+	for key, bucket := range m.buckets {
+		if !now.Before(bucket.full) {
+			delete(m.buckets, key)
+		}
+	}
+}
+
+type rateLimitConfigKey struct{}
+
+// withRateLimits sets the rate limit configuration of the requests to a handler.
+func withRateLimits(handler http.Handler, config rateLimitConfig) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), rateLimitConfigKey{}, config)))
+	})
+}
+
+// takeRateLimit takes a token for a request from the bucket of its key for an operation's rate
+// limit. If the request exceeds the limit it returns false and how long until the client can
+// retry.
+func takeRateLimit(ctx context.Context, op string, limit RateLimit, key string) (bool, time.Duration) {
+	config, _ := ctx.Value(rateLimitConfigKey{}).(rateLimitConfig)
+	if config.store == nil {
+		return true, 0
+	}
+	ok, retryAfter, err := config.store.Take(ctx, op+":"+key, limit)
+	if err != nil {
+		// Don't fail requests because the store is unavailable
+		logger.FromContext(ctx).ErrorD("rate-limit-error", logger.M{"op": op, "error": err.Error()})
+		return true, 0
+	}
+	return ok, retryAfter
+}
+
+// rateLimitKey returns the key a request is limited by: the caller ID in ctx, the value of a
+// header or the client IP. Requests without a caller ID or the header are limited by their client
+// IP.
+func rateLimitKey(ctx context.Context, r *http.Request, key, header string) string {
+	switch key {
+	case "caller":
+		if id := CallerID(ctx); id != "" {
+			return "caller:" + id
+		}
+	case "header":
+		if value := r.Header.Get(header); value != "" {
+			return "header:" + value
+		}
+	}
+	config, _ := ctx.Value(rateLimitConfigKey{}).(rateLimitConfig)
+	return "ip:" + clientIP(r, config.trustedProxies)
+}
+
+// clientIP returns the IP of the client that made a request. Requests from trusted proxies are
+// from the last address in their X-Forwarded-For header that isn't a trusted proxy, since clients
+// can put anything at the start of the header.
+func clientIP(r *http.Request, trustedProxies []netip.Prefix) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !isTrustedProxy(addr, trustedProxies) {
+		return host
+	}
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(forwarded[i]))
+		if err != nil {
+			break
+		}
+		addr = hop
+		if !isTrustedProxy(addr, trustedProxies) {
+			break
+		}
+	}
+	return addr.Unmap().String()
+}
+
+func isTrustedProxy(addr netip.Addr, trustedProxies []netip.Prefix) bool {
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr.Unmap()) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryAfterSeconds returns the Retry-After header for a duration, in whole seconds rounded up.
+func retryAfterSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+`
+
+func generateRateLimit(basePath string) error {
+	rateLimitCode, err := templates.WriteTemplate(rateLimitTemplateStr, rateLimitFileTemplate{
+		ImportStatements: swagger.ImportStatements([]string{
+			"context", "math", "net", "net/http", "net/netip", "strconv", "strings", "sync", "time",
+			"github.com/Clever/kayvee-go/v7/logger",
+		}),
+	})
+	if err != nil {
+		return err
+	}
+	g := swagger.Generator{BasePath: basePath}
+	g.Print(rateLimitCode)
+	return g.WriteFile("server/ratelimit.go")
+}
+
+// hasRateLimits returns true if any operation has an x-rate-limit.
+func hasRateLimits(s *spec.Swagger) bool {
+	for _, pathKey := range swagger.SortedPathItemKeys(s.Paths.Paths) {
+		pathItemOps := swagger.PathItemOperations(s.Paths.Paths[pathKey])
+		for _, opKey := range swagger.SortedOperationsKeys(pathItemOps) {
+			if _, ok := pathItemOps[opKey].Extensions["x-rate-limit"]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkedAfterAuthentication returns true if an operation's rate limit is checked after the
+// request is authenticated, in its handler, rather than in the router. That's the case for limits
+// keyed by caller, so Authenticate can set the caller ID, in operations with security
+// requirements. Other limits are checked first, so requests with bad credentials count too.
+func checkedAfterAuthentication(s *spec.Swagger, op *spec.Operation, rateLimit *routerRateLimit) bool {
+	return rateLimit != nil && rateLimit.Key == "caller" && len(swagger.SecurityRequirements(s, op)) > 0
+}
+
+// hasRateLimitsAfterAuthentication returns true if any operation's rate limit is checked after
+// authentication.
+func hasRateLimitsAfterAuthentication(s *spec.Swagger) (bool, error) {
+	for _, pathKey := range swagger.SortedPathItemKeys(s.Paths.Paths) {
+		pathItemOps := swagger.PathItemOperations(s.Paths.Paths[pathKey])
+		for _, opKey := range swagger.SortedOperationsKeys(pathItemOps) {
+			op := pathItemOps[opKey]
+			rateLimit, err := newRouterRateLimit(s, op)
+			if err != nil {
+				return false, err
+			}
+			if checkedAfterAuthentication(s, op, rateLimit) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// routerRateLimit is the x-rate-limit of an operation in the router.
+type routerRateLimit struct {
+	Requests int
+	Interval string
+	Burst    int
+	Key      string
+	Header   string
+	// TooManyRequests is the body of the 429 response.
+	TooManyRequests string
+}
+
+// newRouterRateLimit returns the x-rate-limit of an operation in the router, or nil if it doesn't
+// have one. The 429 response uses the type the operation defines for it, if any.
+func newRouterRateLimit(s *spec.Swagger, op *spec.Operation) (*routerRateLimit, error) {
+	rateLimit, err := swagger.OperationRateLimit(op)
+	if err != nil {
+		return nil, fmt.Errorf("invalid x-rate-limit for %s: %s", op.ID, err)
+	}
+	if rateLimit == nil {
+		return nil, nil
+	}
+	tooManyRequests := `map[string]string{"message": "rate limit exceeded"}`
+	if typ := swagger.CodeToTypeMap(s, op, false)[429]; typ != "" {
+		tooManyRequests = typ + `{Message: "rate limit exceeded"}`
+	}
+	return &routerRateLimit{
+		Requests:        rateLimit.Requests,
+		Interval:        durationCode(rateLimit.Interval),
+		Burst:           rateLimit.Burst,
+		Key:             rateLimit.Key,
+		Header:          rateLimit.Header,
+		TooManyRequests: tooManyRequests,
+	}, nil
+}
+
+// durationCode returns the Go code for a duration, e.g. "5 * time.Minute".
+func durationCode(d time.Duration) string {
+	for _, unit := range []struct {
+		duration time.Duration
+		name     string
+	}{
+		{time.Hour, "time.Hour"}, {time.Minute, "time.Minute"}, {time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"}, {time.Microsecond, "time.Microsecond"},
+	} {
+		if d%unit.duration == 0 {
+			return fmt.Sprintf("%d * %s", d/unit.duration, unit.name)
+		}
+	}
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
@@ -24,6 +24,19 @@ func Generate(packageName, basePath, outputPath string, s spec.Swagger) error {
 	if err := generateHandlers(packageName, basePath, outputPath, &s, s.Paths); err != nil {
 		return err
 	}
+	if err := generateSpec(basePath, &s); err != nil {
+		return err
+	}
+	if swagger.HasSecurity(&s) {
+		if err := generateAuth(basePath, &s); err != nil {
+			return err
+		}
+	}
+	if hasRateLimits(&s) {
+		if err := generateRateLimit(basePath); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -32,50 +45,94 @@ type routerFunction struct {
 	Path        string
 	HandlerName string
 	OpID        string
+	RateLimit   *routerRateLimit
+	// Limits is the requestLimits of the operation.
+	Limits string
+	// TimeoutStatusCode and TimeoutBody are the response to requests that time out.
+	TimeoutStatusCode string
+	TimeoutBody       string
 }
 
 type routerTemplate struct {
 	ImportStatements string
 	Title            string
 	Functions        []routerFunction
+	SpecPath         string
+	DocsPath         string
+	HasRateLimits    bool
 }
 
 func generateRouter(packageName, basePath, outputPath string, s spec.Swagger, paths *spec.Paths) error {
 	var template routerTemplate
 	template.Title = s.Info.Title
+	template.SpecPath = specPath(&s)
+	template.DocsPath = docsPath(&s)
 	for _, path := range swagger.SortedPathItemKeys(paths.Paths) {
 		pathItem := paths.Paths[path]
 		pathItemOps := swagger.PathItemOperations(pathItem)
 		for _, method := range swagger.SortedOperationsKeys(pathItemOps) {
 			op := pathItemOps[method]
 
-			template.Functions = append(template.Functions, routerFunction{
+			rateLimit, err := newRouterRateLimit(&s, op)
+			if err != nil {
+				return err
+			}
+			if checkedAfterAuthentication(&s, op, rateLimit) {
+				rateLimit = nil
+			}
+			f := routerFunction{
 				Method:      method,
 				Path:        s.BasePath + path,
 				HandlerName: swagger.Capitalize(op.ID),
 				OpID:        op.ID,
-			})
+				RateLimit:   rateLimit,
+			}
+			f.Limits, f.TimeoutStatusCode, f.TimeoutBody, err = routerLimits(&s, op)
+			if err != nil {
+				return err
+			}
+			template.Functions = append(template.Functions, f)
 		}
 	}
-	template.ImportStatements = swagger.ImportStatements([]string{
+	template.HasRateLimits = hasRateLimits(&s)
+	imports := []string{
 		"compress/gzip",
 		"context",
+		"crypto/tls",
+		"net",
+		"encoding/json",
+		"fmt",
 		"log",
 		"net/http",
 		`_ "net/http/pprof"`,
 		"os",
 		"os/signal",
 		"path",
+		"bytes",
+		"sync",
+		"reflect",
+		"runtime/debug",
 		"syscall",
 		"time",
 		"github.com/Clever/go-process-metrics/metrics",
 		packageName + "/servertracing",
 		"github.com/gorilla/handlers",
+		"github.com/go-openapi/strfmt",
 		"github.com/gorilla/mux",
 		"github.com/kardianos/osext",
 		"github.com/Clever/kayvee-go/v7/logger",
 		`kvMiddleware "github.com/Clever/kayvee-go/v7/middleware"`,
-	})
+	}
+	for _, f := range template.Functions {
+		if strings.HasPrefix(f.TimeoutBody, "models.") ||
+			(f.RateLimit != nil && strings.HasPrefix(f.RateLimit.TooManyRequests, "models.")) {
+			outputPath := strings.TrimPrefix(outputPath, ".")
+			moduleName, versionSuffix := utils.ExtractModuleNameAndVersionSuffix(packageName, outputPath)
+			imports = append(imports, moduleName+outputPath+"/models"+versionSuffix)
+			break
+		}
+	}
+	template.ImportStatements = swagger.ImportStatements(imports)
 	routerCode, err := templates.WriteTemplate(routerTemplateStr, template)
 	if err != nil {
 		return err
@@ -93,6 +150,7 @@ type interfaceTemplate struct {
 type interfaceFileTemplate struct {
 	ImportStatements string
 	ServiceName      string
+	HasSecurity      bool
 	Interfaces       []interfaceTemplate
 	ModuleName       string
 	OutputPath       string
@@ -107,8 +165,24 @@ package server
 //go:generate mockgen -source=$GOFILE -destination=mock_controller.go -package server --build_flags=--mod=mod -imports=models={{.ModuleName}}{{.OutputPath}}/models{{.VersionSuffix}}
 
 
+{{if .HasSecurity}}
+// Authenticator authenticates requests to operations with security requirements.
+type Authenticator interface {
+	// Authenticate checks the credentials a request presented for one of the operation's
+	// security schemes. scopes are the scopes the operation requires for that scheme. It's called
+	// before the controller method, once per scheme, and the returned context is passed on to the
+	// controller method, so it can carry the authenticated caller.
+	// Errors that match one of the operation's responses are returned with that status code,
+	// all other errors are returned as a 401.
+	Authenticate(ctx context.Context, op string, creds Credentials, scopes []string) (context.Context, error)
+}
+{{end}}
+
 // Controller defines the interface for the {{.ServiceName}} service.
 type Controller interface {
+	{{if .HasSecurity}}
+	Authenticator
+	{{end}}
 
 	{{range $interface := .Interfaces}}
 		{{$interface.Comment}}
@@ -124,6 +198,7 @@ func generateInterface(packageName, basePath, outputPath string, s *spec.Swagger
 	tmpl := interfaceFileTemplate{
 		ImportStatements: swagger.ImportStatements([]string{"context", moduleName + outputPath + "/models" + versionSuffix}),
 		ServiceName:      serviceName,
+		HasSecurity:      swagger.HasSecurity(s),
 		ModuleName:       moduleName,
 		OutputPath:       outputPath,
 		VersionSuffix:    versionSuffix,
@@ -162,6 +237,11 @@ type handlerFileTemplate struct {
 	// TODO: Think about possibly factoring this out...
 	BaseStringToTypeCode string
 	Handlers             []string
+	HasPolymorphism      bool
+	ProblemDetails       bool
+	// ValidationErrors is true if the handlers report the parameters and fields of a request that
+	// couldn't be read or failed validation, for problem details or the errors field of a 400.
+	ValidationErrors bool
 }
 
 var handlerFileTemplateStr = `
@@ -176,6 +256,9 @@ var _ = errors.New
 var _ = mux.Vars
 var _ = bytes.Compare
 var _ = ioutil.ReadAll
+{{- if .HasPolymorphism}}
+var _ = runtime.JSONConsumer
+{{- end}}
 
 {{.BaseStringToTypeCode}}
 
@@ -188,6 +271,88 @@ func jsonMarshalNoError(i interface{}) string {
 	return string(bytes)
 }
 
+{{- if .ProblemDetails}}
+
+// writeError writes an RFC 7807 problem details response for an error. The fields of the error
+// model are kept alongside the problem details, and its message is the detail.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}, params ...models.InvalidParam) {
+	var problem map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonMarshalNoError(body)), &problem); err != nil || problem == nil {
+		problem = map[string]interface{}{}
+	}
+	if _, ok := problem["type"]; !ok {
+		problem["type"] = "about:blank"
+	}
+	problem["title"] = http.StatusText(statusCode)
+	problem["status"] = statusCode
+	if message, ok := problem["message"].(string); ok {
+		problem["detail"] = message
+	}
+	problem["instance"] = r.URL.Path
+	if len(params) > 0 {
+		problem["invalid-params"] = params
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(statusCode)
+	fmt.Fprintln(w, jsonMarshalNoError(problem))
+}
+
+// invalidParams returns the invalid parameters of a request from the error reading or validating
+// its input.
+func invalidParams(err error) []models.InvalidParam {
+	var params []models.InvalidParam
+	for _, e := range validationErrors(err) {
+		params = append(params, models.InvalidParam{Name: e.path, Reason: e.message})
+	}
+	return params
+}
+{{- else}}
+
+// writeError writes the error model for a status code.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, body interface{}) {
+	http.Error(w, jsonMarshalNoError(body), statusCode)
+}
+{{- end}}
+{{- if .ValidationErrors}}
+
+// invalidParamError is an error reading a parameter from a request.
+type invalidParamError struct {
+	name string
+	code int32
+	err  error
+}
+
+func (e invalidParamError) Error() string {
+	return e.err.Error()
+}
+
+// validationError is a parameter or field of a request that couldn't be read or failed
+// validation. code is one of the codes of github.com/go-openapi/errors.
+type validationError struct {
+	path    string
+	code    int32
+	message string
+}
+
+// validationErrors returns the parameters and fields of a request that couldn't be read or
+// failed validation from the error reading or validating its input.
+func validationErrors(err error) []validationError {
+	var errs []validationError
+	switch err := err.(type) {
+	case invalidParamError:
+		errs = append(errs, validationError{path: err.name, code: err.code, message: err.Error()})
+	case *openapierrors.Validation:
+		errs = append(errs, validationError{path: err.Name, code: err.Code(), message: err.Error()})
+	case *openapierrors.CompositeError:
+		for _, err := range err.Errors {
+			errs = append(errs, validationErrors(err)...)
+		}
+	}
+	return errs
+}
+{{- end}}
+
 {{ range $handler := .Handlers }}
 	{{ $handler }}
 {{end}}
@@ -196,15 +361,39 @@ func jsonMarshalNoError(i interface{}) string {
 func generateHandlers(packageName, basePath, outputPath string, s *spec.Swagger, paths *spec.Paths) error {
 	outputPath = strings.TrimPrefix(outputPath, ".")
 	moduleName, versionSuffix := utils.ExtractModuleNameAndVersionSuffix(packageName, outputPath)
+	imports := []string{
+		"context", "github.com/gorilla/mux",
+		"github.com/Clever/kayvee-go/v7/logger",
+		"net/http", "strconv", "encoding/json", "strconv", "fmt", moduleName + outputPath + "/models" + versionSuffix,
+		"github.com/go-openapi/strfmt", "github.com/go-openapi/swag", "io/ioutil", "bytes",
+		"github.com/go-errors/errors", "golang.org/x/xerrors",
+	}
+	hasPolymorphism := swagger.HasPolymorphicDefinitions(s)
+	if hasPolymorphism {
+		// polymorphic request bodies are decoded with the functions go-swagger generates
+		imports = append(imports, "github.com/go-openapi/runtime")
+	}
+	hasFieldErrors, err := swagger.HasFieldErrors(s)
+	if err != nil {
+		return err
+	}
+	validationErrors := swagger.ProblemDetails(s) || hasFieldErrors
+	if validationErrors {
+		imports = append(imports, `openapierrors "github.com/go-openapi/errors"`)
+	}
+	rateLimitsAfterAuthentication, err := hasRateLimitsAfterAuthentication(s)
+	if err != nil {
+		return err
+	}
+	if rateLimitsAfterAuthentication {
+		imports = append(imports, "time")
+	}
 	tmpl := handlerFileTemplate{
-		ImportStatements: swagger.ImportStatements([]string{
-			"context", "github.com/gorilla/mux",
-			"github.com/Clever/kayvee-go/v7/logger",
-			"net/http", "strconv", "encoding/json", "strconv", "fmt", moduleName + outputPath + "/models" + versionSuffix,
-			"github.com/go-openapi/strfmt", "github.com/go-openapi/swag", "io/ioutil", "bytes",
-			"github.com/go-errors/errors", "golang.org/x/xerrors",
-		}),
+		ImportStatements:     swagger.ImportStatements(imports),
 		BaseStringToTypeCode: swagger.BaseStringToTypeCode(),
+		HasPolymorphism:      hasPolymorphism,
+		ProblemDetails:       swagger.ProblemDetails(s),
+		ValidationErrors:     validationErrors,
 	}
 
 	for _, pathKey := range swagger.SortedPathItemKeys(paths.Paths) {
@@ -268,8 +457,34 @@ func generateOperationHandler(s *spec.Swagger, op *spec.Operation) (string, erro
 		inputVarName = singleStringPathParameterVarName
 	}
 
+	securityRequirements := ""
+	if len(swagger.SecurityRequirements(s, op)) > 0 {
+		securityRequirements = swagger.SecurityRequirementsCode(s, op)
+	}
+
+	var fileParamFields []string
+	for _, param := range op.Parameters {
+		if param.Type == "file" {
+			fileParamFields = append(fileParamFields, swagger.StructParamName(param))
+		}
+	}
+
+	rateLimit, err := newRouterRateLimit(s, op)
+	if err != nil {
+		return "", err
+	}
+	fieldErrors, err := swagger.BadRequestFieldErrors(s, op)
+	if err != nil {
+		return "", err
+	}
+	if !checkedAfterAuthentication(s, op, rateLimit) {
+		rateLimit = nil
+	}
+
 	handlerOp := handlerOp{
 		Op:                               swagger.Capitalize(op.ID),
+		OpID:                             op.ID,
+		SecurityRequirements:             securityRequirements,
 		SuccessReturnType:                successType != nil,
 		ArraySuccessType:                 arraySuccessType,
 		HasParams:                        len(op.Parameters) != 0,
@@ -283,13 +498,37 @@ func generateOperationHandler(s *spec.Swagger, op *spec.Operation) (string, erro
 		SingleStringPathParameter:        singleStringPathParameter,
 		SingleStringPathParameterVarName: singleStringPathParameterVarName,
 		StatusCodeToType:                 codeToType,
+		FileParamFields:                  fileParamFields,
+		ProblemDetails:                   swagger.ProblemDetails(s),
+		FieldErrors:                      fieldErrors,
+		TooLarge:                         requestTooLarge(s, op),
+		RateLimit:                        rateLimit,
+	}
+	if swagger.HasMultipleSuccessResponses(op) {
+		for _, r := range swagger.SuccessResponses(s, op) {
+			handlerOp.SuccessResponses = append(handlerOp.SuccessResponses, successResponse{
+				SuccessResponse: r,
+				IsArray:         strings.HasPrefix(r.Type, "[]"),
+			})
+		}
+	} else if swagger.HasOutputType(op) {
+		r := swagger.SuccessResponses(s, op)[0]
+		handlerOp.Output = &successResponse{
+			SuccessResponse: r,
+			IsArray:         strings.HasPrefix(r.Type, "[]"),
+		}
+		handlerOp.OutputTypeName = swagger.OutputTypeName(op)
+	}
+	handlerOp.ResponseHeaders, err = swagger.ResponseHeaders(op)
+	if err != nil {
+		return "", err
 	}
 	handlerCode, err := templates.WriteTemplate(handlerTemplate, handlerOp)
 	if err != nil {
 		return "", err
 	}
 
-	newInputCode, err := generateNewInput(op, s.Definitions)
+	newInputCode, err := generateNewInput(s, op)
 	if err != nil {
 		return "", err
 	}
@@ -303,7 +542,11 @@ func generateOperationHandler(s *spec.Swagger, op *spec.Operation) (string, erro
 
 // handlerOp contains the template variables for the handlerTemplate
 type handlerOp struct {
-	Op                               string
+	Op                   string
+	OpID                 string
+	SecurityRequirements string
+	// RateLimit is the x-rate-limit of the operation if it's checked after authentication.
+	RateLimit                        *routerRateLimit
 	SuccessReturnType                bool
 	ArraySuccessType                 string
 	HasParams                        bool
@@ -317,6 +560,21 @@ type handlerOp struct {
 	SingleStringPathParameter        bool
 	SingleStringPathParameterVarName string
 	StatusCodeToType                 map[int]string
+	FileParamFields                  []string
+	SuccessResponses                 []successResponse
+	Output                           *successResponse
+	OutputTypeName                   string
+	ResponseHeaders                  []swagger.ResponseHeader
+	ProblemDetails                   bool
+	FieldErrors                      *swagger.FieldErrors
+	// TooLarge is the body of the 413 response, if the handler reads the request body.
+	TooLarge string
+}
+
+// successResponse is one of the success responses of an operation with more than one.
+type successResponse struct {
+	swagger.SuccessResponse
+	IsArray bool
 }
 
 var handlerTemplate = `
@@ -334,14 +592,52 @@ func statusCodeFor{{.Op}}(obj interface{}) int {
 	}
 }
 
+{{if .SecurityRequirements}}
+// securityFor{{.Op}} are the security requirements of {{.OpID}}.
+var securityFor{{.Op}} = {{.SecurityRequirements}}
+{{end}}
+
 func (h handler) {{.Op}}Handler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+{{if .SecurityRequirements}}
+	ctx, authErr := authenticate(ctx, h.Controller, r, "{{.OpID}}", securityFor{{.Op}})
+	if authErr != nil {
+		logger.FromContext(ctx).AddContext("error", authErr.Error())
+		statusCode := statusCodeFor{{.Op}}(authErr)
+		if statusCode == -1 {
+			writeError(w, r, http.StatusUnauthorized, unauthorized{Message: authErr.Error()})
+			return
+		}
+		writeError(w, r, statusCode, authErr)
+		return
+	}
+{{end}}
+{{- with .RateLimit}}
+	limit := RateLimit{Requests: {{.Requests}}, Interval: {{.Interval}}, Burst: {{.Burst}}}
+	if ok, retryAfter := takeRateLimit(ctx, "{{$.OpID}}", limit, rateLimitKey(ctx, r, "{{.Key}}", "{{.Header}}")); !ok {
+		w.Header().Set("Retry-After", retryAfterSeconds(retryAfter))
+		writeError(w, r, http.StatusTooManyRequests, {{.TooManyRequests}})
+		return
+	}
+{{- end}}
 {{if .HasParams}}
 	{{.InputVarName}}, err := new{{.Op}}Input(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError({{index .StatusCodeToType 400}}{Message: err.Error()}), http.StatusBadRequest)
+		{{- if .TooLarge}}
+		var tooLarge *http.MaxBytesError
+		if xerrors.As(err, &tooLarge) {
+			writeError(w, r, http.StatusRequestEntityTooLarge, {{.TooLarge}})
+			return
+		}
+		{{- end}}
+		{{- template "badRequest" .}}
 		return
 	}
+	{{- range .FileParamFields}}
+	if {{$.InputVarName}}.{{.}} != nil {
+		defer {{$.InputVarName}}.{{.}}.Close()
+	}
+	{{- end}}
 
 	{{if .SingleStringPathParameter}}
 		err = models.Validate{{.Op}}Input({{.SingleStringPathParameterVarName}})
@@ -356,7 +652,7 @@ func (h handler) {{.Op}}Handler(ctx context.Context, w http.ResponseWriter, r *h
 	{{end}}
 		if err != nil {
 			logger.FromContext(ctx).AddContext("error", err.Error())
-			http.Error(w, jsonMarshalNoError({{index .StatusCodeToType 400}}{Message: err.Error()}), http.StatusBadRequest)
+			{{- template "badRequest" .}}
 			return
 		}
 {{end}}
@@ -392,15 +688,82 @@ func (h handler) {{.Op}}Handler(ctx context.Context, w http.ResponseWriter, r *h
 			err = {{index .StatusCodeToType 500}}{Message: err.Error()}
 			statusCode = 500
 		}
-		http.Error(w, jsonMarshalNoError(err), statusCode)
+		writeError(w, r, statusCode, err)
 		return
 	}
 
-{{if .SuccessReturnType}}
-	respBytes, err := json.Marshal(resp)
+{{if .SuccessResponses}}
+	if resp == nil {
+		err = fmt.Errorf("{{.OpID}} returned a nil response")
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, {{index .StatusCodeToType 500}}{Message: err.Error()})
+		return
+	}
+
+	var respBytes []byte
+	switch resp.StatusCode {
+	{{- range .SuccessResponses}}
+	case {{.StatusCode}}:
+		{{- if .IsArray}}
+		// Success types that return an array should never return nil so let's make this easier
+		// for consumers by converting nil arrays to empty arrays
+		if resp.{{.Field}} == nil {
+			resp.{{.Field}} = {{.Type}}{}
+		}
+		{{- end}}
+		{{- if .Type}}
+		respBytes, err = marshalResponse(ctx, "{{$.OpID}}", resp.{{.Field}})
+		{{- end}}
+	{{- end}}
+	default:
+		err = fmt.Errorf("{{.OpID}} returned a response with unexpected status code %d", resp.StatusCode)
+	}
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError({{index .StatusCodeToType 500}}{Message: err.Error()}), http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, {{index .StatusCodeToType 500}}{Message: err.Error()})
+		return
+	}
+
+	{{- template "responseHeaders" .}}
+	if len(respBytes) > 0 {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(resp.StatusCode)
+	w.Write(respBytes)
+{{else if .Output}}
+	if resp == nil {
+		resp = &models.{{.OutputTypeName}}{}
+	}
+	{{- if .Output.IsArray}}
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp.Body == nil {
+		resp.Body = {{.Output.Type}}{}
+	}
+	{{- end}}
+	{{- if .Output.Type}}
+
+	respBytes, err := marshalResponse(ctx, "{{.OpID}}", resp.Body)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, {{index .StatusCodeToType 500}}{Message: err.Error()})
+		return
+	}
+	{{- end}}
+	{{template "responseHeaders" .}}
+	{{- if .Output.Type}}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader({{.Output.StatusCode}})
+	w.Write(respBytes)
+	{{- else}}
+	w.WriteHeader({{.Output.StatusCode}})
+	w.Write([]byte(""))
+	{{- end}}
+{{else if .SuccessReturnType}}
+	respBytes, err := marshalResponse(ctx, "{{.OpID}}", resp)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		writeError(w, r, http.StatusInternalServerError, {{index .StatusCodeToType 500}}{Message: err.Error()})
 		return
 	}
 
@@ -410,7 +773,7 @@ func (h handler) {{.Op}}Handler(ctx context.Context, w http.ResponseWriter, r *h
 			path, err := {{.InputVarName}}.Path()
 			if err != nil {
 				logger.FromContext(ctx).AddContext("error", err.Error())
-				http.Error(w, jsonMarshalNoError({{index .StatusCodeToType 500}}{Message: err.Error()}), http.StatusInternalServerError)
+				writeError(w, r, http.StatusInternalServerError, {{index .StatusCodeToType 500}}{Message: err.Error()})
 				return
 			}
 			w.Header().Set("X-Next-Page-Path", path)
@@ -425,6 +788,30 @@ func (h handler) {{.Op}}Handler(ctx context.Context, w http.ResponseWriter, r *h
 	w.Write([]byte(""))
 {{end}}
 }
+
+{{- define "badRequest"}}
+	{{- if .FieldErrors}}
+		badRequest := {{index .StatusCodeToType 400}}{Message: err.Error()}
+		for _, e := range validationErrors(err) {
+			badRequest.Errors = append(badRequest.Errors, &{{.FieldErrors.ItemType}}{Path: e.path, Code: {{.FieldErrors.CodeType}}(e.code), Message: e.message})
+		}
+		writeError(w, r, http.StatusBadRequest, badRequest{{if .ProblemDetails}}, invalidParams(err)...{{end}})
+	{{- else}}
+		writeError(w, r, http.StatusBadRequest, {{index .StatusCodeToType 400}}{Message: err.Error()}{{if .ProblemDetails}}, invalidParams(err)...{{end}})
+	{{- end}}
+{{- end}}
+
+{{- define "responseHeaders"}}
+	{{- range .ResponseHeaders}}
+	{{- if .Pointer}}
+	if resp.{{.Field}} != nil {
+	{{- else}}
+	if resp.{{.Field}} != "" {
+	{{- end}}
+		w.Header().Set("{{.Name}}", {{.ToStringCode}})
+	}
+	{{- end}}
+{{- end}}
 `
 
 type singleStringPathParameterTemplateData struct {
@@ -445,7 +832,7 @@ func new{{.Op}}Input(r *http.Request) (string, error) {
 }
 `
 
-func generateNewInput(op *spec.Operation, definitions map[string]spec.Schema) (string, error) {
+func generateNewInput(s *spec.Swagger, op *spec.Operation) (string, error) {
 	capOpID := swagger.Capitalize(op.ID)
 
 	singleStringPathParameter, paramVarName := swagger.SingleStringPathParameter(op)
@@ -461,7 +848,10 @@ func generateNewInput(op *spec.Operation, definitions map[string]spec.Schema) (s
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("// new%sInput takes in an http.Request an returns the input struct.\n", capOpID))
 	singleSchemaedBodyParameter, opModel := swagger.SingleSchemaedBodyParameter(op)
-	if singleSchemaedBodyParameter {
+	if singleSchemaedBodyParameter && swagger.IsPolymorphic(s, op.Parameters[0].Schema) {
+		buf.WriteString(fmt.Sprintf("func new%sInput(r *http.Request) (models.%s, error) {\n",
+			capOpID, opModel))
+	} else if singleSchemaedBodyParameter {
 		buf.WriteString(fmt.Sprintf("func new%sInput(r *http.Request) (*models.%s, error) {\n",
 			capOpID, opModel))
 	} else {
@@ -472,6 +862,18 @@ func generateNewInput(op *spec.Operation, definitions map[string]spec.Schema) (s
 
 	buf.WriteString(fmt.Sprintf("\tvar err error\n"))
 	buf.WriteString(fmt.Sprintf("\t_ = err\n"))
+	hasFieldErrors, err := swagger.HasFieldErrors(s)
+	if err != nil {
+		return "", err
+	}
+	validationErrors := swagger.ProblemDetails(s) || hasFieldErrors
+
+	if swagger.HasFormDataParams(op) {
+		// Files larger than 32MB are spooled to disk; net/http removes them when the handler returns
+		buf.WriteString("\tif err := r.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {\n")
+		buf.WriteString("\t\treturn nil, err\n")
+		buf.WriteString("\t}\n")
+	}
 
 	for _, param := range op.Parameters {
 
@@ -484,9 +886,45 @@ func generateNewInput(op *spec.Operation, definitions map[string]spec.Schema) (s
 		}
 
 		if param.In != "body" {
-			if param.Type == "array" && param.In == "query" {
+			if param.Type == "array" && param.In == "query" && param.Items.Type == "string" &&
+				param.Items.Format == "" && swagger.CollectionFormatSeparator(param) == "" {
 				buf.WriteString(fmt.Sprintf("\tif %s, ok := r.URL.Query()[\"%s\"]; ok {\n\t\tinput.%s = %s\n\t}\n",
 					paramVarName, param.Name, structFieldName, paramVarName))
+			} else if param.Type == "array" && param.In == "query" {
+				itemTypeCode, err := swagger.StringToTypeCode(fmt.Sprintf("%sStr", paramVarName),
+					swagger.ArrayItemParam(param), op)
+				if err != nil {
+					return "", err
+				}
+				str, err := templates.WriteTemplate(arrayQueryParamTemplateStr, arrayQueryParamTemplate{
+					VarName:          paramVarName,
+					ParamName:        param.Name,
+					CapParamName:     structFieldName,
+					CollectionFormat: param.CollectionFormat,
+					Split:            swagger.CollectionFormatSeparator(param) != "",
+					StringItems:      param.Items.Type == "string" && param.Items.Format == "",
+					TypeCode:         itemTypeCode,
+					ValidationErrors: validationErrors,
+				})
+				if err != nil {
+					return "", err
+				}
+				buf.WriteString(str)
+			} else if param.Type == "array" && param.In == "formData" {
+				buf.WriteString(fmt.Sprintf("\tif %s, ok := r.PostForm[\"%s\"]; ok {\n\t\tinput.%s = %s\n\t}\n",
+					paramVarName, param.Name, structFieldName, paramVarName))
+			} else if param.Type == "file" {
+				str, err := templates.WriteTemplate(fileParamTemplateStr, paramTemplate{
+					Required:         param.Required,
+					VarName:          paramVarName,
+					ParamName:        param.Name,
+					CapParamName:     structFieldName,
+					ValidationErrors: validationErrors,
+				})
+				if err != nil {
+					return "", err
+				}
+				buf.WriteString(str)
 			} else {
 				typeCode, err := swagger.StringToTypeCode(fmt.Sprintf("%sStr", paramVarName), param, op)
 				if err != nil {
@@ -497,15 +935,16 @@ func generateNewInput(op *spec.Operation, definitions map[string]spec.Schema) (s
 					defaultVal = swagger.DefaultAsString(param)
 				}
 				str, err := templates.WriteTemplate(paramTemplateStr, paramTemplate{
-					Required:        param.Required,
-					ParamType:       param.In,
-					VarName:         paramVarName,
-					ParamName:       param.Name,
-					CapParamName:    structFieldName,
-					TypeName:        typeName,
-					TypeCode:        typeCode,
-					DefaultValue:    defaultVal,
-					PointerInStruct: pointer,
+					Required:         param.Required,
+					ParamType:        param.In,
+					VarName:          paramVarName,
+					ParamName:        param.Name,
+					CapParamName:     structFieldName,
+					TypeName:         typeName,
+					TypeCode:         typeCode,
+					DefaultValue:     defaultVal,
+					PointerInStruct:  pointer,
+					ValidationErrors: validationErrors,
 				})
 				if err != nil {
 					return "", err
@@ -524,7 +963,8 @@ func generateNewInput(op *spec.Operation, definitions map[string]spec.Schema) (s
 				Required:   param.Required,
 				ParamField: paramField,
 				TypeName:   typeName,
-				IsBinary:   clients.IsBinaryParam(param, definitions),
+				IsBinary:   clients.IsBinaryParam(param, s.Definitions),
+				Unmarshal:  swagger.UnmarshalFunc(s, param.Schema),
 			})
 			if err != nil {
 				return "", err
@@ -549,36 +989,55 @@ func capitalize(str string) string {
 }
 
 type paramTemplate struct {
-	Required        bool
-	ParamType       string
-	VarName         string
-	ParamName       string
-	CapParamName    string
-	TypeName        string
-	TypeCode        string
-	DefaultValue    string
-	PointerInStruct bool
-}
-
-var paramTemplateStr = `
+	Required         bool
+	ParamType        string
+	VarName          string
+	ParamName        string
+	CapParamName     string
+	TypeName         string
+	TypeCode         string
+	DefaultValue     string
+	PointerInStruct  bool
+	ValidationErrors bool
+}
+
+// paramErrorsTemplateStr starts the parameter templates. It sets $invalid, $missing and $end,
+// which wrap the errors the templates return in an invalidParamError when the handlers report
+// validation errors, so the errors name the invalid parameter.
+var paramErrorsTemplateStr = `
+{{- $invalid := ""}}{{$missing := ""}}{{$end := ""}}
+{{- if .ValidationErrors}}
+	{{- $invalid = printf "invalidParamError{name: %q, code: openapierrors.InvalidTypeCode, err: " .ParamName}}
+	{{- $missing = printf "invalidParamError{name: %q, code: openapierrors.RequiredFailCode, err: " .ParamName}}
+	{{- $end = "}"}}
+{{- end}}`
+
+var paramTemplateStr = paramErrorsTemplateStr + `
 	{{if eq .ParamType "query" -}}
 		{{.VarName}}Strs := r.URL.Query()["{{.ParamName}}"]
 		{{if .Required -}}
 			if len({{.VarName}}Strs) == 0 {
-				return nil, errors.New("query parameter '{{.ParamName}}' must be specified")
+				return nil, {{$missing}}errors.New("query parameter '{{.ParamName}}' must be specified"){{$end}}
 			}
 		{{- end -}}
 	{{- else if eq .ParamType "path" -}}
 		{{.VarName}}Str := mux.Vars(r)["{{.ParamName}}"]
 		if len({{.VarName}}Str) == 0 {
-			return nil, errors.New("path parameter '{{.ParamName}}' must be specified")
+			return nil, {{$missing}}errors.New("path parameter '{{.ParamName}}' must be specified"){{$end}}
 		}
 		{{.VarName}}Strs := []string{ {{.VarName}}Str }
+	{{- else if eq .ParamType "formData" -}}
+		{{.VarName}}Strs := r.PostForm["{{.ParamName}}"]
+		{{if .Required -}}
+			if len({{.VarName}}Strs) == 0 {
+				return nil, {{$missing}}errors.New("form parameter '{{.ParamName}}' must be specified"){{$end}}
+			}
+		{{- end -}}
 	{{- else if eq .ParamType "header" -}}
 		{{.VarName}}Strs := r.Header.Get("{{.ParamName}}")
 		{{if .Required -}}
 			if len({{.VarName}}Strs) == 0 {
-				return nil, errors.New("request header '{{.ParamName}}' must be specified")
+				return nil, {{$missing}}errors.New("request header '{{.ParamName}}' must be specified"){{$end}}
 			}
 		{{- end -}}
 	{{- end}}
@@ -595,7 +1054,7 @@ var paramTemplateStr = `
 			{{.VarName}}Str := {{.VarName}}Strs[0]
 			{{.VarName}}Tmp, err = {{.TypeCode}}
 			if err != nil {
-				return nil, err
+				return nil, {{$invalid}}err{{$end}}
 			}
 		{{- end}}
 		{{if .PointerInStruct -}}
@@ -606,21 +1065,79 @@ var paramTemplateStr = `
 	}
 `
 
+type arrayQueryParamTemplate struct {
+	VarName          string
+	ParamName        string
+	CapParamName     string
+	CollectionFormat string
+	Split            bool
+	StringItems      bool
+	TypeCode         string
+	ValidationErrors bool
+}
+
+// arrayQueryParamTemplateStr reads an array query parameter whose items are joined by a
+// separator or need to be converted from strings.
+var arrayQueryParamTemplateStr = paramErrorsTemplateStr + `
+	if {{.VarName}}Strs, ok := r.URL.Query()["{{.ParamName}}"]; ok {
+		{{- if .Split}}
+		{{.VarName}}Strs = swag.SplitByFormat({{.VarName}}Strs[0], "{{.CollectionFormat}}")
+		{{- end}}
+		{{- if .StringItems}}
+		input.{{.CapParamName}} = {{.VarName}}Strs
+		{{- else}}
+		for _, {{.VarName}}Str := range {{.VarName}}Strs {
+			{{.VarName}}Item, err := {{.TypeCode}}
+			if err != nil {
+				return nil, {{$invalid}}err{{$end}}
+			}
+			input.{{.CapParamName}} = append(input.{{.CapParamName}}, {{.VarName}}Item)
+		}
+		{{- end}}
+	}
+`
+
+// fileParamTemplateStr reads a file parameter from a multipart form. The handler closes the file
+// after the controller returns.
+var fileParamTemplateStr = paramErrorsTemplateStr + `
+	{{.VarName}}File, _, err := r.FormFile("{{.ParamName}}")
+	if err != nil && err != http.ErrMissingFile && err != http.ErrNotMultipart {
+		return nil, {{$invalid}}err{{$end}}
+	}
+	{{if .Required -}}
+	if {{.VarName}}File == nil {
+		return nil, {{$missing}}errors.New("form file '{{.ParamName}}' must be specified"){{$end}}
+	}
+	{{end -}}
+	input.{{.CapParamName}} = {{.VarName}}File
+`
+
 type bodyParamTemplate struct {
 	Required   bool
 	IsBinary   bool
 	ParamField string
 	TypeName   string
+	// Unmarshal is the function that decodes polymorphic types, e.g. UnmarshalEvent.
+	Unmarshal string
 }
 
 var bodyParamTemplateStr = `
 	{{ if not .IsBinary }}
 	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
 	{{if .Required}} if len(data) == 0 {
 		return nil, errors.New("request body is required, but was empty")
 	}{{end}}
 	if len(data) > 0 {
-		{{- if eq (len .ParamField) 0}}
+		{{- if and .Unmarshal (eq (len .ParamField) 0)}}
+			return models.{{.Unmarshal}}(bytes.NewReader(data), runtime.JSONConsumer())
+		{{- else if .Unmarshal}}
+			if input.{{.ParamField}}, err = models.{{.Unmarshal}}(bytes.NewReader(data), runtime.JSONConsumer()); err != nil {
+				return nil, err
+			}
+		{{- else if eq (len .ParamField) 0}}
 			var input models.{{.TypeName}}
 			if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
 				return nil, err
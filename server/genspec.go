@@ -0,0 +1,160 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/go-openapi/spec"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/Clever/wag/v9/swagger"
+	"github.com/Clever/wag/v9/templates"
+)
+
+// specPath returns the path the spec is served at, without the extension, e.g. "/v1/swagger".
+func specPath(s *spec.Swagger) string {
+	return path.Join("/", s.BasePath, "swagger")
+}
+
+// docsPath returns the path the HTML docs are served at, e.g. "/v1/docs".
+func docsPath(s *spec.Swagger) string {
+	return path.Join("/", s.BasePath, "docs")
+}
+
+// generateSpec writes the spec as JSON and YAML, and HTML docs for it, to the server package,
+// along with the code that embeds them and serves them when the ServeSpec option is set.
+func generateSpec(basePath string, s *spec.Swagger) error {
+	specJSON, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding the spec: %s", err)
+	}
+	specYAML, err := jsonToYAML(specJSON)
+	if err != nil {
+		return fmt.Errorf("error encoding the spec: %s", err)
+	}
+	docs, err := generateDocs(s)
+	if err != nil {
+		return fmt.Errorf("error generating docs: %s", err)
+	}
+
+	for file, contents := range map[string][]byte{
+		"server/swagger.json": append(specJSON, '\n'),
+		"server/swagger.yml":  specYAML,
+		"server/docs.html":    docs,
+	} {
+		g := swagger.Generator{BasePath: basePath}
+		g.Write(contents)
+		if err := g.WriteFile(file); err != nil {
+			return err
+		}
+	}
+
+	specCode, err := templates.WriteTemplate(specTemplateStr, struct {
+		SpecPath string
+		DocsPath string
+	}{specPath(s), docsPath(s)})
+	if err != nil {
+		return err
+	}
+	g := swagger.Generator{BasePath: basePath}
+	g.Print(specCode)
+	return g.WriteFile("server/spec.go")
+}
+
+var specTemplateStr = `
+package server
+
+// Code auto-generated. Do not edit.
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// specJSON is the spec of the service, with the references to other files resolved.
+//
+//go:embed swagger.json
+var specJSON []byte
+
+// specYAML is specJSON as YAML.
+//
+//go:embed swagger.yml
+var specYAML []byte
+
+// docsHTML documents the operations and models of the service.
+//
+//go:embed docs.html
+var docsHTML []byte
+
+// handleSpec adds the routes that serve the spec and its docs to a router.
+func handleSpec(router *mux.Router) {
+	router.Methods("GET").Path("{{.SpecPath}}.json").HandlerFunc(serveEmbedded("application/json", specJSON))
+	router.Methods("GET").Path("{{.SpecPath}}.yml").HandlerFunc(serveEmbedded("application/yaml", specYAML))
+	router.Methods("GET").Path("{{.DocsPath}}").HandlerFunc(serveEmbedded("text/html; charset=utf-8", docsHTML))
+}
+
+func serveEmbedded(contentType string, content []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Write(content)
+	}
+}
+`
+
+// jsonToYAML converts a JSON document to YAML, keeping the order of the keys of its objects.
+func jsonToYAML(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	value, err := decodeOrdered(dec)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(value)
+}
+
+// decodeOrdered decodes the next JSON value from dec, with objects as yaml.MapSlices.
+func decodeOrdered(dec *json.Decoder) (interface{}, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := token.(type) {
+	case json.Delim:
+		if t == '{' {
+			object := yaml.MapSlice{}
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := decodeOrdered(dec)
+				if err != nil {
+					return nil, err
+				}
+				object = append(object, yaml.MapItem{Key: key, Value: value})
+			}
+			_, err := dec.Token()
+			return object, err
+		}
+		array := []interface{}{}
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err := dec.Token()
+		return array, err
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i, nil
+		}
+		return t.Float64()
+	}
+	return token, nil
+}
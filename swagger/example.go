@@ -0,0 +1,176 @@
+package swagger
+
+import (
+	"math"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// Example returns an example of a value of a schema. It uses the example, x-example or default
+// of the schema or of the definitions it refers to when they have one, and otherwise builds a
+// value that matches the type, format, enum and bounds of the schema.
+func Example(s *spec.Swagger, schema spec.Schema) interface{} {
+	e := exampler{s: s}
+	return e.value(schema)
+}
+
+// exampler builds examples of the values of schemas.
+type exampler struct {
+	s *spec.Swagger
+	// resolving are the definitions being built, used to stop at recursive definitions.
+	resolving []string
+}
+
+func (e *exampler) value(schema spec.Schema) interface{} {
+	if schema.Example != nil {
+		return schema.Example
+	}
+	if example, ok := schema.Extensions["x-example"]; ok {
+		return example
+	}
+	if schema.Default != nil {
+		return schema.Default
+	}
+	if schema.Ref.String() != "" {
+		return e.definition(strings.TrimPrefix(schema.Ref.String(), "#/definitions/"), true)
+	}
+	if len(schema.Enum) > 0 {
+		return schema.Enum[0]
+	}
+	if len(schema.AllOf) > 0 {
+		object := map[string]interface{}{}
+		for _, sub := range schema.AllOf {
+			var value interface{}
+			if sub.Ref.String() != "" {
+				// the variants of polymorphic definitions include the fields of the definition
+				value = e.definition(strings.TrimPrefix(sub.Ref.String(), "#/definitions/"), false)
+			} else {
+				value = e.value(sub)
+			}
+			if fields, ok := value.(map[string]interface{}); ok {
+				for key, field := range fields {
+					object[key] = field
+				}
+			}
+		}
+		return object
+	}
+
+	typeName := ""
+	if len(schema.Type) > 0 {
+		typeName = schema.Type[0]
+	}
+	switch typeName {
+	case "array":
+		array := []interface{}{}
+		if schema.Items != nil && schema.Items.Schema != nil {
+			array = append(array, e.value(*schema.Items.Schema))
+			for schema.MinItems != nil && int64(len(array)) < *schema.MinItems {
+				array = append(array, array[0])
+			}
+		}
+		return array
+	case "string":
+		return exampleString(schema)
+	case "integer", "number":
+		return exampleNumber(schema, typeName == "integer")
+	case "boolean":
+		return false
+	}
+	object := map[string]interface{}{}
+	for _, property := range SortedSchemaProperties(schema) {
+		object[property] = e.value(schema.Properties[property])
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		object["key"] = e.value(*schema.AdditionalProperties.Schema)
+	}
+	return object
+}
+
+// definition returns an example of a definition, with the discriminators of polymorphic
+// definitions set to its name. If pickVariant is true, polymorphic definitions are represented by
+// their first variant.
+func (e *exampler) definition(name string, pickVariant bool) interface{} {
+	def, ok := e.s.Definitions[name]
+	if !ok {
+		return nil
+	}
+	if variants := Subtypes(e.s, name); pickVariant && def.Discriminator != "" && len(variants) > 0 {
+		return e.definition(variants[0], false)
+	}
+	for _, resolving := range e.resolving {
+		if resolving == name {
+			return nil
+		}
+	}
+	e.resolving = append(e.resolving, name)
+	defer func() { e.resolving = e.resolving[:len(e.resolving)-1] }()
+
+	value := e.value(def)
+	object, ok := value.(map[string]interface{})
+	if !ok || def.Example != nil {
+		return value
+	}
+	if def.Discriminator != "" {
+		object[def.Discriminator] = name
+	}
+	for _, sub := range def.AllOf {
+		parent, ok := e.s.Definitions[strings.TrimPrefix(sub.Ref.String(), "#/definitions/")]
+		if sub.Ref.String() != "" && ok && parent.Discriminator != "" {
+			object[parent.Discriminator] = name
+		}
+	}
+	return object
+}
+
+// exampleString returns an example of a string in the format of a schema, padded or truncated
+// to its length bounds.
+func exampleString(schema spec.Schema) string {
+	example := "string"
+	switch schema.Format {
+	case "date-time":
+		return "2006-01-02T15:04:05Z"
+	case "date":
+		return "2006-01-02"
+	case "mongo-id":
+		return "5d8d3c1e2b2a4f0001a1b2c3"
+	case "uuid":
+		return "123e4567-e89b-12d3-a456-426614174000"
+	case "byte":
+		return "d2Fn"
+	}
+	if schema.MinLength != nil && int64(len(example)) < *schema.MinLength {
+		example += strings.Repeat("x", int(*schema.MinLength)-len(example))
+	}
+	if schema.MaxLength != nil && int64(len(example)) > *schema.MaxLength {
+		example = example[:*schema.MaxLength]
+	}
+	return example
+}
+
+// exampleNumber returns the number closest to zero within the bounds of a schema.
+func exampleNumber(schema spec.Schema, integer bool) interface{} {
+	example := 0.0
+	if schema.Minimum != nil && example <= *schema.Minimum {
+		example = *schema.Minimum
+		if integer {
+			example = math.Ceil(example)
+		}
+		if schema.ExclusiveMinimum && example <= *schema.Minimum {
+			example = math.Floor(example) + 1
+		}
+	} else if schema.Maximum != nil && example >= *schema.Maximum {
+		example = *schema.Maximum
+		if integer {
+			example = math.Floor(example)
+		}
+		if schema.ExclusiveMaximum && example >= *schema.Maximum {
+			example = math.Ceil(example) - 1
+		}
+	}
+	if integer {
+		return int64(example)
+	}
+	return example
+}
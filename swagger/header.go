@@ -0,0 +1,141 @@
+package swagger
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Clever/wag/v9/utils"
+	"github.com/go-openapi/spec"
+)
+
+// This code defines the operations on the headers of success responses. Each header becomes a
+// field of the operation's output type: strings are plain values and the other types are
+// pointers, so a header can be left unset. Headers on error responses are ignored.
+
+// HasResponseHeaders returns true if any of the operation's success responses declares headers.
+func HasResponseHeaders(op *spec.Operation) bool {
+	if op.Responses == nil {
+		return false
+	}
+	for statusCode, response := range op.Responses.StatusCodeResponses {
+		if statusCode < 400 && len(response.Headers) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasOutputType returns true if the operation returns an <OperationID>Output that wraps its
+// success body and response headers. Operations with more than one success response put their
+// headers in the <OperationID>Response instead.
+func HasOutputType(op *spec.Operation) bool {
+	return HasResponseHeaders(op) && !HasMultipleSuccessResponses(op)
+}
+
+// OutputTypeName returns the name of the model that wraps the success body and response headers
+// of an operation, e.g. "GetBookOutput".
+func OutputTypeName(op *spec.Operation) string {
+	return Capitalize(op.ID) + "Output"
+}
+
+// ResponseHeader is a header of one or more of an operation's success responses.
+type ResponseHeader struct {
+	// Name is the header name, e.g. "X-Rate-Limit-Remaining".
+	Name string
+	// Field is the name of the output type's field that holds the header,
+	// e.g. "XRateLimitRemaining".
+	Field string
+	// Type is the Go type of the header, without the pointer.
+	Type    string
+	Pointer bool
+	// ToStringCode converts the value of the field in the variable resp to a string.
+	ToStringCode string
+	// ParseCode parses the string in the variable v, returning the parsed value and an error.
+	// It's empty for strings, which don't need parsing.
+	ParseCode string
+	// ParsedValue converts the result of ParseCode, named parsed, to Type. It's empty if the
+	// result already has that type.
+	ParsedValue string
+}
+
+// ResponseHeaders returns the headers declared by the operation's success responses, sorted by
+// name. Headers declared by more than one response must have the same type.
+func ResponseHeaders(op *spec.Operation) ([]ResponseHeader, error) {
+	if op.Responses == nil {
+		return nil, nil
+	}
+	headers := map[string]ResponseHeader{}
+	for _, statusCode := range SortedStatusCodeKeys(op.Responses.StatusCodeResponses) {
+		if statusCode >= 400 {
+			continue
+		}
+		for name, header := range op.Responses.StatusCodeResponses[statusCode].Headers {
+			h, err := responseHeader(name, header)
+			if err != nil {
+				return nil, fmt.Errorf("response header %s of %s: %s", name, op.ID, err)
+			}
+			if existing, ok := headers[h.Field]; ok && existing.Type != h.Type {
+				return nil, fmt.Errorf("response header %s of %s has type %s in one response and %s "+
+					"in another", name, op.ID, existing.Type, h.Type)
+			}
+			headers[h.Field] = h
+		}
+	}
+
+	var fields []string
+	for field := range headers {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	responseHeaders := []ResponseHeader{}
+	for _, field := range fields {
+		responseHeaders = append(responseHeaders, headers[field])
+	}
+	return responseHeaders, nil
+}
+
+func responseHeader(name string, header spec.Header) (ResponseHeader, error) {
+	h := ResponseHeader{
+		Name:  name,
+		Field: utils.CamelCase(name, true),
+	}
+	switch header.Type {
+	case "string":
+		if header.Format != "" && header.Format != "mongo-id" {
+			return h, fmt.Errorf("unsupported string format \"%s\"", header.Format)
+		}
+		h.Type = "string"
+	case "integer":
+		h.Type = "int64"
+		h.ParseCode = "strconv.ParseInt(v, 10, 64)"
+		if header.Format == "int32" {
+			h.Type = "int32"
+			h.ParseCode = "strconv.ParseInt(v, 10, 32)"
+			h.ParsedValue = "int32(parsed)"
+		}
+	case "number":
+		h.Type = "float64"
+		h.ParseCode = "strconv.ParseFloat(v, 64)"
+		if header.Format == "float" {
+			h.Type = "float32"
+			h.ParseCode = "strconv.ParseFloat(v, 32)"
+			h.ParsedValue = "float32(parsed)"
+		}
+	case "boolean":
+		h.Type = "bool"
+		h.ParseCode = "strconv.ParseBool(v)"
+	default:
+		return h, fmt.Errorf("response headers must be strings, integers, numbers, or booleans")
+	}
+
+	h.Pointer = h.Type != "string"
+	access := "resp." + h.Field
+	if h.Pointer {
+		access = "*" + access
+	}
+	param := spec.Parameter{}
+	param.Type = header.Type
+	param.Format = header.Format
+	h.ToStringCode = valueToStringCode(param, access)
+	return h, nil
+}
@@ -0,0 +1,190 @@
+package swagger
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Clever/wag/v9/utils"
+	"github.com/go-openapi/spec"
+)
+
+// HoistInlineSchemas moves the inline object schemas of a spec to definitions and replaces them
+// with references, since wag only generates types for definitions. Each new definition is named
+// after where its schema was defined:
+//   - <Definition><Property> for a property of a definition, e.g. ThingMetadata
+//   - <OperationID><Status>Body for a response, e.g. GetThingOKBody
+//   - <OperationID>RequestBody for a body parameter
+//   - <Response>Body for a global response
+//
+// Inline objects in the new definitions are moved as well, and the items of arrays and values of
+// maps get an "Item" and "Value" suffix. HoistInlineSchemas returns true if it moved any schemas.
+func HoistInlineSchemas(s *spec.Swagger) (bool, error) {
+	h := hoister{s: s}
+	if s.Definitions == nil {
+		s.Definitions = spec.Definitions{}
+	}
+
+	for _, name := range SortedKeys(s.Definitions) {
+		def, err := h.hoistNested(s.Definitions[name], name, "#/definitions/"+EscapePointer(name))
+		if err != nil {
+			return false, err
+		}
+		s.Definitions[name] = def
+	}
+
+	for _, name := range SortedResponses(s.Responses) {
+		response := s.Responses[name]
+		if response.Schema == nil {
+			continue
+		}
+		schema, err := h.hoist(*response.Schema, name+"Body", "#/responses/"+EscapePointer(name)+"/schema")
+		if err != nil {
+			return false, err
+		}
+		response.Schema = &schema
+		s.Responses[name] = response
+	}
+
+	if s.Paths == nil {
+		return h.hoisted, nil
+	}
+	for _, path := range SortedPathItemKeys(s.Paths.Paths) {
+		ops := PathItemOperations(s.Paths.Paths[path])
+		for _, method := range SortedOperationsKeys(ops) {
+			op := ops[method]
+			if op.ID == "" {
+				// validation rejects operations without IDs
+				continue
+			}
+			location := "#/paths/" + EscapePointer(path) + "/" + strings.ToLower(method)
+			if err := h.hoistOperation(op, location); err != nil {
+				return false, err
+			}
+		}
+	}
+	return h.hoisted, nil
+}
+
+type hoister struct {
+	s       *spec.Swagger
+	hoisted bool
+}
+
+func (h *hoister) hoistOperation(op *spec.Operation, location string) error {
+	for i, param := range op.Parameters {
+		if param.In != "body" || param.Schema == nil {
+			continue
+		}
+		schema, err := h.hoist(*param.Schema, Capitalize(op.ID)+"RequestBody",
+			fmt.Sprintf("%s/parameters/%d/schema", location, i))
+		if err != nil {
+			return err
+		}
+		op.Parameters[i].Schema = &schema
+	}
+
+	if op.Responses == nil {
+		return nil
+	}
+	if op.Responses.Default != nil && op.Responses.Default.Schema != nil {
+		schema, err := h.hoist(*op.Responses.Default.Schema, Capitalize(op.ID)+"DefaultBody",
+			location+"/responses/default/schema")
+		if err != nil {
+			return err
+		}
+		op.Responses.Default.Schema = &schema
+	}
+	for _, statusCode := range SortedStatusCodeKeys(op.Responses.StatusCodeResponses) {
+		response := op.Responses.StatusCodeResponses[statusCode]
+		if response.Schema == nil {
+			continue
+		}
+		schema, err := h.hoist(*response.Schema, Capitalize(op.ID)+statusCodeFieldName(statusCode)+"Body",
+			fmt.Sprintf("%s/responses/%d/schema", location, statusCode))
+		if err != nil {
+			return err
+		}
+		response.Schema = &schema
+		op.Responses.StatusCodeResponses[statusCode] = response
+	}
+	return nil
+}
+
+// hoist returns the schema to use in place of schema. Inline objects are moved to a definition
+// called name and replaced with a reference to it.
+func (h *hoister) hoist(schema spec.Schema, name, location string) (spec.Schema, error) {
+	if !isInlineObject(schema) {
+		// arrays and allOf stay inline, but may contain objects
+		return h.hoistNested(schema, name, location)
+	}
+
+	if _, ok := h.s.Definitions[name]; ok {
+		return schema, fmt.Errorf("the inline schema at %s would be moved to #/definitions/%s, but "+
+			"that definition already exists. Move the schema to #/definitions yourself, with a "+
+			"different name", location, name)
+	}
+	// add the definition before moving its nested objects, so their names are checked against it
+	h.s.Definitions[name] = schema
+	def, err := h.hoistNested(schema, name, location)
+	if err != nil {
+		return schema, err
+	}
+	h.s.Definitions[name] = def
+	h.hoisted = true
+	return spec.Schema{SchemaProps: spec.SchemaProps{Ref: spec.MustCreateRef("#/definitions/" + name)}}, nil
+}
+
+// hoistNested moves the inline objects in the properties, items, and additional properties of a
+// schema called name. The schemas of allOf stay inline, but their properties are moved.
+func (h *hoister) hoistNested(schema spec.Schema, name, location string) (spec.Schema, error) {
+	if len(schema.Properties) > 0 {
+		properties := map[string]spec.Schema{}
+		for _, property := range SortedSchemaProperties(schema) {
+			hoisted, err := h.hoist(schema.Properties[property], name+utils.CamelCase(property, true),
+				location+"/properties/"+EscapePointer(property))
+			if err != nil {
+				return schema, err
+			}
+			properties[property] = hoisted
+		}
+		schema.Properties = properties
+	}
+	if schema.Items != nil && schema.Items.Schema != nil {
+		items, err := h.hoist(*schema.Items.Schema, name+"Item", location+"/items")
+		if err != nil {
+			return schema, err
+		}
+		schema.Items = &spec.SchemaOrArray{Schema: &items}
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		value, err := h.hoist(*schema.AdditionalProperties.Schema, name+"Value",
+			location+"/additionalProperties")
+		if err != nil {
+			return schema, err
+		}
+		schema.AdditionalProperties = &spec.SchemaOrBool{Allows: true, Schema: &value}
+	}
+	if len(schema.AllOf) > 0 {
+		allOf := make([]spec.Schema, len(schema.AllOf))
+		for i, sub := range schema.AllOf {
+			hoisted, err := h.hoistNested(sub, name, fmt.Sprintf("%s/allOf/%d", location, i))
+			if err != nil {
+				return schema, err
+			}
+			allOf[i] = hoisted
+		}
+		schema.AllOf = allOf
+	}
+	return schema, nil
+}
+
+// isInlineObject returns true if a schema defines an object rather than referring to one.
+func isInlineObject(schema spec.Schema) bool {
+	if schema.Ref.String() != "" {
+		return false
+	}
+	if len(schema.Type) == 0 {
+		return len(schema.Properties) > 0
+	}
+	return len(schema.Type) == 1 && schema.Type[0] == "object"
+}
@@ -0,0 +1,58 @@
+package swagger
+
+import (
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoistInlineSchemas(t *testing.T) {
+	s := loadTestFile(t, "testyml/inline.yml")
+	hoisted, err := HoistInlineSchemas(&s)
+	require.NoError(t, err)
+	assert.True(t, hoisted)
+
+	ref := func(schema spec.Schema) string { return schema.Ref.String() }
+	refs := map[string]string{
+		"owner":   ref(s.Definitions["Thing"].Properties["owner"]),
+		"address": ref(s.Definitions["ThingOwner"].Properties["address"]),
+		"history": s.Definitions["Thing"].Properties["history"].Items.Schema.Ref.String(),
+		"extra":   ref(s.Definitions["Thing"].Properties["base"].AllOf[1].Properties["extra"]),
+		"body":    s.Paths.Paths["/things/{id}"].Put.Parameters[1].Schema.Ref.String(),
+		"404":     s.Paths.Paths["/things/{id}"].Put.Responses.StatusCodeResponses[404].Schema.Ref.String(),
+		"default": s.Paths.Paths["/things/{id}"].Put.Responses.Default.Schema.Items.Schema.Ref.String(),
+		"global":  s.Responses["BadRequest"].Schema.Ref.String(),
+	}
+	assert.Equal(t, map[string]string{
+		"owner":   "#/definitions/ThingOwner",
+		"address": "#/definitions/ThingOwnerAddress",
+		"history": "#/definitions/ThingHistoryItem",
+		"extra":   "#/definitions/ThingBaseExtra",
+		"body":    "#/definitions/PutThingRequestBody",
+		"404":     "#/definitions/PutThingNotFoundBody",
+		"default": "#/definitions/PutThingDefaultBodyItem",
+		"global":  "#/definitions/BadRequestBody",
+	}, refs)
+	assert.Equal(t, []string{"string"}, []string(s.Definitions["ThingOwnerAddress"].Properties["city"].Type))
+	// references and primitives stay where they are
+	assert.Equal(t, "#/definitions/Thing", s.Paths.Paths["/things/{id}"].Put.Responses.StatusCodeResponses[200].Schema.Ref.String())
+	assert.Equal(t, []string{"string"}, []string(s.Definitions["Thing"].Properties["tags"].Items.Schema.Type))
+	assert.Len(t, s.Definitions, 10)
+
+	s = loadTestFile(t, "testyml/defaults.yml")
+	hoisted, err = HoistInlineSchemas(&s)
+	require.NoError(t, err)
+	assert.False(t, hoisted)
+}
+
+func TestHoistInlineSchemasConflict(t *testing.T) {
+	s := loadTestFile(t, "testyml/inline.yml")
+	s.Definitions["ThingOwner"] = s.Definitions["Base"]
+	_, err := HoistInlineSchemas(&s)
+	require.Error(t, err)
+	assert.Equal(t, "the inline schema at #/definitions/Thing/properties/owner would be moved to "+
+		"#/definitions/ThingOwner, but that definition already exists. Move the schema to "+
+		"#/definitions yourself, with a different name", err.Error())
+}
@@ -0,0 +1,35 @@
+package swagger
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-openapi/spec"
+)
+
+// OperationTimeout returns the x-timeout of an operation, or 0 if it doesn't have one.
+func OperationTimeout(op *spec.Operation) (time.Duration, error) {
+	value, ok := op.Extensions["x-timeout"]
+	if !ok {
+		return 0, nil
+	}
+	str, _ := value.(string)
+	timeout, err := time.ParseDuration(str)
+	if err != nil || timeout <= 0 {
+		return 0, errors.New("x-timeout must be a positive duration, e.g. 500ms or 30s")
+	}
+	return timeout, nil
+}
+
+// OperationMaxBodyBytes returns the x-max-body-bytes of an operation, or 0 if it doesn't have one.
+func OperationMaxBodyBytes(op *spec.Operation) (int64, error) {
+	value, ok := op.Extensions["x-max-body-bytes"]
+	if !ok {
+		return 0, nil
+	}
+	maxBodyBytes, ok := positiveInt(value)
+	if !ok {
+		return 0, errors.New("x-max-body-bytes must be a positive integer")
+	}
+	return int64(maxBodyBytes), nil
+}
@@ -0,0 +1,34 @@
+package swagger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationLimits(t *testing.T) {
+	op := spec.NewOperation("op")
+	timeout, err := OperationTimeout(op)
+	require.NoError(t, err)
+	assert.Zero(t, timeout)
+	maxBodyBytes, err := OperationMaxBodyBytes(op)
+	require.NoError(t, err)
+	assert.Zero(t, maxBodyBytes)
+
+	op.Extensions = spec.Extensions{"x-timeout": "1m30s", "x-max-body-bytes": float64(1 << 20)}
+	timeout, err = OperationTimeout(op)
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, timeout)
+	maxBodyBytes, err = OperationMaxBodyBytes(op)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1<<20), maxBodyBytes)
+
+	op.Extensions = spec.Extensions{"x-timeout": float64(30), "x-max-body-bytes": "1MB"}
+	_, err = OperationTimeout(op)
+	assert.EqualError(t, err, "x-timeout must be a positive duration, e.g. 500ms or 30s")
+	_, err = OperationMaxBodyBytes(op)
+	assert.EqualError(t, err, "x-max-body-bytes must be a positive integer")
+}
@@ -2,6 +2,7 @@ package swagger
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/Clever/wag/v9/templates"
@@ -23,7 +24,7 @@ func ClientInterface(s *spec.Swagger, op *spec.Operation) string {
 // builder of an operation
 func ClientIterInterface(s *spec.Swagger, op *spec.Operation) string {
 	capOpID := Capitalize(op.ID)
-	input := OperationInput(op)
+	input := OperationInput(s, op)
 	return fmt.Sprintf(
 		"New%sIter(ctx context.Context, %s) (%sIter, error)",
 		capOpID,
@@ -33,7 +34,7 @@ func ClientIterInterface(s *spec.Swagger, op *spec.Operation) string {
 }
 
 // OperationInput returns the input to an operation
-func OperationInput(op *spec.Operation) string {
+func OperationInput(s *spec.Swagger, op *spec.Operation) string {
 	// Don't add the input parameter argument unless there are some arguments.
 	// If a method has a single input parameter, and it's a schema, make the
 	// generated type for that schema the input of the method.
@@ -44,6 +45,9 @@ func OperationInput(op *spec.Operation) string {
 	input := ""
 	if singleSchemaedBodyParameter, opModel := SingleSchemaedBodyParameter(op); singleSchemaedBodyParameter {
 		input = fmt.Sprintf("i *models.%s", opModel)
+		if IsPolymorphic(s, op.Parameters[0].Schema) {
+			input = fmt.Sprintf("i models.%s", opModel)
+		}
 	} else if singleStringPathParameter, inputName := SingleStringPathParameter(op); singleStringPathParameter {
 		input = fmt.Sprintf("%s string", inputName)
 	} else if len(op.Parameters) > 0 {
@@ -56,7 +60,7 @@ func OperationInput(op *spec.Operation) string {
 func opInterface(s *spec.Swagger, op *spec.Operation, includePaging bool) string {
 	capOpID := Capitalize(op.ID)
 
-	input := OperationInput(op)
+	input := OperationInput(s, op)
 
 	returnTypes := []string{}
 	if successType := SuccessType(s, op); successType != nil {
@@ -145,7 +149,7 @@ func OutputSchema(s *spec.Swagger, op *spec.Operation, statusCode int) *spec.Sch
 }
 
 // OutputType returns the output type for a given status code of an operation and whether it
-// is a pointer in the interface.
+// is a pointer in the interface. Polymorphic types are interfaces, so they aren't pointers.
 func OutputType(s *spec.Swagger, op *spec.Operation, statusCode int) (string, bool) {
 	schema := OutputSchema(s, op, statusCode)
 
@@ -153,12 +157,22 @@ func OutputType(s *spec.Swagger, op *spec.Operation, statusCode int) (string, bo
 	if err != nil {
 		panic(fmt.Errorf("could not convert operation to type for %s, %s", op.ID, err))
 	}
-	return successType, schema != nil && schema.Ref.String() != ""
+	return successType, schema != nil && schema.Ref.String() != "" && !IsPolymorphic(s, schema)
 }
 
 // SuccessType returns the success type for the operation. If there is no success-type then
-// it returns nil
+// it returns nil. Operations with more than one success response return the generated
+// <OperationID>Response type, which holds whichever response the server sent, and operations
+// whose success response declares headers return the generated <OperationID>Output type.
 func SuccessType(s *spec.Swagger, op *spec.Operation) *string {
+	if HasMultipleSuccessResponses(op) {
+		successType := "*models." + SuccessResponseTypeName(op)
+		return &successType
+	}
+	if HasOutputType(op) {
+		successType := "*models." + OutputTypeName(op)
+		return &successType
+	}
 	for statusCode := range op.Responses.StatusCodeResponses {
 		if statusCode < 400 {
 			successType, makePointer := OutputType(s, op, statusCode)
@@ -174,6 +188,68 @@ func SuccessType(s *spec.Swagger, op *spec.Operation) *string {
 	return nil
 }
 
+// HasMultipleSuccessResponses returns true if the operation defines more than one success
+// (< 400) status code.
+func HasMultipleSuccessResponses(op *spec.Operation) bool {
+	if op.Responses == nil {
+		return false
+	}
+	count := 0
+	for statusCode := range op.Responses.StatusCodeResponses {
+		if statusCode < 400 {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// SuccessResponseTypeName returns the name of the model that holds the success responses of an
+// operation with more than one of them, e.g. "UpsertBookResponse".
+func SuccessResponseTypeName(op *spec.Operation) string {
+	return Capitalize(op.ID) + "Response"
+}
+
+// SuccessResponse is one of the success responses of an operation with more than one.
+type SuccessResponse struct {
+	StatusCode int
+	// Field is the name of the field of the <OperationID>Response type that holds the body of
+	// this response, e.g. "Created" for a 201.
+	Field string
+	// Type is the Go type of the body, e.g. "*models.Book". It's empty if the response doesn't
+	// have a body.
+	Type string
+}
+
+// SuccessResponses returns the success responses of an operation sorted by status code.
+func SuccessResponses(s *spec.Swagger, op *spec.Operation) []SuccessResponse {
+	responses := []SuccessResponse{}
+	for _, statusCode := range SortedStatusCodeKeys(op.Responses.StatusCodeResponses) {
+		if statusCode >= 400 {
+			continue
+		}
+		typeName, makePointer := OutputType(s, op, statusCode)
+		if typeName != "" && makePointer {
+			typeName = "*" + typeName
+		}
+		responses = append(responses, SuccessResponse{
+			StatusCode: statusCode,
+			Field:      statusCodeFieldName(statusCode),
+			Type:       typeName,
+		})
+	}
+	return responses
+}
+
+// statusCodeFieldName returns a field name for a status code based on its status text, e.g.
+// "Created" for 201 and "NoContent" for 204.
+func statusCodeFieldName(statusCode int) string {
+	text := http.StatusText(statusCode)
+	if text == "" {
+		return fmt.Sprintf("Status%d", statusCode)
+	}
+	return utils.CamelCase(text, true)
+}
+
 // PagingParam returns the parameter that specifies the page ID for this
 // operation, if paging is configured. If paging is not configured, the second
 // return value is `false`.
@@ -283,10 +359,15 @@ func CodeToTypeMap(s *spec.Swagger, op *spec.Operation, makePointers bool) map[i
 }
 
 // TypeToCodeMap returns a map from the type to its corresponding status code. It returns
-// an error if mutiple status codes map to the same type
+// an error if mutiple status codes map to the same type. Operations with more than one success
+// response pick the status code from the <OperationID>Response, so only their errors are included.
 func TypeToCodeMap(s *spec.Swagger, op *spec.Operation) (map[string]int, error) {
 	typeToCode := make(map[string]int)
+	multipleSuccess := HasMultipleSuccessResponses(op)
 	for code, typeStr := range CodeToTypeMap(s, op, false) {
+		if multipleSuccess && code < 400 {
+			continue
+		}
 		if typeStr != "" {
 			if _, ok := typeToCode[typeStr]; ok {
 				return nil, fmt.Errorf("duplicate response types %s, %s", typeStr, op.ID)
@@ -11,8 +11,8 @@ import (
 
 // This code defines all the operations on parameter objects. The swagger spec for parameters
 // is defined here: http://swagger.io/specification/#parameterObject. Note that currently we don't
-// support array, file, or string.binary data types and that the schema logic isn't currently defined
-// in this file.
+// support the string.binary data type and that the schema logic isn't currently defined in this file.
+// File parameters are streamed as io.ReadCloser and don't have a string representation.
 //
 // There are four common operations on parameter objects and we have one function for each:
 // 1. Param -> Go Type
@@ -24,8 +24,17 @@ import (
 // it into a string (for serialization). For example, a integer named 'Size' becomes
 // `strconv.FormatInt(i.Size, 10)`
 func ParamToStringCode(param spec.Parameter, op *spec.Operation) string {
+	return valueToStringCode(param, accessString(param, op))
+}
+
+// ArrayItemToStringCode returns the code to convert a single item of an array parameter, held
+// in the variable itemVar, into a string. For example, an item of an integer array becomes
+// `strconv.FormatInt(v, 10)`
+func ArrayItemToStringCode(param spec.Parameter, itemVar string) string {
+	return valueToStringCode(ArrayItemParam(param), itemVar)
+}
 
-	valToSet := accessString(param, op)
+func valueToStringCode(param spec.Parameter, valToSet string) string {
 	switch param.Type {
 	case "string":
 		switch param.Format {
@@ -103,20 +112,61 @@ func ParamToType(param spec.Parameter) (string, bool, error) {
 			typeName = "float64"
 		}
 	case "array":
-		if param.Items.Type != "string" {
-			return "", false, fmt.Errorf("array parameters must have string sub-types")
+		if param.Items == nil || !isPrimitiveType(param.Items.Type) {
+			return "", false, fmt.Errorf("array parameters must have string, integer, number, or boolean sub-types")
+		}
+		itemType, _, err := ParamToType(ArrayItemParam(param))
+		if err != nil {
+			return "", false, err
 		}
-		typeName = "[]string"
+		typeName = "[]" + itemType
+	case "file":
+		if param.In != "formData" {
+			return "", false, fmt.Errorf("file parameters must be in formData")
+		}
+		typeName = "io.ReadCloser"
 	default:
-		// Note. We don't support 'array' or 'file' types even though they're in the
-		// Swagger spec.
 		return "", false, fmt.Errorf("unsupported param type: \"%s\"", param.Type)
 	}
 
-	pointer := !param.Required && param.Type != "array" && param.In != "header"
+	pointer := !param.Required && param.Type != "array" && param.Type != "file" && param.In != "header"
 	return typeName, pointer, nil
 }
 
+func isPrimitiveType(t string) bool {
+	return t == "string" || t == "integer" || t == "number" || t == "boolean"
+}
+
+// ArrayItemParam returns a required parameter with the type and format of an array parameter's
+// items, so a single item can be converted to and from a string like a primitive parameter.
+func ArrayItemParam(param spec.Parameter) spec.Parameter {
+	item := spec.Parameter{}
+	item.Name = param.Name
+	item.In = param.In
+	item.Required = true
+	item.Type = param.Items.Type
+	item.Format = param.Items.Format
+	return item
+}
+
+// CollectionFormatSeparator returns the separator that joins the items of an array parameter
+// into a single value, or an empty string if each item is sent as a separate parameter. Note that
+// wag treats arrays without a collectionFormat as "multi", unlike the swagger default of "csv".
+func CollectionFormatSeparator(param spec.Parameter) string {
+	switch param.CollectionFormat {
+	case "csv":
+		return ","
+	case "ssv":
+		return " "
+	case "tsv":
+		return "\t"
+	case "pipes":
+		return "|"
+	default:
+		return ""
+	}
+}
+
 // BaseStringToTypeCode is the helper code from base string to type
 func BaseStringToTypeCode() string {
 	return `
@@ -193,7 +243,7 @@ func StringToTypeCode(strField string, param spec.Parameter, op *spec.Operation)
 		if param.Items.Type != "string" {
 			return "", fmt.Errorf("array parameters must have string sub-types")
 		}
-		return fmt.Sprintf("swag.SplitByFormat(%s, \"%s\"), error(nil)", strField, param.Format), nil
+		return fmt.Sprintf("swag.SplitByFormat(%s, \"%s\"), error(nil)", strField, param.CollectionFormat), nil
 	default:
 		return "", fmt.Errorf("param type %s not supported", param.Type)
 	}
@@ -290,6 +340,26 @@ func accessString(param spec.Parameter, op *spec.Operation) string {
 	return fmt.Sprintf("%si.%s", pointer, utils.CamelCase(param.Name, true))
 }
 
+// HasFormDataParams returns true if the operation has formData parameters.
+func HasFormDataParams(op *spec.Operation) bool {
+	for _, param := range op.Parameters {
+		if param.In == "formData" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasFileParams returns true if the operation has file parameters.
+func HasFileParams(op *spec.Operation) bool {
+	for _, param := range op.Parameters {
+		if param.Type == "file" {
+			return true
+		}
+	}
+	return false
+}
+
 // StructParamName returns the name of the struct as used in the model struct
 func StructParamName(param spec.Parameter) string {
 	return utils.CamelCase(param.Name, true)
@@ -321,6 +391,11 @@ type ParamTemplate struct {
 	Type         string
 	AccessString string
 	Pointer      bool
+	// ItemToStringCode converts an item of an array parameter, named v, to a string.
+	ItemToStringCode string
+	// Separator joins the items of an array parameter. It's empty if each item is sent
+	// separately.
+	Separator string
 }
 
 // ParamToTemplate converts a spec.Parameter into the a struct with the information needed to
@@ -336,7 +411,7 @@ func ParamToTemplate(param *spec.Parameter, op *spec.Operation) ParamTemplate {
 	}
 
 	toStringCode := ""
-	if param.Type != "array" && param.In != "body" {
+	if param.Type != "array" && param.Type != "file" && param.In != "body" {
 		toStringCode = ParamToStringCode(*param, op)
 	}
 
@@ -347,6 +422,10 @@ func ParamToTemplate(param *spec.Parameter, op *spec.Operation) ParamTemplate {
 		AccessString: "i." + StructParamName(*param),
 		Pointer:      pointer,
 	}
+	if param.Type == "array" {
+		t.ItemToStringCode = ArrayItemToStringCode(*param, "v")
+		t.Separator = CollectionFormatSeparator(*param)
+	}
 	// If this is a single parameter then use $FIELD rather than i.$FIELD in both the toString
 	// and accessing code.
 	if singleStringPathParameter {
@@ -0,0 +1,66 @@
+package swagger
+
+import (
+	"sort"
+
+	"github.com/go-openapi/spec"
+)
+
+// IsPolymorphic returns true if a schema refers to a definition with a discriminator. go-swagger
+// generates an interface for these definitions, which is implemented by a type for each
+// definition that extends it with allOf, so they're never used as pointers.
+func IsPolymorphic(s *spec.Swagger, schema *spec.Schema) bool {
+	if schema == nil || schema.Ref.String() == "" {
+		return false
+	}
+	name, err := DefFromRef(schema.Ref.String())
+	if err != nil {
+		return false
+	}
+	def, ok := s.Definitions[name]
+	return ok && def.Discriminator != ""
+}
+
+// HasPolymorphicDefinitions returns true if any of the definitions of a spec has a discriminator.
+func HasPolymorphicDefinitions(s *spec.Swagger) bool {
+	for _, def := range s.Definitions {
+		if def.Discriminator != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalFunc returns the function go-swagger generates to decode a polymorphic schema, or an
+// array of them, e.g. "UnmarshalEvent" or "UnmarshalEventSlice". It returns an empty string for
+// other schemas, which encoding/json can decode.
+func UnmarshalFunc(s *spec.Swagger, schema *spec.Schema) string {
+	if schema == nil {
+		return ""
+	}
+	suffix := ""
+	if schema.Items != nil && schema.Items.Schema != nil {
+		schema, suffix = schema.Items.Schema, "Slice"
+	}
+	if !IsPolymorphic(s, schema) {
+		return ""
+	}
+	name, _ := DefFromRef(schema.Ref.String())
+	return "Unmarshal" + name + suffix
+}
+
+// Subtypes returns the sorted names of the definitions that extend a definition with allOf.
+// Swagger uses these names as the values of the discriminator of a polymorphic definition.
+func Subtypes(s *spec.Swagger, name string) []string {
+	subtypes := []string{}
+	for defName, def := range s.Definitions {
+		for _, schema := range def.AllOf {
+			if schema.Ref.String() == "#/definitions/"+name {
+				subtypes = append(subtypes, defName)
+				break
+			}
+		}
+	}
+	sort.Strings(subtypes)
+	return subtypes
+}
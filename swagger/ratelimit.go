@@ -0,0 +1,83 @@
+package swagger
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/spec"
+)
+
+// RateLimit is the x-rate-limit extension of an operation. Each key gets a bucket of Burst tokens
+// that refills with Requests tokens every Interval, and each request takes a token.
+type RateLimit struct {
+	Requests int
+	Interval time.Duration
+	Burst    int
+	// Key is what requests are limited by: "ip" (the default), "header" or "caller".
+	Key string
+	// Header is the header to limit requests by when Key is "header".
+	Header string
+}
+
+// OperationRateLimit returns the x-rate-limit of an operation, or nil if it doesn't have one.
+func OperationRateLimit(op *spec.Operation) (*RateLimit, error) {
+	value, ok := op.Extensions["x-rate-limit"]
+	if !ok {
+		return nil, nil
+	}
+	config, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("x-rate-limit must be an object")
+	}
+	for field := range config {
+		switch field {
+		case "requests", "interval", "burst", "key", "header":
+		default:
+			return nil, fmt.Errorf("x-rate-limit has an unknown field '%s'", field)
+		}
+	}
+
+	requests, ok := positiveInt(config["requests"])
+	if !ok {
+		return nil, errors.New("x-rate-limit must have a 'requests' field that's a positive integer")
+	}
+	intervalStr, _ := config["interval"].(string)
+	interval, err := time.ParseDuration(intervalStr)
+	if err != nil || interval <= 0 {
+		return nil, errors.New("x-rate-limit must have an 'interval' field that's a positive " +
+			"duration, e.g. 1s or 1m")
+	}
+	rateLimit := &RateLimit{Requests: requests, Interval: interval, Burst: requests, Key: "ip"}
+	if burst, ok := config["burst"]; ok {
+		if rateLimit.Burst, ok = positiveInt(burst); !ok {
+			return nil, errors.New("the 'burst' field of x-rate-limit must be a positive integer")
+		}
+	}
+	if key, ok := config["key"]; ok {
+		rateLimit.Key, _ = key.(string)
+	}
+	rateLimit.Header, _ = config["header"].(string)
+	switch rateLimit.Key {
+	case "ip", "caller":
+		if rateLimit.Header != "" {
+			return nil, errors.New("the 'header' field of x-rate-limit can only be set when 'key' is 'header'")
+		}
+	case "header":
+		if rateLimit.Header == "" {
+			return nil, errors.New("x-rate-limit must have a 'header' field when 'key' is 'header'")
+		}
+	default:
+		return nil, errors.New("the 'key' field of x-rate-limit must be 'ip', 'header' or 'caller'")
+	}
+	return rateLimit, nil
+}
+
+// positiveInt returns a number from a spec as an int, if it's a positive integer.
+func positiveInt(value interface{}) (int, bool) {
+	number, ok := value.(float64)
+	if !ok || number < 1 || number != float64(int(number)) {
+		return 0, false
+	}
+	return int(number), true
+}
@@ -0,0 +1,71 @@
+package swagger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationRateLimit(t *testing.T) {
+	op := spec.NewOperation("op")
+	rateLimit, err := OperationRateLimit(op)
+	require.NoError(t, err)
+	assert.Nil(t, rateLimit)
+
+	for _, test := range []struct {
+		config    map[string]interface{}
+		rateLimit *RateLimit
+		err       string
+	}{
+		{
+			config:    map[string]interface{}{"requests": float64(10), "interval": "1s"},
+			rateLimit: &RateLimit{Requests: 10, Interval: time.Second, Burst: 10, Key: "ip"},
+		},
+		{
+			config: map[string]interface{}{"requests": float64(100), "interval": "1m", "burst": float64(20),
+				"key": "header", "header": "X-Client-ID"},
+			rateLimit: &RateLimit{Requests: 100, Interval: time.Minute, Burst: 20, Key: "header",
+				Header: "X-Client-ID"},
+		},
+		{
+			config:    map[string]interface{}{"requests": float64(1), "interval": "500ms", "key": "caller"},
+			rateLimit: &RateLimit{Requests: 1, Interval: 500 * time.Millisecond, Burst: 1, Key: "caller"},
+		},
+		{
+			config: map[string]interface{}{"requests": 1.5, "interval": "1s"},
+			err:    "x-rate-limit must have a 'requests' field that's a positive integer",
+		},
+		{
+			config: map[string]interface{}{"requests": float64(1), "interval": "1"},
+			err:    "x-rate-limit must have an 'interval' field that's a positive duration, e.g. 1s or 1m",
+		},
+		{
+			config: map[string]interface{}{"requests": float64(1), "interval": "1s", "burst": float64(0)},
+			err:    "the 'burst' field of x-rate-limit must be a positive integer",
+		},
+		{
+			config: map[string]interface{}{"requests": float64(1), "interval": "1s", "key": "user"},
+			err:    "the 'key' field of x-rate-limit must be 'ip', 'header' or 'caller'",
+		},
+		{
+			config: map[string]interface{}{"requests": float64(1), "interval": "1s", "key": "header"},
+			err:    "x-rate-limit must have a 'header' field when 'key' is 'header'",
+		},
+		{
+			config: map[string]interface{}{"requests": float64(1), "interval": "1s", "per": "ip"},
+			err:    "x-rate-limit has an unknown field 'per'",
+		},
+	} {
+		op.Extensions = spec.Extensions{"x-rate-limit": test.config}
+		rateLimit, err := OperationRateLimit(op)
+		if test.err != "" {
+			assert.EqualError(t, err, test.err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, test.rateLimit, rateLimit)
+	}
+}
@@ -3,6 +3,7 @@ package swagger
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-openapi/jsonreference"
 	"github.com/go-openapi/spec"
@@ -10,7 +11,7 @@ import (
 
 // ValidateResponses checks the responses from swagger operations, and in a few cases
 // tweaks the swagger spec to make them valid. This means a few things:
-// - Verifying that the spec only has one success type
+// - Verifying that the spec doesn't have 3XX responses
 // - Verifying the required global error types exist and that they have the required fields
 // - Adding 400 / 500 responses to any operation that doesn't have them defined
 func ValidateResponses(s spec.Swagger) error {
@@ -46,13 +47,12 @@ func ValidateResponses(s spec.Swagger) error {
 			has400 := false
 			has500 := false
 
-			successResponseCount := 0
 			for code, resp := range op.Responses.StatusCodeResponses {
 
 				// Any 400+ responses must have `message` field so that
 				// they can have a generated Error message
 				if code < 300 {
-					successResponseCount++
+					continue
 				} else if code < 400 {
 					return fmt.Errorf("cannot define 3XX status codes: %s", op.ID)
 				} else {
@@ -68,10 +68,6 @@ func ValidateResponses(s spec.Swagger) error {
 				}
 			}
 
-			if successResponseCount > 1 {
-				return fmt.Errorf("can only define one success type (statusCode < 400) for %s", op.ID)
-			}
-
 			if !has400 {
 				refResponse, err := createRefResponse("BadRequest", "#/responses/BadRequest")
 				if err != nil {
@@ -166,5 +162,91 @@ func refHasMessageField(ref spec.Ref, s spec.Swagger) error {
 		return fmt.Errorf("%s cannot have required fields to be used an error", ref.String())
 	}
 
+	if _, err := fieldErrorsOf(&s, schema); err != nil {
+		return fmt.Errorf("%s: %s", ref.String(), err)
+	}
+
 	return nil
 }
+
+// FieldErrors is the errors field of an error definition. When the type of an operation's 400
+// response has one, the server fills it with the parameters and fields of the request that
+// couldn't be read or failed validation.
+type FieldErrors struct {
+	// ItemType is the type of the items, e.g. "models.FieldError".
+	ItemType string
+	// CodeType is the type of the code field of the items.
+	CodeType string
+}
+
+// BadRequestFieldErrors returns the errors field of the type of an operation's 400 response, or
+// nil if it doesn't have one.
+func BadRequestFieldErrors(s *spec.Swagger, op *spec.Operation) (*FieldErrors, error) {
+	schema := OutputSchema(s, op, 400)
+	if schema == nil || schema.Ref.String() == "" {
+		return nil, nil
+	}
+	fieldErrors, err := fieldErrorsOf(s, *resolveSchema(s, schema))
+	if err != nil {
+		return nil, fmt.Errorf("invalid 400 response for %s: %s", op.ID, err)
+	}
+	return fieldErrors, nil
+}
+
+// HasFieldErrors returns true if the type of any operation's 400 response has an errors field.
+func HasFieldErrors(s *spec.Swagger) (bool, error) {
+	for _, pathKey := range SortedPathItemKeys(s.Paths.Paths) {
+		pathItemOps := PathItemOperations(s.Paths.Paths[pathKey])
+		for _, opKey := range SortedOperationsKeys(pathItemOps) {
+			fieldErrors, err := BadRequestFieldErrors(s, pathItemOps[opKey])
+			if err != nil {
+				return false, err
+			}
+			if fieldErrors != nil {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// fieldErrorsOf returns the errors field of an error schema, or nil if it doesn't have one. The
+// field must be an array of a definition with path, code and message fields.
+func fieldErrorsOf(s *spec.Swagger, schema spec.Schema) (*FieldErrors, error) {
+	errorsField, ok := schema.Properties["errors"]
+	if !ok {
+		return nil, nil
+	}
+	errorsType, err := TypeFromSchema(&errorsField, true)
+	if err != nil || !strings.HasPrefix(errorsType, "[]") {
+		return nil, errors.New("the 'errors' field in errors must be an array of a definition with " +
+			"'path', 'code' and 'message' fields")
+	}
+	item := resolveSchema(s, errorsField.Items.Schema)
+	for _, field := range []struct{ name, typ string }{
+		{"path", "string"}, {"code", "integer"}, {"message", "string"},
+	} {
+		property, ok := item.Properties[field.name]
+		if !ok || len(property.Type) != 1 || property.Type[0] != field.typ {
+			return nil, fmt.Errorf("the items of the 'errors' field in errors must have a '%s' field of "+
+				"type '%s'", field.name, field.typ)
+		}
+	}
+	// Like the error definitions themselves, required fields would be pointers
+	if len(item.Required) > 0 {
+		return nil, errors.New("the items of the 'errors' field in errors cannot have required fields")
+	}
+
+	codeType := "int64"
+	if item.Properties["code"].Format == "int32" {
+		codeType = "int32"
+	}
+	return &FieldErrors{ItemType: strings.TrimPrefix(errorsType, "[]"), CodeType: codeType}, nil
+}
+
+// ProblemDetails returns true if the spec sets the x-problem-details extension, which makes the
+// server respond with RFC 7807 problem details (application/problem+json) for errors.
+func ProblemDetails(s *spec.Swagger) bool {
+	enabled, _ := s.Extensions.GetBool("x-problem-details")
+	return enabled
+}
@@ -46,11 +46,24 @@ func Test3xxError(t *testing.T) {
 	assert.True(t, strings.Contains(err.Error(), "cannot define 3XX status codes"), err.Error())
 }
 
-func TestMultiSuccessError(t *testing.T) {
+func TestMultiSuccess(t *testing.T) {
 	s := loadTestFile(t, "testyml/multisuccess.yml")
-	err := ValidateResponses(s)
-	assert.Error(t, err)
-	assert.True(t, strings.Contains(err.Error(), "one success type"), err.Error())
+	require.NoError(t, ValidateResponses(s))
+
+	op := s.Paths.Paths["/path"].Get
+	assert.True(t, HasMultipleSuccessResponses(op))
+	assert.Equal(t, "*models.OpResponse", *SuccessType(&s, op))
+	assert.Equal(t, []SuccessResponse{
+		{StatusCode: 200, Field: "OK", Type: "*models.SuccessType"},
+		{StatusCode: 204, Field: "NoContent", Type: ""},
+	}, SuccessResponses(&s, op))
+
+	typeToCode, err := TypeToCodeMap(&s, op)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{
+		"models.BadRequest": 400, "*models.BadRequest": 400,
+		"models.InternalError": 500, "*models.InternalError": 500,
+	}, typeToCode)
 }
 
 func loadTestFile(t *testing.T, filename string) spec.Swagger {
@@ -72,3 +85,28 @@ func TestOverrideDefaults(t *testing.T) {
 	s := loadTestFile(t, "testyml/override.yml")
 	assert.NoError(t, ValidateResponses(s))
 }
+
+func TestFieldErrors(t *testing.T) {
+	s := loadTestFile(t, "testyml/fielderrors.yml")
+	require.NoError(t, ValidateResponses(s))
+
+	hasFieldErrors, err := HasFieldErrors(&s)
+	require.NoError(t, err)
+	assert.True(t, hasFieldErrors)
+	fieldErrors, err := BadRequestFieldErrors(&s, s.Paths.Paths["/path"].Get)
+	require.NoError(t, err)
+	assert.Equal(t, &FieldErrors{ItemType: "models.FieldError", CodeType: "int32"}, fieldErrors)
+}
+
+func TestInvalidFieldErrors(t *testing.T) {
+	s := loadTestFile(t, "testyml/badfielderrors.yml")
+	err := ValidateResponses(s)
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "must have a 'code' field of type 'integer'"), err.Error())
+
+	// Generating code from a spec that wasn't validated returns the error too
+	_, err = HasFieldErrors(&s)
+	assert.Error(t, err)
+	_, err = BadRequestFieldErrors(&s, s.Paths.Paths["/path"].Get)
+	assert.Error(t, err)
+}
@@ -0,0 +1,101 @@
+package swagger
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+
+	"github.com/Clever/wag/v9/utils"
+)
+
+// SecurityRequirements returns the security requirements of an operation. An operation's own
+// security field overrides the global one, so `security: []` on an operation disables auth.
+// The requirements are alternatives; each one lists the schemes that must all be satisfied.
+func SecurityRequirements(s *spec.Swagger, op *spec.Operation) []map[string][]string {
+	if op.Security != nil {
+		return op.Security
+	}
+	return s.Security
+}
+
+// HasSecurity returns true if the spec defines any security schemes.
+func HasSecurity(s *spec.Swagger) bool {
+	return len(s.SecurityDefinitions) > 0
+}
+
+// SortedSecurityDefinitionKeys sorts the keys of a spec.SecurityDefinitions.
+func SortedSecurityDefinitionKeys(m spec.SecurityDefinitions) []string {
+	sortedKeys := []string{}
+	for k := range m {
+		sortedKeys = append(sortedKeys, k)
+	}
+	sort.Strings(sortedKeys)
+	return sortedKeys
+}
+
+// SortedSecurityRequirementKeys sorts the keys of a security requirement.
+func SortedSecurityRequirementKeys(m map[string][]string) []string {
+	sortedKeys := []string{}
+	for k := range m {
+		sortedKeys = append(sortedKeys, k)
+	}
+	sort.Strings(sortedKeys)
+	return sortedKeys
+}
+
+// SecuritySchemeConstName returns the name of the Go constant for a security scheme,
+// e.g. "api_key" -> "SecuritySchemeAPIKey".
+func SecuritySchemeConstName(name string) string {
+	return "SecurityScheme" + utils.CamelCase(name, true)
+}
+
+// SecurityScheme is a security definition in the form the client and server templates use.
+type SecurityScheme struct {
+	Name      string
+	ConstName string
+	// Type is one of "apiKey", "basic", or "oauth2". oauth2 schemes are sent as bearer tokens.
+	Type string
+	// In and ParamName are the location and name of the key for apiKey schemes.
+	In        string
+	ParamName string
+}
+
+// SecuritySchemes returns the security definitions of a spec, sorted by name.
+func SecuritySchemes(s *spec.Swagger) []SecurityScheme {
+	schemes := []SecurityScheme{}
+	for _, name := range SortedSecurityDefinitionKeys(s.SecurityDefinitions) {
+		def := s.SecurityDefinitions[name]
+		schemes = append(schemes, SecurityScheme{
+			Name:      name,
+			ConstName: SecuritySchemeConstName(name),
+			Type:      def.Type,
+			In:        def.In,
+			ParamName: def.Name,
+		})
+	}
+	return schemes
+}
+
+// SecurityRequirementsCode returns a Go literal of type [][]securityRequirement for the
+// operation's security requirements, for use in the generated server.
+func SecurityRequirementsCode(s *spec.Swagger, op *spec.Operation) string {
+	var requirements []string
+	for _, requirement := range SecurityRequirements(s, op) {
+		var schemes []string
+		for _, name := range SortedSecurityRequirementKeys(requirement) {
+			scopes := "nil"
+			if len(requirement[name]) > 0 {
+				var quoted []string
+				for _, scope := range requirement[name] {
+					quoted = append(quoted, strconv.Quote(scope))
+				}
+				scopes = "[]string{" + strings.Join(quoted, ", ") + "}"
+			}
+			schemes = append(schemes, "{scheme: "+SecuritySchemeConstName(name)+", scopes: "+scopes+"}")
+		}
+		requirements = append(requirements, "{"+strings.Join(schemes, ", ")+"}")
+	}
+	return "[][]securityRequirement{\n" + strings.Join(requirements, ",\n") + ",\n}"
+}
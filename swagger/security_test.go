@@ -0,0 +1,27 @@
+package swagger
+
+import (
+	"go/parser"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityRequirementsCode(t *testing.T) {
+	s := &spec.Swagger{}
+	op := spec.NewOperation("op")
+	op.Security = []map[string][]string{
+		{},
+		{"api_key": nil, "oauth": {"read:widgets", `say "hi" \ bye`}},
+	}
+
+	code := SecurityRequirementsCode(s, op)
+	assert.Equal(t, `[][]securityRequirement{
+{},
+{{scheme: SecuritySchemeAPIKey, scopes: nil}, {scheme: SecuritySchemeOauth, scopes: []string{"read:widgets", "say \"hi\" \\ bye"}}},
+}`, code)
+	_, err := parser.ParseExpr(code)
+	require.NoError(t, err)
+}
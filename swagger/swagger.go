@@ -150,6 +150,27 @@ func SortedSchemaProperties(schema spec.Schema) []string {
 	return sortedKeys
 }
 
+// SortedKeys sorts the keys of a map with string keys, e.g. a spec.Definitions, or an object of
+// a spec decoded into a map[string]interface{}.
+func SortedKeys[M ~map[string]V, V any](m M) []string {
+	sortedKeys := []string{}
+	for k := range m {
+		sortedKeys = append(sortedKeys, k)
+	}
+	sort.Strings(sortedKeys)
+	return sortedKeys
+}
+
+// EscapePointer escapes a key for use in a JSON pointer, e.g. "/books/{id}" -> "~1books~1{id}".
+func EscapePointer(key string) string {
+	return strings.Replace(strings.Replace(key, "~", "~0", -1), "/", "~1", -1)
+}
+
+// UnescapePointer reverses EscapePointer.
+func UnescapePointer(token string) string {
+	return strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+}
+
 // Capitalize the first character of a string.
 func Capitalize(input string) string {
 	return strings.ToUpper(input[0:1]) + input[1:]
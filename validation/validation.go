@@ -17,11 +17,13 @@ import (
 // A regex requiring the field to be start with a letter and be alphanumeric
 var alphaNumRegex = regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9]*$")
 
+// A regex requiring the field to start with a letter and contain only letters, numbers, and underscores
+var alphaNumUnderscoreRegex = regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9_]*$")
+
 // Validate checks if the swagger operation has any fields we don't support
 func validateOp(s *spec.Swagger, path, method string, op *spec.Operation) error {
-	if len(op.Consumes) != 0 {
-		return fmt.Errorf("%s %s cannot have a consumes field. WAG does not support the consumes field "+
-			"on operations", method, path)
+	if err := validateConsumes(path, method, op); err != nil {
+		return err
 	}
 	if len(op.Produces) != 0 {
 		return fmt.Errorf("%s %s cannot have a produces field. WAG does not support the produces field "+
@@ -31,9 +33,8 @@ func validateOp(s *spec.Swagger, path, method string, op *spec.Operation) error
 		return fmt.Errorf("%s %s cannot have a schemes field. WAG does not support the schemes field "+
 			"on operations", method, path)
 	}
-	if len(op.Security) != 0 {
-		return fmt.Errorf("%s %s cannot have a security field. WAG does not support the security field "+
-			"on operations", method, path)
+	if err := validateSecurityRequirements(s, op.Security); err != nil {
+		return fmt.Errorf("%s %s has an invalid security field: %s", method, path, err)
 	}
 
 	if op.ID == "" {
@@ -53,10 +54,36 @@ func validateOp(s *spec.Swagger, path, method string, op *spec.Operation) error
 		return err
 	}
 
+	if err := validatePolymorphicResponses(s, path, method, op); err != nil {
+		return err
+	}
+
+	if swagger.HasMultipleSuccessResponses(op) {
+		name := swagger.SuccessResponseTypeName(op)
+		if _, ok := s.Definitions[name]; ok {
+			return fmt.Errorf("%s %s has more than one success response so wag generates a %s type, "+
+				"which conflicts with the definition of the same name", method, path, name)
+		}
+	}
+
+	if err := validateResponseHeaders(s, path, method, op); err != nil {
+		return err
+	}
+
 	if err := validateParams(path, method, op); err != nil {
 		return err
 	}
 
+	if _, err := swagger.OperationRateLimit(op); err != nil {
+		return fmt.Errorf("%s %s: %s", method, path, err)
+	}
+	if _, err := swagger.OperationTimeout(op); err != nil {
+		return fmt.Errorf("%s %s: %s", method, path, err)
+	}
+	if _, err := swagger.OperationMaxBodyBytes(op); err != nil {
+		return fmt.Errorf("%s %s: %s", method, path, err)
+	}
+
 	return validatePaging(s, path, method, op)
 }
 
@@ -84,10 +111,93 @@ func validateResponses(path, method string, op *spec.Operation) error {
 	return nil
 }
 
+// validatePolymorphicResponses rejects error responses with polymorphic types. wag generates the
+// Error method for the types of error responses, but go-swagger generates an interface for types
+// with a discriminator.
+func validatePolymorphicResponses(s *spec.Swagger, path, method string, op *spec.Operation) error {
+	for _, statusCode := range swagger.SortedStatusCodeKeys(op.Responses.StatusCodeResponses) {
+		if statusCode < 400 {
+			continue
+		}
+		schema := swagger.OutputSchema(s, op, statusCode)
+		if swagger.IsPolymorphic(s, schema) {
+			return fmt.Errorf("responses.%d for %s %s refers to %s, which has a discriminator. Error "+
+				"responses can't be polymorphic", statusCode, method, path, schema.Ref.String())
+		}
+	}
+	return nil
+}
+
+// validateConsumes only allows the consumes field on operations with formData parameters, which
+// are sent as multipart/form-data or application/x-www-form-urlencoded instead of JSON.
+func validateConsumes(path, method string, op *spec.Operation) error {
+	if len(op.Consumes) == 0 {
+		return nil
+	}
+	if !swagger.HasFormDataParams(op) {
+		return fmt.Errorf("%s %s cannot have a consumes field. WAG only supports the consumes field "+
+			"on operations with formData parameters", method, path)
+	}
+	for _, mimeType := range op.Consumes {
+		switch mimeType {
+		case "multipart/form-data":
+		case "application/x-www-form-urlencoded":
+			if swagger.HasFileParams(op) {
+				return fmt.Errorf("%s %s has file parameters so it must consume multipart/form-data",
+					method, path)
+			}
+		default:
+			return fmt.Errorf("%s %s has unsupported consumes option '%s'. Operations with formData "+
+				"parameters can consume multipart/form-data or application/x-www-form-urlencoded",
+				method, path, mimeType)
+		}
+	}
+	return nil
+}
+
+// validateResponseHeaders validates the headers of an operation's success responses, which become
+// fields of the <OperationID>Output or <OperationID>Response type.
+func validateResponseHeaders(s *spec.Swagger, path, method string, op *spec.Operation) error {
+	headers, err := swagger.ResponseHeaders(op)
+	if err != nil {
+		return fmt.Errorf("%s %s: %s", method, path, err)
+	}
+	if len(headers) == 0 {
+		return nil
+	}
+
+	typeName := swagger.SuccessResponseTypeName(op)
+	reserved := map[string]bool{"StatusCode": true}
+	for _, r := range swagger.SuccessResponses(s, op) {
+		reserved[r.Field] = true
+	}
+	if swagger.HasOutputType(op) {
+		typeName = swagger.OutputTypeName(op)
+		reserved = map[string]bool{"Body": true}
+		if _, ok := s.Definitions[typeName]; ok {
+			return fmt.Errorf("%s %s has response headers so wag generates a %s type, "+
+				"which conflicts with the definition of the same name", method, path, typeName)
+		}
+	}
+	for _, h := range headers {
+		if reserved[h.Field] {
+			return fmt.Errorf("%s %s has response header %s, which conflicts with the %s field of %s",
+				method, path, h.Name, h.Field, typeName)
+		}
+	}
+	return nil
+}
+
 func validateParams(path, method string, op *spec.Operation) error {
 
+	hasBody, hasFormData := false, false
 	for _, param := range op.Parameters {
 
+		if param.Type == "file" && param.In != "formData" {
+			return fmt.Errorf("%s for %s %s is a file parameter so it must be in formData",
+				param.Name, method, path)
+		}
+
 		switch param.In {
 		case "path":
 			if !param.Required {
@@ -107,14 +217,26 @@ func validateParams(path, method string, op *spec.Operation) error {
 				return fmt.Errorf("%s for %s %s is a body parameter so it must reference a schema",
 					param.Name, method, path)
 			}
-		case "query":
+			hasBody = true
+		case "formData":
 			if param.Type == "object" {
-				return fmt.Errorf("%s for %s %s is a query param so it can't have the type 'object'",
+				return fmt.Errorf("%s for %s %s is a formData param so it can't have the type 'object'",
 					param.Name, method, path)
 			}
 			if param.Type == "array" && param.Items.Type != "string" {
 				return fmt.Errorf("array parameters must have string sub-types")
 			}
+			hasFormData = true
+		case "query":
+			if param.Type == "object" {
+				return fmt.Errorf("%s for %s %s is a query param so it can't have the type 'object'",
+					param.Name, method, path)
+			}
+			if param.Type == "array" {
+				if err := validateArrayQueryParam(param); err != nil {
+					return fmt.Errorf("%s for %s %s: %s", param.Name, method, path, err)
+				}
+			}
 		case "header":
 			if param.Type == "array" || param.Type == "object" {
 				return fmt.Errorf("%s for %s %s is a path parameter so it must have a primitive type",
@@ -128,6 +250,11 @@ func validateParams(path, method string, op *spec.Operation) error {
 			return fmt.Errorf("unsupported param type: %s", param.In)
 		}
 
+		if param.CollectionFormat != "" && (param.In != "query" || param.Type != "array") {
+			return fmt.Errorf("%s for %s %s cannot have a collectionFormat. Only array query "+
+				"parameters can have a collectionFormat", param.Name, method, path)
+		}
+
 		if param.Type == "string" && param.Format != "" {
 			if param.MaxLength != nil || param.MinLength != nil || param.Pattern != "" || len(param.Enum) > 0 {
 				return fmt.Errorf("%s for %s %s cannot have min/max length, pattern, or enum fields. "+
@@ -136,6 +263,29 @@ func validateParams(path, method string, op *spec.Operation) error {
 			}
 		}
 	}
+
+	if hasBody && hasFormData {
+		return fmt.Errorf("%s %s cannot have both body and formData parameters", method, path)
+	}
+	return nil
+}
+
+// validateArrayQueryParam checks that an array query parameter has primitive items and a
+// collectionFormat wag knows how to serialize.
+func validateArrayQueryParam(param spec.Parameter) error {
+	if param.Items == nil {
+		return fmt.Errorf("array parameters must define items")
+	}
+	switch param.Items.Type {
+	case "string", "integer", "number", "boolean":
+	default:
+		return fmt.Errorf("array parameters must have string, integer, number, or boolean sub-types")
+	}
+	switch param.CollectionFormat {
+	case "", "csv", "ssv", "tsv", "pipes", "multi":
+	default:
+		return fmt.Errorf("unsupported collectionFormat '%s'", param.CollectionFormat)
+	}
 	return nil
 }
 
@@ -158,6 +308,21 @@ func validatePaging(s *spec.Swagger, path, method string, op *spec.Operation) er
 			"paging on endpoints with a single string path parameter", method, path)
 	}
 
+	if swagger.HasFormDataParams(op) {
+		return fmt.Errorf("%s %s cannot use x-paging. WAG doesn't support "+
+			"paging on endpoints with formData parameters", method, path)
+	}
+
+	if swagger.HasMultipleSuccessResponses(op) {
+		return fmt.Errorf("%s %s cannot use x-paging. WAG doesn't support "+
+			"paging on endpoints with more than one success response", method, path)
+	}
+
+	if swagger.HasResponseHeaders(op) {
+		return fmt.Errorf("%s %s cannot use x-paging. WAG doesn't support "+
+			"paging on endpoints with response headers", method, path)
+	}
+
 	pagingParamName, ok := pagingConfig["pageParameter"].(string)
 	if !ok {
 		return fmt.Errorf("%s %s has invalid x-paging section. x-paging must include "+
@@ -185,10 +350,15 @@ func validatePaging(s *spec.Swagger, path, method string, op *spec.Operation) er
 		}
 	}
 
-	if _, _, err := swagger.PagingResourceType(s, op); err != nil {
+	resourceType, _, err := swagger.PagingResourceType(s, op)
+	if err != nil {
 		return fmt.Errorf("%s %s has invalid paging resource (defaults to successful return type): %s",
 			method, path, err.Error())
 	}
+	if def, ok := s.Definitions[strings.TrimPrefix(resourceType, "models.")]; ok && def.Discriminator != "" {
+		return fmt.Errorf("%s %s cannot use x-paging. WAG doesn't support paging over "+
+			"polymorphic types", method, path)
+	}
 
 	return nil
 }
@@ -206,6 +376,71 @@ func validateDefinitions(definitions map[string]spec.Schema) error {
 	return nil
 }
 
+// validateProblemDetails checks the x-problem-details extension. wag generates the
+// ProblemDetails and InvalidParam models for it, so definitions can't use those names.
+func validateProblemDetails(s *spec.Swagger) error {
+	value, ok := s.Extensions["x-problem-details"]
+	if !ok {
+		return nil
+	}
+	if _, ok := value.(bool); !ok {
+		return fmt.Errorf("x-problem-details must be true or false")
+	}
+	if !swagger.ProblemDetails(s) {
+		return nil
+	}
+	for _, name := range []string{"ProblemDetails", "InvalidParam"} {
+		if _, ok := s.Definitions[name]; ok {
+			return fmt.Errorf("wag generates the %s type for x-problem-details, which conflicts with "+
+				"the definition of the same name", name)
+		}
+	}
+	return nil
+}
+
+func validateSecurityDefinitions(definitions spec.SecurityDefinitions) error {
+	for _, name := range swagger.SortedSecurityDefinitionKeys(definitions) {
+		if !alphaNumUnderscoreRegex.MatchString(name) {
+			return fmt.Errorf("security definition %s must be alphanumeric and start with a letter", name)
+		}
+		def := definitions[name]
+		switch def.Type {
+		case "basic", "oauth2":
+		case "apiKey":
+			if def.In != "header" && def.In != "query" {
+				return fmt.Errorf("security definition %s must be in 'header' or 'query'", name)
+			}
+			if def.Name == "" {
+				return fmt.Errorf("security definition %s must have a name", name)
+			}
+		default:
+			return fmt.Errorf("security definition %s has unsupported type '%s'. WAG supports "+
+				"'apiKey', 'basic', and 'oauth2'", name, def.Type)
+		}
+	}
+	return nil
+}
+
+func validateSecurityRequirements(s *spec.Swagger, requirements []map[string][]string) error {
+	for _, requirement := range requirements {
+		for _, name := range swagger.SortedSecurityRequirementKeys(requirement) {
+			def, ok := s.SecurityDefinitions[name]
+			if !ok {
+				return fmt.Errorf("security scheme %s is not defined in securityDefinitions", name)
+			}
+			if def.Type != "oauth2" {
+				continue
+			}
+			for _, scope := range requirement[name] {
+				if _, ok := def.Scopes[scope]; !ok {
+					return fmt.Errorf("scope %s is not defined in the scopes of security scheme %s", scope, name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // Validate returns an error if the swagger file is invalid or uses fields
 // we don't support. Note that this isn't a comprehensive check for all things
 // we don't support, so this may not return an error, but the Swagger file might
@@ -213,7 +448,13 @@ func validateDefinitions(definitions map[string]spec.Schema) error {
 func Validate(d loads.Document, generateJSClient bool) error {
 	s := d.Spec()
 
-	goSwaggerError := validate.Spec(&d, strfmt.Default)
+	// validate.Spec expands the references of responses with examples in place, which would leave
+	// inline schemas in the spec, so validate a copy of it
+	copied, err := d.Expanded(&spec.ExpandOptions{RelativeBase: d.SpecFilePath(), SkipSchemas: true})
+	if err != nil {
+		return err
+	}
+	goSwaggerError := validate.Spec(copied, strfmt.Default)
 	if goSwaggerError != nil {
 		str := ""
 		for _, desc := range goSwaggerError.(*swaggererrors.CompositeError).Errors {
@@ -246,12 +487,12 @@ func Validate(d loads.Document, generateJSClient bool) error {
 		return fmt.Errorf("wag does not support global parameters definitions. Define parameters on a per request basis")
 	}
 
-	if len(s.SecurityDefinitions) != 0 {
-		return fmt.Errorf("wag does not support the security definitions field")
+	if err := validateSecurityDefinitions(s.SecurityDefinitions); err != nil {
+		return err
 	}
 
-	if len(s.Security) != 0 {
-		return fmt.Errorf("wag does not support the security field")
+	if err := validateSecurityRequirements(s, s.Security); err != nil {
+		return fmt.Errorf("invalid security field: %s", err)
 	}
 
 	_, ok := s.Info.Extensions.GetString("x-npm-package")
@@ -259,10 +500,14 @@ func Validate(d loads.Document, generateJSClient bool) error {
 		return fmt.Errorf("must provide 'x-npm-package' in the 'info' section of the swagger.yml")
 	}
 
+	if err := validateProblemDetails(s); err != nil {
+		return err
+	}
+
 	for path, pathItem := range s.Paths.Paths {
 		if pathItem.Ref.String() != "" {
-			return fmt.Errorf("wag does not support paths with $ref fields. Define the references on " +
-				"a per operation basis")
+			return fmt.Errorf("wag only supports $ref fields on paths that refer to other files, like " +
+				"paths/books.yml. Define the references on a per operation basis")
 		}
 		if len(pathItem.Parameters) != 0 {
 			return fmt.Errorf("parameters cannot be defined for an entire path. " +
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/go-openapi/jsonreference"
+	"github.com/go-openapi/loads"
 	"github.com/go-openapi/spec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -65,3 +66,266 @@ func TestNestedTypes(t *testing.T) {
 	require.Error(t, err)
 	assert.Equal(t, "badNested cannot have nested object types", err.Error())
 }
+
+func TestValidateSecurityDefinitions(t *testing.T) {
+	definitions := spec.SecurityDefinitions{
+		"api_key": spec.APIKeyAuth("X-API-Key", "header"),
+		"basic":   spec.BasicAuth(),
+		"oauth":   spec.OAuth2Application("https://example.com/token"),
+	}
+	require.NoError(t, validateSecurityDefinitions(definitions))
+
+	definitions["cookie"] = spec.APIKeyAuth("session", "cookie")
+	err := validateSecurityDefinitions(definitions)
+	require.Error(t, err)
+	assert.Equal(t, "security definition cookie must be in 'header' or 'query'", err.Error())
+}
+
+func TestValidateSecurityRequirements(t *testing.T) {
+	s := spec.Swagger{}
+	s.SecurityDefinitions = spec.SecurityDefinitions{"api_key": spec.APIKeyAuth("X-API-Key", "header")}
+	op := spec.Operation{}
+	op.ID = "op"
+	op.Responses = &spec.Responses{}
+	op.Security = []map[string][]string{{"api_key": {}}}
+	require.NoError(t, validateOp(&s, "/books", "GET", &op))
+
+	op.Security = []map[string][]string{{"oauth": {"read"}}}
+	err := validateOp(&s, "/books", "GET", &op)
+	require.Error(t, err)
+	assert.Equal(t, "GET /books has an invalid security field: security scheme oauth is not defined "+
+		"in securityDefinitions", err.Error())
+
+	oauth := spec.OAuth2Application("https://auth.example.com/token")
+	oauth.AddScope("read", "read books")
+	s.SecurityDefinitions["oauth"] = oauth
+	require.NoError(t, validateOp(&s, "/books", "GET", &op))
+	op.Security = []map[string][]string{{"oauth": {"raed"}}}
+	err = validateOp(&s, "/books", "GET", &op)
+	require.Error(t, err)
+	assert.Equal(t, "GET /books has an invalid security field: scope raed is not defined in the "+
+		"scopes of security scheme oauth", err.Error())
+}
+
+func TestValidateFormDataParams(t *testing.T) {
+	s := spec.Swagger{}
+	op := spec.Operation{}
+	op.ID = "op"
+	op.Responses = &spec.Responses{}
+	op.Parameters = []spec.Parameter{*spec.FileParam("upload"), *spec.FormDataParam("title").Typed("string", "")}
+	op.Consumes = []string{"multipart/form-data"}
+	require.NoError(t, validateOp(&s, "/books", "POST", &op))
+
+	op.Consumes = []string{"application/x-www-form-urlencoded"}
+	err := validateOp(&s, "/books", "POST", &op)
+	require.Error(t, err)
+	assert.Equal(t, "POST /books has file parameters so it must consume multipart/form-data", err.Error())
+
+	op.Consumes = nil
+	op.Parameters = append(op.Parameters, *spec.BodyParam("book", spec.RefSchema("#/definitions/Book")))
+	err = validateOp(&s, "/books", "POST", &op)
+	require.Error(t, err)
+	assert.Equal(t, "POST /books cannot have both body and formData parameters", err.Error())
+
+	op.Parameters = []spec.Parameter{*spec.QueryParam("upload").Typed("file", "")}
+	err = validateOp(&s, "/books", "POST", &op)
+	require.Error(t, err)
+	assert.Equal(t, "upload for POST /books is a file parameter so it must be in formData", err.Error())
+
+	op.Consumes = []string{"multipart/form-data"}
+	op.Parameters = []spec.Parameter{*spec.QueryParam("title").Typed("string", "")}
+	err = validateOp(&s, "/books", "POST", &op)
+	require.Error(t, err)
+	assert.Equal(t, "POST /books cannot have a consumes field. WAG only supports the consumes field "+
+		"on operations with formData parameters", err.Error())
+}
+
+func TestValidateArrayQueryParams(t *testing.T) {
+	s := spec.Swagger{}
+	op := spec.Operation{}
+	op.ID = "op"
+	op.Responses = &spec.Responses{}
+	ids := spec.QueryParam("ids").CollectionOf(spec.NewItems().Typed("integer", "int32"), "pipes")
+	op.Parameters = []spec.Parameter{*ids}
+	require.NoError(t, validateOp(&s, "/books", "GET", &op))
+
+	ids.CollectionFormat = "json"
+	op.Parameters = []spec.Parameter{*ids}
+	err := validateOp(&s, "/books", "GET", &op)
+	require.Error(t, err)
+	assert.Equal(t, "ids for GET /books: unsupported collectionFormat 'json'", err.Error())
+
+	ids = spec.QueryParam("ids").CollectionOf(spec.NewItems().Typed("object", ""), "csv")
+	op.Parameters = []spec.Parameter{*ids}
+	err = validateOp(&s, "/books", "GET", &op)
+	require.Error(t, err)
+	assert.Equal(t, "ids for GET /books: array parameters must have string, integer, number, or boolean "+
+		"sub-types", err.Error())
+
+	ids = spec.FormDataParam("ids").CollectionOf(spec.NewItems().Typed("string", ""), "csv")
+	op.Parameters = []spec.Parameter{*ids}
+	err = validateOp(&s, "/books", "POST", &op)
+	require.Error(t, err)
+	assert.Equal(t, "ids for POST /books cannot have a collectionFormat. Only array query parameters "+
+		"can have a collectionFormat", err.Error())
+}
+
+func TestValidateMultipleSuccessResponses(t *testing.T) {
+	s := spec.Swagger{}
+	op := spec.Operation{}
+	op.ID = "upsertBook"
+	op.Responses = &spec.Responses{}
+	op.Responses.StatusCodeResponses = map[int]spec.Response{
+		200: *spec.NewResponse().WithSchema(spec.RefSchema("#/definitions/Book")),
+		201: *spec.NewResponse().WithSchema(spec.RefSchema("#/definitions/Book")),
+	}
+	require.NoError(t, validateOp(&s, "/books", "PUT", &op))
+
+	s.Definitions = spec.Definitions{"UpsertBookResponse": *spec.StringProperty()}
+	err := validateOp(&s, "/books", "PUT", &op)
+	require.Error(t, err)
+	assert.Equal(t, "PUT /books has more than one success response so wag generates a UpsertBookResponse "+
+		"type, which conflicts with the definition of the same name", err.Error())
+
+	s.Definitions = nil
+	op.Extensions = spec.Extensions{"x-paging": map[string]interface{}{"pageParameter": "page"}}
+	err = validateOp(&s, "/books", "PUT", &op)
+	require.Error(t, err)
+	assert.Equal(t, "PUT /books cannot use x-paging. WAG doesn't support paging on endpoints with more "+
+		"than one success response", err.Error())
+}
+
+func TestValidateResponseHeaders(t *testing.T) {
+	s := spec.Swagger{}
+	op := spec.Operation{}
+	op.ID = "getBook"
+	op.Responses = &spec.Responses{}
+	op.Responses.StatusCodeResponses = map[int]spec.Response{
+		200: *spec.NewResponse().WithSchema(spec.RefSchema("#/definitions/Book")).
+			AddHeader("ETag", spec.ResponseHeader().Typed("string", "")),
+	}
+	require.NoError(t, validateOp(&s, "/books", "GET", &op))
+
+	s.Definitions = spec.Definitions{"GetBookOutput": *spec.StringProperty()}
+	err := validateOp(&s, "/books", "GET", &op)
+	require.Error(t, err)
+	assert.Equal(t, "GET /books has response headers so wag generates a GetBookOutput type, "+
+		"which conflicts with the definition of the same name", err.Error())
+	s.Definitions = nil
+
+	op.Responses.StatusCodeResponses[200].Headers["Body"] = *spec.ResponseHeader().Typed("string", "")
+	err = validateOp(&s, "/books", "GET", &op)
+	require.Error(t, err)
+	assert.Equal(t, "GET /books has response header Body, which conflicts with the Body field of "+
+		"GetBookOutput", err.Error())
+	delete(op.Responses.StatusCodeResponses[200].Headers, "Body")
+
+	op.Responses.StatusCodeResponses[200].Headers["X-Tags"] = *spec.ResponseHeader().Typed("array", "")
+	err = validateOp(&s, "/books", "GET", &op)
+	require.Error(t, err)
+	assert.Equal(t, "GET /books: response header X-Tags of getBook: response headers must be strings, "+
+		"integers, numbers, or booleans", err.Error())
+	delete(op.Responses.StatusCodeResponses[200].Headers, "X-Tags")
+
+	op.Responses.StatusCodeResponses[201] = *spec.NewResponse().
+		AddHeader("ETag", spec.ResponseHeader().Typed("integer", ""))
+	err = validateOp(&s, "/books", "GET", &op)
+	require.Error(t, err)
+	assert.Equal(t, "GET /books: response header ETag of getBook has type string in one response and "+
+		"int64 in another", err.Error())
+	delete(op.Responses.StatusCodeResponses, 201)
+
+	op.Extensions = spec.Extensions{"x-paging": map[string]interface{}{"pageParameter": "page"}}
+	err = validateOp(&s, "/books", "GET", &op)
+	require.Error(t, err)
+	assert.Equal(t, "GET /books cannot use x-paging. WAG doesn't support paging on endpoints with "+
+		"response headers", err.Error())
+}
+
+func TestValidatePolymorphicResponses(t *testing.T) {
+	s := spec.Swagger{}
+	s.Definitions = spec.Definitions{
+		"Event": spec.Schema{SwaggerSchemaProps: spec.SwaggerSchemaProps{Discriminator: "kind"}},
+	}
+	op := spec.Operation{}
+	op.ID = "getEvent"
+	op.Responses = &spec.Responses{}
+	op.Responses.StatusCodeResponses = map[int]spec.Response{
+		200: *spec.NewResponse().WithSchema(spec.RefSchema("#/definitions/Event")),
+	}
+	require.NoError(t, validateOp(&s, "/events", "GET", &op))
+
+	op.Responses.StatusCodeResponses[404] = *spec.NewResponse().WithSchema(spec.RefSchema("#/definitions/Event"))
+	err := validateOp(&s, "/events", "GET", &op)
+	require.Error(t, err)
+	assert.Equal(t, "responses.404 for GET /events refers to #/definitions/Event, which has a "+
+		"discriminator. Error responses can't be polymorphic", err.Error())
+	delete(op.Responses.StatusCodeResponses, 404)
+
+	op.Parameters = []spec.Parameter{*spec.QueryParam("page").Typed("string", "")}
+	op.Extensions = spec.Extensions{"x-paging": map[string]interface{}{"pageParameter": "page"}}
+	op.Responses.StatusCodeResponses[200] = *spec.NewResponse().
+		WithSchema(spec.ArrayProperty(spec.RefSchema("#/definitions/Event")))
+	err = validateOp(&s, "/events", "GET", &op)
+	require.Error(t, err)
+	assert.Equal(t, "GET /events cannot use x-paging. WAG doesn't support paging over polymorphic types",
+		err.Error())
+}
+
+func TestValidateProblemDetails(t *testing.T) {
+	s := spec.Swagger{}
+	require.NoError(t, validateProblemDetails(&s))
+
+	s.Extensions = spec.Extensions{"x-problem-details": "yes"}
+	err := validateProblemDetails(&s)
+	require.Error(t, err)
+	assert.Equal(t, "x-problem-details must be true or false", err.Error())
+
+	s.Extensions = spec.Extensions{"x-problem-details": true}
+	s.Definitions = spec.Definitions{"BadRequest": spec.Schema{}}
+	require.NoError(t, validateProblemDetails(&s))
+
+	s.Definitions["InvalidParam"] = spec.Schema{}
+	err = validateProblemDetails(&s)
+	require.Error(t, err)
+	assert.Equal(t, "wag generates the InvalidParam type for x-problem-details, which conflicts with "+
+		"the definition of the same name", err.Error())
+
+	// the definitions are only generated when problem details are on
+	s.Extensions = spec.Extensions{"x-problem-details": false}
+	require.NoError(t, validateProblemDetails(&s))
+}
+
+func TestValidateDoesNotModifySpec(t *testing.T) {
+	doc, err := loads.Analyzed([]byte(`{
+  "swagger": "2.0",
+  "info": {"title": "books", "version": "1.0.0"},
+  "basePath": "/v1",
+  "schemes": ["http"],
+  "produces": ["application/json"],
+  "consumes": ["application/json"],
+  "paths": {
+    "/books/{id}": {
+      "get": {
+        "operationId": "getBook",
+        "parameters": [{"name": "id", "in": "path", "required": true, "type": "string"}],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {"$ref": "#/definitions/Book"},
+            "examples": {"application/json": {"name": "Frankenstein"}}
+          }
+        }
+      }
+    }
+  },
+  "definitions": {"Book": {"type": "object", "properties": {"name": {"type": "string"}}}}
+}`), "")
+	require.NoError(t, err)
+	require.NoError(t, Validate(*doc, false))
+
+	// Validating the examples of responses mustn't replace their references with inline schemas,
+	// which would generate anonymous types instead of the definitions
+	schema := doc.Spec().Paths.Paths["/books/{id}"].Get.Responses.StatusCodeResponses[200].Schema
+	assert.Equal(t, "#/definitions/Book", schema.Ref.String())
+}